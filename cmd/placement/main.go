@@ -16,6 +16,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 
 	"github.com/dapr/dapr/cmd/placement/options"
@@ -35,7 +37,17 @@ import (
 var log = logger.NewLogger("dapr.placement")
 
 func main() {
-	opts := options.New()
+	if len(os.Args) > 1 && os.Args[1] == options.RestoreCommand {
+		if err := restore(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	opts, err := options.New()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Apply options to all loggers.
 	if err := logger.ApplyOptionsToLoggers(&opts.Logger); err != nil {
@@ -48,7 +60,7 @@ func main() {
 	metricsExporter := metrics.NewExporterWithOptions(log, metrics.DefaultMetricNamespace, opts.Metrics)
 
 	// Initialize dapr metrics for placement.
-	err := metricsExporter.Init()
+	err = metricsExporter.Init()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -64,6 +76,7 @@ func main() {
 		InMem:        opts.RaftInMemEnabled,
 		Peers:        opts.RaftPeers,
 		LogStorePath: opts.RaftLogStorePath,
+		TableOptions: opts.ActorTypeOptions,
 	})
 	if raftServer == nil {
 		log.Fatal("Failed to create raft server.")
@@ -98,6 +111,11 @@ func main() {
 			if opts.MetadataEnabled {
 				metadataOptions = append(metadataOptions, health.NewJSONDataRouterOptions[*placement.PlacementTables]("/placement/state", apiServer.GetPlacementTables))
 			}
+			if opts.AdminEnabled {
+				metadataOptions = append(metadataOptions,
+					health.NewRouterOptions("/placement/backup", apiServer.BackupHandler()),
+				)
+			}
 			healthzServer := health.NewServer(log, metadataOptions...)
 			healthzServer.Ready()
 			if healthzErr := healthzServer.Run(ctx, opts.HealthzPort); healthzErr != nil {
@@ -115,3 +133,34 @@ func main() {
 
 	log.Info("Placement service shut down gracefully")
 }
+
+// restore restores a backup of the placement state into the raft log store of
+// a placement node. The node must not be running.
+func restore(args []string) error {
+	opts, err := options.NewRestoreOptions(args)
+	if err != nil {
+		return err
+	}
+
+	var backup io.Reader = os.Stdin
+	if opts.BackupFile != "-" {
+		f, err := os.Open(opts.BackupFile)
+		if err != nil {
+			return fmt.Errorf("failed to open backup file: %w", err)
+		}
+		defer f.Close()
+		backup = f
+	}
+
+	err = raft.RestoreBackup(raft.RestoreOptions{
+		ID:           opts.RaftID,
+		Peers:        opts.RaftPeers,
+		LogStorePath: opts.RaftLogStorePath,
+	}, backup)
+	if err != nil {
+		return fmt.Errorf("failed to restore placement state: %w", err)
+	}
+
+	log.Infof("Placement state restored from backup into %s", opts.RaftLogStorePath)
+	return nil
+}
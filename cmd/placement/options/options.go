@@ -14,8 +14,11 @@ limitations under the License.
 package options
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/dapr/dapr/utils"
@@ -23,6 +26,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/metrics"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/raft"
 )
 
@@ -33,6 +37,7 @@ const (
 	defaultPlacementPort     = 50005
 	defaultReplicationFactor = 100
 	envMetadataEnabled       = "DAPR_PLACEMENT_METADATA_ENABLED"
+	envAdminEnabled          = "DAPR_PLACEMENT_ADMIN_ENABLED"
 )
 
 type Options struct {
@@ -49,15 +54,21 @@ type Options struct {
 	CertChainPath   string
 	TLSEnabled      bool
 	MetadataEnabled bool
+	AdminEnabled    bool
 
 	ReplicationFactor int
 
+	// ActorTypeOptionsString is the raw value of the actor type placement settings flag.
+	ActorTypeOptionsString string
+	// ActorTypeOptions is the map of placement settings per actor type.
+	ActorTypeOptions map[string]hashing.Options
+
 	// Log and metrics configurations
 	Logger  logger.Options
 	Metrics *metrics.Options
 }
 
-func New() *Options {
+func New() (*Options, error) {
 	// Default options
 	var opts Options
 
@@ -70,7 +81,9 @@ func New() *Options {
 	flag.StringVar(&opts.CertChainPath, "certchain", defaultCredentialsPath, "Path to the credentials directory holding the cert chain")
 	flag.BoolVar(&opts.TLSEnabled, "tls-enabled", false, "Should TLS be enabled for the placement gRPC server")
 	flag.BoolVar(&opts.MetadataEnabled, "metadata-enabled", opts.MetadataEnabled, "Expose the placement tables on the healthz server")
+	flag.BoolVar(&opts.AdminEnabled, "admin-enabled", opts.AdminEnabled, "Expose the backup endpoint of the placement state on the healthz server")
 	flag.IntVar(&opts.ReplicationFactor, "replicationFactor", defaultReplicationFactor, "sets the replication factor for actor distribution on vnodes")
+	flag.StringVar(&opts.ActorTypeOptionsString, "actor-type-placement", "", "Placement settings per actor type, e.g. \"myactor:replicationFactor=500:loadFactor=1.25,other:zonePolicy=spread\". A loadFactor greater than 1 enables bounded-load placement. zonePolicy=spread distributes the actors of the type evenly across zones")
 
 	flag.StringVar(&credentials.RootCertFilename, "issuer-ca-filename", credentials.RootCertFilename, "Certificate Authority certificate filename")
 	flag.StringVar(&credentials.IssuerCertFilename, "issuer-certificate-filename", credentials.IssuerCertFilename, "Issuer certificate filename")
@@ -84,6 +97,7 @@ func New() *Options {
 
 	// parse env variables before parsing flags, so the flags takes priority over env variables
 	opts.MetadataEnabled = utils.IsTruthy(os.Getenv(envMetadataEnabled))
+	opts.AdminEnabled = utils.IsTruthy(os.Getenv(envAdminEnabled))
 
 	flag.Parse()
 
@@ -92,7 +106,13 @@ func New() *Options {
 		opts.RaftInMemEnabled = false
 	}
 
-	return &opts
+	var err error
+	opts.ActorTypeOptions, err = parseActorTypeOptionsFromFlag(opts.ActorTypeOptionsString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for actor-type-placement: %w", err)
+	}
+
+	return &opts, nil
 }
 
 func parsePeersFromFlag(val string) []raft.PeerInfo {
@@ -113,3 +133,59 @@ func parsePeersFromFlag(val string) []raft.PeerInfo {
 
 	return peers
 }
+
+// parseActorTypeOptionsFromFlag parses the placement settings per actor type.
+// The format is a comma-separated list of actor types, each followed by
+// colon-separated key=value settings.
+func parseActorTypeOptionsFromFlag(val string) (map[string]hashing.Options, error) {
+	res := map[string]hashing.Options{}
+
+	for _, entry := range strings.Split(val, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		actorType := strings.TrimSpace(parts[0])
+		if actorType == "" {
+			return nil, fmt.Errorf("missing actor type in %q", entry)
+		}
+
+		var opts hashing.Options
+		for _, setting := range parts[1:] {
+			k, v, ok := strings.Cut(setting, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid setting %q for actor type %s", setting, actorType)
+			}
+
+			var err error
+			switch strings.TrimSpace(k) {
+			case "replicationFactor":
+				opts.ReplicationFactor, err = strconv.Atoi(strings.TrimSpace(v))
+				if err == nil && opts.ReplicationFactor <= 0 {
+					err = errors.New("must be greater than 0")
+				}
+			case "loadFactor":
+				opts.LoadFactor, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+				if err == nil && opts.LoadFactor <= 1 {
+					err = errors.New("must be greater than 1")
+				}
+			case "zonePolicy":
+				opts.ZonePolicy = hashing.ZonePolicy(strings.TrimSpace(v))
+				if !opts.ZonePolicy.IsValid() {
+					err = errors.New("must be spread")
+				}
+			default:
+				err = errors.New("unknown setting")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid setting %q for actor type %s: %w", setting, actorType, err)
+			}
+		}
+
+		res[actorType] = opts
+	}
+
+	return res, nil
+}
@@ -17,7 +17,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/raft"
 )
 
@@ -54,3 +56,38 @@ func TestParsePeersFromFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestParseActorTypeOptionsFromFlag(t *testing.T) {
+	t.Run("valid settings", func(t *testing.T) {
+		opts, err := parseActorTypeOptionsFromFlag("typeA:replicationFactor=500:loadFactor=1.25, typeB:replicationFactor=50,typeC,typeD:zonePolicy=spread")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]hashing.Options{
+			"typeA": {ReplicationFactor: 500, LoadFactor: 1.25},
+			"typeB": {ReplicationFactor: 50},
+			"typeC": {},
+			"typeD": {ZonePolicy: hashing.ZonePolicySpread},
+		}, opts)
+	})
+
+	t.Run("empty value", func(t *testing.T) {
+		opts, err := parseActorTypeOptionsFromFlag("")
+		require.NoError(t, err)
+		assert.Empty(t, opts)
+	})
+
+	for _, in := range []string{
+		":replicationFactor=10",
+		"typeA:replicationFactor",
+		"typeA:replicationFactor=abc",
+		"typeA:replicationFactor=0",
+		"typeA:loadFactor=1",
+		"typeA:unknown=1",
+		"typeA:zonePolicy=nearest",
+		"typeA:zonePolicy=preferLocal",
+	} {
+		t.Run("invalid value: "+in, func(t *testing.T) {
+			_, err := parseActorTypeOptionsFromFlag(in)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/dapr/dapr/pkg/placement/raft"
+)
+
+// RestoreCommand is the name of the subcommand which restores a backup of the
+// placement state into the raft log store of a placement node that is not running.
+const RestoreCommand = "restore"
+
+// RestoreOptions contains the options of the restore subcommand.
+type RestoreOptions struct {
+	RaftID           string
+	RaftPeerString   string
+	RaftPeers        []raft.PeerInfo
+	RaftLogStorePath string
+
+	// BackupFile is the path of the backup to restore, or "-" to read it from stdin.
+	BackupFile string
+}
+
+// NewRestoreOptions parses the arguments of the restore subcommand.
+func NewRestoreOptions(args []string) (*RestoreOptions, error) {
+	var opts RestoreOptions
+
+	fs := flag.NewFlagSet(RestoreCommand, flag.ContinueOnError)
+	fs.StringVar(&opts.RaftID, "id", "dapr-placement-0", "Placement server ID.")
+	fs.StringVar(&opts.RaftPeerString, "initial-cluster", "dapr-placement-0=127.0.0.1:8201", "raft cluster peers")
+	fs.StringVar(&opts.RaftLogStorePath, "raft-logstore-path", "", "raft log store path of the placement node to restore the backup into.")
+	fs.StringVar(&opts.BackupFile, "backup-file", "", "Path of the backup to restore, or - to read it from stdin")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if opts.RaftLogStorePath == "" {
+		return nil, errors.New("--raft-logstore-path is required")
+	}
+	if opts.BackupFile == "" {
+		return nil, errors.New("--backup-file is required")
+	}
+
+	opts.RaftPeers = parsePeersFromFlag(opts.RaftPeerString)
+
+	return &opts, nil
+}
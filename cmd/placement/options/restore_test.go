@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/placement/raft"
+)
+
+func TestNewRestoreOptions(t *testing.T) {
+	t.Run("parse flags", func(t *testing.T) {
+		opts, err := NewRestoreOptions([]string{
+			"--id", "node1",
+			"--initial-cluster", "node0=127.0.0.1:3030,node1=127.0.0.1:3031",
+			"--raft-logstore-path", "/var/run/dapr/raft",
+			"--backup-file", "-",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "node1", opts.RaftID)
+		assert.Equal(t, []raft.PeerInfo{
+			{ID: "node0", Address: "127.0.0.1:3030"},
+			{ID: "node1", Address: "127.0.0.1:3031"},
+		}, opts.RaftPeers)
+		assert.Equal(t, "/var/run/dapr/raft", opts.RaftLogStorePath)
+		assert.Equal(t, "-", opts.BackupFile)
+	})
+
+	t.Run("log store path is required", func(t *testing.T) {
+		_, err := NewRestoreOptions([]string{"--backup-file", "backup.bin"})
+		assert.Error(t, err)
+	})
+
+	t.Run("backup file is required", func(t *testing.T) {
+		_, err := NewRestoreOptions([]string{"--raft-logstore-path", "/var/run/dapr/raft"})
+		assert.Error(t, err)
+	})
+}
@@ -78,6 +78,14 @@ func main() {
 	config.RootCertPath = rootCertPath
 	config.TrustDomain = opts.TrustDomain
 	config.Port = opts.Port
+	config.JoinTokensFile = opts.JoinTokensFile
+	config.JoinTokenKeyFile = opts.JoinTokenKeyFile
+	config.JoinTokenAudience = opts.JoinTokenAudience
+	config.JoinTokenMaxLifetime = opts.JoinTokenMaxLifetime
+	config.ExternalSignerURL = opts.ExternalSignerURL
+	config.ExternalSignerCAPath = opts.ExternalSignerCA
+	config.ExternalSignerTokenPath = opts.ExternalSignerToken
+	config.DenyListPath = opts.DenyListPath
 	if opts.TokenAudience != "" {
 		config.TokenAudience = &opts.TokenAudience
 	}
@@ -16,6 +16,7 @@ package options
 import (
 	"flag"
 	"path/filepath"
+	"time"
 
 	"k8s.io/client-go/util/homedir"
 
@@ -31,6 +32,9 @@ const (
 
 	// defaultDaprSystemConfigName is the default resource object name for Dapr System Config.
 	defaultDaprSystemConfigName = "daprsystem"
+
+	// defaultJoinTokenMaxLifetime is the default maximum lifetime of JWT join tokens.
+	defaultJoinTokenMaxLifetime = 24 * time.Hour
 )
 
 type Options struct {
@@ -40,6 +44,14 @@ type Options struct {
 	IssuerCredentialsPath string
 	TrustDomain           string
 	TokenAudience         string
+	JoinTokensFile        string
+	JoinTokenKeyFile      string
+	JoinTokenAudience     string
+	JoinTokenMaxLifetime  time.Duration
+	ExternalSignerURL     string
+	ExternalSignerCA      string
+	ExternalSignerToken   string
+	DenyListPath          string
 	Kubeconfig            string
 	Logger                logger.Options
 	Metrics               *metrics.Options
@@ -55,6 +67,14 @@ func New() *Options {
 	flag.StringVar(&credentials.IssuerKeyFilename, "issuer-key-filename", credentials.IssuerKeyFilename, "Issuer private key filename")
 	flag.StringVar(&opts.TrustDomain, "trust-domain", "localhost", "The CA trust domain")
 	flag.StringVar(&opts.TokenAudience, "token-audience", "", "Expected audience for tokens; multiple values can be separated by a comma")
+	flag.StringVar(&opts.JoinTokensFile, "join-tokens-file", "", "Path to a file with the pre-shared join tokens bound to app IDs, used in self-hosted mode; defaults to the "+config.JoinTokensEnvVar+" env var")
+	flag.StringVar(&opts.JoinTokenKeyFile, "join-token-key-file", "", "Path to the HMAC key to validate JWT join tokens, used in self-hosted mode; defaults to the "+config.JoinTokenKeyEnvVar+" env var")
+	flag.StringVar(&opts.JoinTokenAudience, "join-token-audience", "", "Expected audience of JWT join tokens, used in self-hosted mode")
+	flag.DurationVar(&opts.JoinTokenMaxLifetime, "join-token-max-lifetime", defaultJoinTokenMaxLifetime, "Maximum lifetime of JWT join tokens, used in self-hosted mode; 0 to disable the check")
+	flag.StringVar(&opts.ExternalSignerURL, "external-signer-url", "", "URL of an external signer to delegate certificate signing to; if set, the issuer private key is not loaded by sentry")
+	flag.StringVar(&opts.ExternalSignerCA, "external-signer-ca", "", "Path to the CA bundle used to verify the TLS certificate of the external signer")
+	flag.StringVar(&opts.ExternalSignerToken, "external-signer-token", "", "Path to a file with the bearer token sent to the external signer")
+	flag.StringVar(&opts.DenyListPath, "deny-list", "", "Path to a YAML file with the denied SPIFFE IDs (spiffeIds) and revoked certificate serial numbers (serialNumbers); the file is reloaded on changes")
 	flag.IntVar(&opts.Port, "port", config.DefaultPort, "The port for the sentry server to listen on")
 	flag.IntVar(&opts.HealthzPort, "healthz-port", 8080, "The port for the healthz server to listen on")
 
@@ -233,6 +233,7 @@ func (a *actorsRuntime) Init() error {
 			AppID:           a.actorsConfig.Config.AppID,
 			RuntimeHostname: hostname,
 			PodName:         a.actorsConfig.Config.PodName,
+			Topology:        a.actorsConfig.Config.Topology,
 			ActorTypes:      a.actorsConfig.Config.HostedActorTypes.ListActorTypes(),
 			AppHealthFn: func() bool {
 				return a.appHealthy.Load()
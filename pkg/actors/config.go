@@ -21,6 +21,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/internal"
 	daprAppConfig "github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 )
 
 // Config is the actor runtime configuration.
@@ -48,6 +49,7 @@ type ConfigOpts struct {
 	HealthEndpoint     string
 	AppChannelAddress  string
 	PodName            string
+	Topology           hashing.Topology
 }
 
 // NewConfig returns the actor runtime configuration.
@@ -71,6 +73,7 @@ func NewConfig(opts ConfigOpts) Config {
 		EntityConfigs:                 make(map[string]internal.EntityConfig),
 		AppChannelAddress:             opts.AppChannelAddress,
 		PodName:                       opts.PodName,
+		Topology:                      opts.Topology,
 	}
 
 	scanDuration, err := time.ParseDuration(opts.AppConfig.ActorScanInterval)
@@ -20,6 +20,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	daprAppConfig "github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 )
 
 // Config is the actor runtime configuration.
@@ -42,6 +43,7 @@ type Config struct {
 	HealthEndpoint                string
 	AppChannelAddress             string
 	PodName                       string
+	Topology                      hashing.Topology
 }
 
 // Remap of daprAppConfig.EntityConfig but with more useful types for actors.go.
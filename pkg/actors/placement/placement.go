@@ -59,6 +59,8 @@ type actorPlacement struct {
 	runtimeHostName string
 	// name of the pod hosting the actor
 	podName string
+	// topology is the failure domain of the runtime
+	topology hashing.Topology
 
 	// client is the placement client.
 	client *placementClient
@@ -100,6 +102,7 @@ type ActorPlacementOpts struct {
 	AppID              string
 	RuntimeHostname    string
 	PodName            string
+	Topology           hashing.Topology
 	ActorTypes         []string
 	AppHealthFn        func() bool
 	AfterTableUpdateFn func()
@@ -113,6 +116,7 @@ func NewActorPlacement(opts ActorPlacementOpts) internal.PlacementService {
 		appID:           opts.AppID,
 		runtimeHostName: opts.RuntimeHostname,
 		podName:         opts.PodName,
+		topology:        opts.Topology,
 		serverAddr:      servers,
 
 		client: newPlacementClient(getGrpcOptsGetter(servers, opts.CertChain)),
@@ -227,6 +231,13 @@ func (p *actorPlacement) Start(ctx context.Context) error {
 				Pod:      p.podName,
 				// Port is redundant because Name should include port number
 			}
+			if p.topology != (hashing.Topology{}) {
+				host.Topology = &v1pb.HostTopology{
+					Region: p.topology.Region,
+					Zone:   p.topology.Zone,
+					Node:   p.topology.Node,
+				}
+			}
 
 			err := p.client.send(&host)
 			if err != nil {
@@ -402,8 +413,18 @@ func (p *actorPlacement) updatePlacements(in *v1pb.PlacementTables) {
 			loadMap := map[string]*hashing.Host{}
 			for lk, lv := range v.LoadMap {
 				loadMap[lk] = hashing.NewHost(lv.Name, lv.Id, lv.Load, lv.Port)
+				if t := lv.GetTopology(); t != nil {
+					loadMap[lk].Topology = hashing.Topology{
+						Region: t.GetRegion(),
+						Zone:   t.GetZone(),
+						Node:   t.GetNode(),
+					}
+				}
 			}
-			tables.Entries[k] = hashing.NewFromExisting(v.Hosts, v.SortedSet, loadMap)
+			tables.Entries[k] = hashing.NewFromExisting(v.Hosts, v.SortedSet, loadMap, hashing.Options{
+				LoadFactor: v.LoadFactor,
+				ZonePolicy: hashing.ZonePolicy(v.ZonePolicy),
+			})
 		}
 
 		p.placementTables = tables
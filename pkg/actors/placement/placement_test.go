@@ -326,6 +326,35 @@ func TestLookupActor(t *testing.T) {
 		assert.Empty(t, name)
 		assert.Empty(t, appID)
 	})
+
+	t.Run("owner does not depend on the zone of the caller", func(t *testing.T) {
+		const testActorType = "actorOne"
+		defer func() {
+			testPlacement.topology = hashing.Topology{}
+		}()
+
+		hashing.SetReplicationFactor(10)
+		actorOneHashing := hashing.NewConsistentHashWithOptions(hashing.Options{ZonePolicy: hashing.ZonePolicySpread})
+		actorOneHashing.Add("127.0.0.1:1001", "appA", 0)
+		actorOneHashing.UpdateTopology("127.0.0.1:1001", hashing.Topology{Zone: "zone-a"})
+		actorOneHashing.Add("127.0.0.1:1002", "appB", 0)
+		actorOneHashing.UpdateTopology("127.0.0.1:1002", hashing.Topology{Zone: "zone-b"})
+		testPlacement.placementTables = &hashing.ConsistentHashTables{
+			Version: "2",
+			Entries: map[string]*hashing.Consistent{testActorType: actorOneHashing},
+		}
+
+		for i := 0; i < 10; i++ {
+			actorID := fmt.Sprintf("id%d", i)
+			testPlacement.topology = hashing.Topology{Zone: "zone-a"}
+			nameA, appIDA := testPlacement.LookupActor(testActorType, actorID)
+			testPlacement.topology = hashing.Topology{Zone: "zone-b"}
+			nameB, appIDB := testPlacement.LookupActor(testActorType, actorID)
+			assert.NotEmpty(t, nameA)
+			assert.Equal(t, nameA, nameB)
+			assert.Equal(t, appIDA, appIDB)
+		}
+	})
 }
 
 func TestConcurrentUnblockPlacements(t *testing.T) {
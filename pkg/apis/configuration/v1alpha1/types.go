@@ -71,6 +71,9 @@ type APISpec struct {
 	// List of denied APIs. Can be used in conjunction with allowed.
 	// +optional
 	Denied []APIAccessRule `json:"denied,omitempty"`
+	// Adaptive load shedding for groups of Dapr APIs.
+	// +optional
+	LoadShedding []APILoadSheddingRule `json:"loadShedding,omitempty"`
 }
 
 // APIAccessRule describes an access rule for allowing or denying a Dapr API.
@@ -81,6 +84,25 @@ type APIAccessRule struct {
 	Protocol string `json:"protocol,omitempty"`
 }
 
+// APILoadSheddingRule configures an adaptive concurrency limit for a group of Dapr APIs.
+// Requests exceeding the limit are rejected.
+type APILoadSheddingRule struct {
+	// Name of the API group, as in API access rules, e.g. "state", "publish", "invoke", or "actors".
+	Name string `json:"name"`
+	// Protocol the limit applies to, "http" or "grpc". Applies to both if empty.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// +optional
+	InitialLimit int `json:"initialLimit,omitempty"`
+	// +optional
+	MinLimit int `json:"minLimit,omitempty"`
+	// +optional
+	MaxLimit int `json:"maxLimit,omitempty"`
+	// Requests taking longer than this decrease the limit.
+	// +optional
+	LatencyThreshold string `json:"latencyThreshold,omitempty"`
+}
+
 // NameResolutionSpec is the spec for name resolution configuration.
 type NameResolutionSpec struct {
 	Component     string        `json:"component"`
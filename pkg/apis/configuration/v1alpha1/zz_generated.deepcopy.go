@@ -70,6 +70,21 @@ func (in *APILoggingSpec) DeepCopy() *APILoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APILoadSheddingRule) DeepCopyInto(out *APILoadSheddingRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APILoadSheddingRule.
+func (in *APILoadSheddingRule) DeepCopy() *APILoadSheddingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(APILoadSheddingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APISpec) DeepCopyInto(out *APISpec) {
 	*out = *in
@@ -83,6 +98,11 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 		*out = make([]APIAccessRule, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadShedding != nil {
+		in, out := &in.LoadShedding, &out.LoadShedding
+		*out = make([]APILoadSheddingRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpec.
@@ -48,6 +48,10 @@ type Policies struct {
 	Timeouts        map[string]string         `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
 	Retries         map[string]Retry          `json:"retries,omitempty" yaml:"retries,omitempty"`
 	CircuitBreakers map[string]CircuitBreaker `json:"circuitBreakers,omitempty" yaml:"circuitBreakers,omitempty"`
+	Bulkheads       map[string]Bulkhead       `json:"bulkheads,omitempty" yaml:"bulkheads,omitempty"`
+	RateLimits      map[string]RateLimit      `json:"rateLimits,omitempty" yaml:"rateLimits,omitempty"`
+	Hedging         map[string]Hedging        `json:"hedging,omitempty" yaml:"hedging,omitempty"`
+	Fallbacks       map[string]Fallback       `json:"fallbacks,omitempty" yaml:"fallbacks,omitempty"`
 }
 
 type Retry struct {
@@ -55,6 +59,33 @@ type Retry struct {
 	Duration    string `json:"duration,omitempty" yaml:"duration,omitempty"`
 	MaxInterval string `json:"maxInterval,omitempty" yaml:"maxInterval,omitempty"`
 	MaxRetries  *int   `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+
+	Matching *RetryMatching `json:"matching,omitempty" yaml:"matching,omitempty"`
+	Budget   *RetryBudget   `json:"budget,omitempty" yaml:"budget,omitempty"`
+}
+
+// RetryBudget limits the retries to a target to a share of the calls made to it, to prevent retry storms.
+// Retries beyond the budget are not performed.
+type RetryBudget struct {
+	// MaxRetryPercent is the maximum number of retries, as a percentage of the calls made to the target during the window.
+	MaxRetryPercent int `json:"maxRetryPercent" yaml:"maxRetryPercent"`
+	// Window is the duration of the sliding window over which calls and retries are counted. Defaults to 10s.
+	Window string `json:"window,omitempty" yaml:"window,omitempty"`
+	// MinRetriesPerSecond is the number of retries per second allowed regardless of the percentage, so targets receiving few calls can still be retried. Defaults to 10.
+	MinRetriesPerSecond *int `json:"minRetriesPerSecond,omitempty" yaml:"minRetriesPerSecond,omitempty"`
+}
+
+// RetryMatching restricts the errors a retry policy retries.
+// When a field is not set, all errors of that kind are retried.
+type RetryMatching struct {
+	// HTTPStatusCodes is a comma-separated list of HTTP status codes or ranges of codes, e.g. "429,500-599".
+	// They match the status codes returned by apps over HTTP to service invocations, input bindings, pub/sub
+	// subscriptions and configuration subscriptions. The status codes returned to actor invocations are not matched.
+	HTTPStatusCodes string `json:"httpStatusCodes,omitempty" yaml:"httpStatusCodes,omitempty"`
+	// GRPCStatusCodes is a comma-separated list of gRPC status codes, names or ranges of codes, e.g. "UNAVAILABLE,8-10".
+	GRPCStatusCodes string `json:"gRPCStatusCodes,omitempty" yaml:"gRPCStatusCodes,omitempty"`
+	// ErrorClasses is the list of classes of the other errors, e.g. "timeout" or "connection".
+	ErrorClasses []string `json:"errorClasses,omitempty" yaml:"errorClasses,omitempty"`
 }
 
 type CircuitBreaker struct {
@@ -64,6 +95,69 @@ type CircuitBreaker struct {
 	Trip        string `json:"trip,omitempty" yaml:"trip,omitempty"`
 }
 
+// Bulkhead limits the number of calls to a target which are executed concurrently.
+type Bulkhead struct {
+	// MaxConcurrentCalls is the maximum number of calls executed concurrently.
+	MaxConcurrentCalls int `json:"maxConcurrentCalls" yaml:"maxConcurrentCalls"`
+	// MaxQueueSize is the maximum number of calls waiting for another call to complete.
+	// Calls are rejected when the queue is full; with 0, calls are rejected as soon as the concurrency limit is reached.
+	MaxQueueSize int `json:"maxQueueSize,omitempty" yaml:"maxQueueSize,omitempty"`
+}
+
+// RateLimit limits the rate of calls to a target using a token bucket.
+type RateLimit struct {
+	// RequestsPerSecond is the number of calls allowed per second.
+	RequestsPerSecond int `json:"requestsPerSecond" yaml:"requestsPerSecond"`
+	// Burst is the maximum number of calls allowed at once. Defaults to RequestsPerSecond.
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+}
+
+// Hedging sends additional requests to other instances of an app when a request doesn't receive a response in time.
+// Only idempotent requests, matched by their HTTP verb or method, are hedged.
+// Requests are only hedged to instances returned by name resolution which haven't been sent the request yet,
+// so apps resolved to a single address, like a Kubernetes service, are not hedged.
+type Hedging struct {
+	// Delay is the time to wait for a response before sending another request, e.g. "100ms".
+	// When Percentile is set, it is used until enough requests have completed.
+	Delay string `json:"delay" yaml:"delay"`
+	// Percentile is the percentile of the latencies of recent requests to the app used as the delay, e.g. 95.
+	Percentile int `json:"percentile,omitempty" yaml:"percentile,omitempty"`
+	// MaxAttempts is the maximum number of requests sent, including the first one. Defaults to 2.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty"`
+	// Verbs is the list of HTTP verbs of idempotent requests, e.g. "GET".
+	Verbs []string `json:"verbs,omitempty" yaml:"verbs,omitempty"`
+	// Methods is the list of idempotent methods.
+	Methods []string `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+// Fallback is used when a call to a target fails because its circuit breaker is open, it timed out, it couldn't reach
+// the target, or it received a response with a 5xx HTTP status code. Fallbacks can't lead back to the target.
+// Exactly one of Response, AppID, or Component must be set.
+type Fallback struct {
+	// Response is a static response returned by service invocation calls to an app.
+	Response *FallbackResponse `json:"response,omitempty" yaml:"response,omitempty"`
+	// AppID is an alternate app invoked by service invocation calls.
+	AppID string `json:"appId,omitempty" yaml:"appId,omitempty"`
+	// Method is the method invoked on the alternate app. Defaults to the method of the call.
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+	// Component is an alternate component of the same type, used to get state and publish messages.
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
+	// OnCircuitBreakerOpen restricts the fallback to calls rejected because the circuit breaker is open.
+	OnCircuitBreakerOpen bool `json:"onCircuitBreakerOpen,omitempty" yaml:"onCircuitBreakerOpen,omitempty"`
+}
+
+// FallbackResponse is a static response returned by a fallback.
+type FallbackResponse struct {
+	// StatusCode is the HTTP status code of the response. Defaults to 200.
+	StatusCode int `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	// Headers are the headers of the response.
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// ContentType is the content type of the body.
+	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	// Body is the body of the response.
+	Body string `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
 type Targets struct {
 	Apps       map[string]EndpointPolicyNames  `json:"apps,omitempty" yaml:"apps,omitempty"`
 	Actors     map[string]ActorPolicyNames     `json:"actors,omitempty" yaml:"actors,omitempty"`
@@ -79,6 +173,9 @@ type PolicyNames struct {
 	Timeout        string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Retry          string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	Bulkhead       string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
+	RateLimit      string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	Fallback       string `json:"fallback,omitempty" yaml:"fallback,omitempty"`
 }
 
 type EndpointPolicyNames struct {
@@ -86,6 +183,10 @@ type EndpointPolicyNames struct {
 	Retry                   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
+	RateLimit               string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	Hedging                 string `json:"hedging,omitempty" yaml:"hedging,omitempty"`
+	Fallback                string `json:"fallback,omitempty" yaml:"fallback,omitempty"`
 }
 
 type ActorPolicyNames struct {
@@ -94,6 +195,8 @@ type ActorPolicyNames struct {
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerScope     string `json:"circuitBreakerScope,omitempty" yaml:"circuitBreakerScope,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
+	RateLimit               string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
 }
 
 // ResiliencyList represents a list of `Resiliency` items.
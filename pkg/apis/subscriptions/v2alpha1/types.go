@@ -47,6 +47,25 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional CloudEvent attribute or metadata key whose value limits the concurrency of deliveries.
+	// Messages with the same value are never delivered to the app concurrently. No delivery order is
+	// guaranteed, and messages with different values are only delivered concurrently if the pubsub
+	// component hands messages to the runtime concurrently.
+	// +optional
+	ConcurrencyKey string `json:"concurrencyKey,omitempty"`
+	// The optional duplicate detection settings for this topic.
+	// +optional
+	Deduplication Deduplication `json:"deduplication,omitempty"`
+	// The optional schema the data of the messages must match.
+	// Messages that do not match the schema are sent to the dead letter topic.
+	// +optional
+	Schema Schema `json:"schema,omitempty"`
+	// The optional maximum number of messages of this topic delivered to the app concurrently.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+	// The optional maximum number of messages of this topic delivered to the app per second.
+	// +optional
+	MaxMessagesPerSecond int32 `json:"maxMessagesPerSecond,omitempty"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
@@ -57,6 +76,32 @@ type BulkSubscribe struct {
 	MaxAwaitDurationMs int32 `json:"maxAwaitDurationMs,omitempty"`
 }
 
+// Deduplication encapsulates the duplicate detection configuration for a topic.
+// The IDs of the messages processed successfully by the app are recorded in the
+// state store, and messages with a recorded ID are dropped.
+type Deduplication struct {
+	// The state store where the IDs of processed messages are recorded.
+	StateStore string `json:"stateStore"`
+	// How long the IDs of processed messages are recorded for. Defaults to 24 hours.
+	// +optional
+	TTLInSeconds int32 `json:"ttlInSeconds,omitempty"`
+}
+
+// Schema encapsulates the schema the data of the messages of a topic must match.
+type Schema struct {
+	// The type of schema: "jsonschema" or "protobuf".
+	Type string `json:"type"`
+	// The self-contained JSON Schema document, for the jsonschema type.
+	// +optional
+	JSONSchema string `json:"jsonSchema,omitempty"`
+	// The base64-encoded FileDescriptorSet containing the message and its dependencies, for the protobuf type.
+	// +optional
+	ProtoDescriptorSet string `json:"protoDescriptorSet,omitempty"`
+	// The fully-qualified name of the message in the descriptor set, for the protobuf type.
+	// +optional
+	ProtoMessage string `json:"protoMessage,omitempty"`
+}
+
 // Routes encapsulates the rules and optional default path for a topic.
 type Routes struct {
 	// The list of rules for this topic.
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+)
+
+const (
+	defaultInitialLimit     = 20
+	defaultMinLimit         = 1
+	defaultMaxLimit         = 1000
+	defaultLatencyThreshold = time.Second
+	defaultBackoffRatio     = 0.9
+)
+
+// AdaptiveLimiterOptions contains the options for an AdaptiveLimiter.
+// Unset values are replaced with defaults.
+type AdaptiveLimiterOptions struct {
+	// Number of concurrent requests allowed initially. Default: 20.
+	InitialLimit int
+	// Lowest and highest values the limit can take. Defaults: 1 and 1000.
+	MinLimit int
+	MaxLimit int
+	// Requests taking longer than this are considered a sign of overload and decrease the limit. Default: 1s.
+	LatencyThreshold time.Duration
+	// Factor the limit is multiplied by when it's decreased, between 0 and 1. Default: 0.9.
+	BackoffRatio float64
+}
+
+// AdaptiveLimiter limits the number of concurrent requests, with a limit that adapts to the observed latency
+// using an additive-increase/multiplicative-decrease (AIMD) algorithm.
+// The limit grows by one for every request completing within the latency threshold while at least half of the
+// limit is in use, and is multiplied by the backoff ratio for every request exceeding it.
+type AdaptiveLimiter struct {
+	opts     AdaptiveLimiterOptions
+	clock    kclock.Clock
+	lock     sync.Mutex
+	limit    float64
+	inflight int
+}
+
+// NewAdaptiveLimiter returns a new AdaptiveLimiter.
+func NewAdaptiveLimiter(opts AdaptiveLimiterOptions) *AdaptiveLimiter {
+	if opts.MinLimit <= 0 {
+		opts.MinLimit = defaultMinLimit
+	}
+	if opts.MaxLimit <= 0 {
+		opts.MaxLimit = defaultMaxLimit
+	}
+	if opts.MaxLimit < opts.MinLimit {
+		opts.MaxLimit = opts.MinLimit
+	}
+	if opts.InitialLimit <= 0 {
+		opts.InitialLimit = defaultInitialLimit
+	}
+	if opts.LatencyThreshold <= 0 {
+		opts.LatencyThreshold = defaultLatencyThreshold
+	}
+	if opts.BackoffRatio <= 0 || opts.BackoffRatio >= 1 {
+		opts.BackoffRatio = defaultBackoffRatio
+	}
+
+	return &AdaptiveLimiter{
+		opts:  opts,
+		clock: &kclock.RealClock{},
+		limit: math.Min(math.Max(float64(opts.InitialLimit), float64(opts.MinLimit)), float64(opts.MaxLimit)),
+	}
+}
+
+// TryAcquire reserves a slot for a request if the limit has not been reached.
+// If ok is true, done must be invoked when the request completes, so its latency is used to adjust the limit.
+func (l *AdaptiveLimiter) TryAcquire() (done func(), ok bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inflight >= int(l.limit) {
+		return nil, false
+	}
+	l.inflight++
+
+	start := l.clock.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.release(l.clock.Since(start))
+		})
+	}, true
+}
+
+func (l *AdaptiveLimiter) release(latency time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if latency > l.opts.LatencyThreshold {
+		l.limit = math.Max(l.limit*l.opts.BackoffRatio, float64(l.opts.MinLimit))
+	} else if l.inflight*2 >= int(l.limit) {
+		l.limit = math.Min(l.limit+1, float64(l.opts.MaxLimit))
+	}
+	l.inflight--
+}
+
+// Limit returns the current concurrency limit.
+func (l *AdaptiveLimiter) Limit() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return int(l.limit)
+}
+
+// InFlight returns the number of requests currently holding a slot.
+func (l *AdaptiveLimiter) InFlight() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.inflight
+}
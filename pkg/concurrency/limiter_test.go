@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestAdaptiveLimiter(t *testing.T) {
+	newLimiter := func() (*AdaptiveLimiter, *clocktesting.FakeClock) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		l := NewAdaptiveLimiter(AdaptiveLimiterOptions{
+			InitialLimit:     4,
+			MinLimit:         2,
+			MaxLimit:         5,
+			LatencyThreshold: time.Second,
+			BackoffRatio:     0.5,
+		})
+		l.clock = clock
+		return l, clock
+	}
+
+	t.Run("rejects requests over the limit", func(t *testing.T) {
+		l, _ := newLimiter()
+		dones := make([]func(), 0, 4)
+		for i := 0; i < 4; i++ {
+			done, ok := l.TryAcquire()
+			require.True(t, ok)
+			dones = append(dones, done)
+		}
+		_, ok := l.TryAcquire()
+		assert.False(t, ok)
+		assert.Equal(t, 4, l.InFlight())
+
+		dones[0]()
+		// Invoking done more than once has no effect.
+		dones[0]()
+		assert.Equal(t, 3, l.InFlight())
+		done, ok := l.TryAcquire()
+		require.True(t, ok)
+		done()
+	})
+
+	t.Run("limit increases while latency is low", func(t *testing.T) {
+		l, _ := newLimiter()
+		done1, _ := l.TryAcquire()
+		done2, _ := l.TryAcquire()
+		done1()
+		assert.Equal(t, 5, l.Limit())
+		done2()
+		// The limit doesn't grow past the maximum.
+		assert.Equal(t, 5, l.Limit())
+	})
+
+	t.Run("limit decreases when latency is high", func(t *testing.T) {
+		l, clock := newLimiter()
+		done, _ := l.TryAcquire()
+		clock.Step(2 * time.Second)
+		done()
+		assert.Equal(t, 2, l.Limit())
+
+		done, _ = l.TryAcquire()
+		clock.Step(2 * time.Second)
+		done()
+		// The limit doesn't go below the minimum.
+		assert.Equal(t, 2, l.Limit())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		l := NewAdaptiveLimiter(AdaptiveLimiterOptions{})
+		assert.Equal(t, defaultInitialLimit, l.Limit())
+		assert.Equal(t, defaultLatencyThreshold, l.opts.LatencyThreshold)
+		assert.InDelta(t, defaultBackoffRatio, l.opts.BackoffRatio, 0)
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/dapr/dapr/pkg/buildinfo"
+	"github.com/dapr/dapr/pkg/concurrency"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/kit/ptr"
@@ -48,6 +49,8 @@ const (
 	// Enables support for setting TTL on Actor state keys. Remove this flag in
 	// Dapr 1.12.
 	ActorStateTTL Feature = "ActorStateTTL"
+	// Allows resetting and forcing open circuit breakers through the resiliency APIs.
+	ResiliencyCircuitBreakerControl Feature = "ResiliencyCircuitBreakerControl"
 )
 
 // end feature flags section
@@ -137,6 +140,8 @@ type APISpec struct {
 	Allowed APIAccessRules `json:"allowed,omitempty"`
 	// List of denied APIs. Can be used in conjunction with allowed.
 	Denied APIAccessRules `json:"denied,omitempty"`
+	// Adaptive load shedding for groups of APIs.
+	LoadShedding []APILoadSheddingRule `json:"loadShedding,omitempty" yaml:"loadShedding,omitempty"`
 }
 
 // APIAccessRule describes an access rule for allowing a Dapr API to be enabled and accessible by an app.
@@ -171,6 +176,40 @@ func (r APIAccessRules) GetRulesByProtocol(protocol APIAccessRuleProtocol) []API
 	return res[:n]
 }
 
+// APILoadSheddingRule configures an adaptive concurrency limit for a group of Dapr APIs.
+type APILoadSheddingRule struct {
+	// Name of the API group, as in API access rules.
+	Name string `json:"name" yaml:"name"`
+	// Protocol the limit applies to. Applies to both HTTP and gRPC if empty.
+	Protocol         APIAccessRuleProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	InitialLimit     int                   `json:"initialLimit,omitempty" yaml:"initialLimit,omitempty"`
+	MinLimit         int                   `json:"minLimit,omitempty" yaml:"minLimit,omitempty"`
+	MaxLimit         int                   `json:"maxLimit,omitempty" yaml:"maxLimit,omitempty"`
+	LatencyThreshold string                `json:"latencyThreshold,omitempty" yaml:"latencyThreshold,omitempty"`
+}
+
+// AppliesTo returns true if the rule applies to APIs served over the protocol.
+func (r APILoadSheddingRule) AppliesTo(protocol APIAccessRuleProtocol) bool {
+	return r.Protocol == "" || strings.ToLower(string(r.Protocol)) == string(protocol)
+}
+
+// LimiterOptions returns the options of the adaptive limiter configured by the rule.
+func (r APILoadSheddingRule) LimiterOptions() (concurrency.AdaptiveLimiterOptions, error) {
+	opts := concurrency.AdaptiveLimiterOptions{
+		InitialLimit: r.InitialLimit,
+		MinLimit:     r.MinLimit,
+		MaxLimit:     r.MaxLimit,
+	}
+	if r.LatencyThreshold != "" {
+		d, err := time.ParseDuration(r.LatencyThreshold)
+		if err != nil {
+			return opts, fmt.Errorf("invalid latency threshold for API group %s: %w", r.Name, err)
+		}
+		opts.LatencyThreshold = d
+	}
+	return opts, nil
+}
+
 type HandlerSpec struct {
 	Name         string       `json:"name,omitempty" yaml:"name,omitempty"`
 	Type         string       `json:"type,omitempty" yaml:"type,omitempty"`
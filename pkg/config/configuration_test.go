@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -174,6 +175,23 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 		assert.Equal(t, "1h", mtlsSpec.AllowedClockSkew)
 	})
 
+	t.Run("API load shedding", func(t *testing.T) {
+		config, err := LoadStandaloneConfiguration("./testdata/load_shedding_config.yaml")
+		require.NoError(t, err)
+		rules := config.GetAPISpec().LoadShedding
+		require.Len(t, rules, 2)
+		assert.Equal(t, "state", rules[0].Name)
+		assert.True(t, rules[0].AppliesTo(APIAccessRuleProtocolHTTP))
+		assert.True(t, rules[0].AppliesTo(APIAccessRuleProtocolGRPC))
+		opts, err := rules[0].LimiterOptions()
+		require.NoError(t, err)
+		assert.Equal(t, 10, opts.InitialLimit)
+		assert.Equal(t, 50, opts.MaxLimit)
+		assert.Equal(t, 500*time.Millisecond, opts.LatencyThreshold)
+		assert.False(t, rules[1].AppliesTo(APIAccessRuleProtocolHTTP))
+		assert.True(t, rules[1].AppliesTo(APIAccessRuleProtocolGRPC))
+	})
+
 	t.Run("multiple configurations", func(t *testing.T) {
 		config, err := LoadStandaloneConfiguration("./testdata/feature_config.yaml", "./testdata/mtls_config.yaml")
 		require.NoError(t, err)
@@ -17,6 +17,12 @@ const (
 	AppPort string = "APP_PORT"
 	// AppID is the ID of the application.
 	AppID string = "APP_ID"
+	// TopologyRegion is the region of the host, used for topology-aware actor placement.
+	TopologyRegion string = "DAPR_TOPOLOGY_REGION"
+	// TopologyZone is the zone of the host, used for topology-aware actor placement.
+	TopologyZone string = "DAPR_TOPOLOGY_ZONE"
+	// TopologyNode is the node of the host, used for topology-aware actor placement.
+	TopologyNode string = "DAPR_TOPOLOGY_NODE"
 	// OpenTelemetry target URL for OTLP exporter
 	OtlpExporterEndpoint string = "OTEL_EXPORTER_OTLP_ENDPOINT"
 	// OpenTelemetry disables client transport security
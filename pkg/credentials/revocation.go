@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// RevocationList holds the SPIFFE IDs and certificate serial numbers revoked by Sentry.
+// It is safe for concurrent use.
+type RevocationList struct {
+	lock      sync.RWMutex
+	spiffeIDs map[string]struct{}
+	serials   map[string]struct{}
+}
+
+// NewRevocationList returns an empty RevocationList.
+func NewRevocationList() *RevocationList {
+	return &RevocationList{
+		spiffeIDs: map[string]struct{}{},
+		serials:   map[string]struct{}{},
+	}
+}
+
+// Update replaces the content of the revocation list.
+func (r *RevocationList) Update(spiffeIDs, serials []string) {
+	ids := make(map[string]struct{}, len(spiffeIDs))
+	for _, id := range spiffeIDs {
+		ids[id] = struct{}{}
+	}
+	srs := make(map[string]struct{}, len(serials))
+	for _, s := range serials {
+		srs[NormalizeSerial(s)] = struct{}{}
+	}
+
+	r.lock.Lock()
+	r.spiffeIDs = ids
+	r.serials = srs
+	r.lock.Unlock()
+}
+
+// Entries returns the revoked SPIFFE IDs and serial numbers.
+func (r *RevocationList) Entries() (spiffeIDs []string, serials []string) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	spiffeIDs = make([]string, 0, len(r.spiffeIDs))
+	for id := range r.spiffeIDs {
+		spiffeIDs = append(spiffeIDs, id)
+	}
+	serials = make([]string, 0, len(r.serials))
+	for s := range r.serials {
+		serials = append(serials, s)
+	}
+	return spiffeIDs, serials
+}
+
+// IsIDRevoked returns true if the SPIFFE ID is revoked.
+func (r *RevocationList) IsIDRevoked(spiffeID string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	_, ok := r.spiffeIDs[spiffeID]
+	return ok
+}
+
+// IsRevoked returns true if the certificate serial number or any of its SPIFFE IDs is revoked.
+func (r *RevocationList) IsRevoked(cert *x509.Certificate) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if len(r.spiffeIDs) == 0 && len(r.serials) == 0 {
+		return false
+	}
+
+	if cert.SerialNumber != nil {
+		if _, ok := r.serials[SerialString(cert.SerialNumber)]; ok {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if _, ok := r.spiffeIDs[uri.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyPeerCertificate can be used as tls.Config.VerifyPeerCertificate to refuse
+// connections from peers presenting a revoked certificate.
+// It must be used together with the default certificate verification.
+func (r *RevocationList) VerifyPeerCertificate(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	for _, chain := range verifiedChains {
+		for _, cert := range chain {
+			if r.IsRevoked(cert) {
+				return fmt.Errorf("certificate with serial number %s has been revoked", SerialString(cert.SerialNumber))
+			}
+		}
+	}
+	return nil
+}
+
+// SerialString returns the serial number as a lowercase hex string.
+func SerialString(serial *big.Int) string {
+	if serial == nil {
+		return ""
+	}
+	return serial.Text(16)
+}
+
+// NormalizeSerial normalizes a hex-encoded serial number, which may be separated
+// by colons, to the format returned by SerialString.
+func NormalizeSerial(serial string) string {
+	s := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(serial), ":", ""))
+	s = strings.TrimPrefix(s, "0x")
+	if n, ok := new(big.Int).SetString(s, 16); ok {
+		return n.Text(16)
+	}
+	return s
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"crypto/x509"
+	"math/big"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevocationList(t *testing.T) {
+	spiffeID, _ := url.Parse("spiffe://public/ns/default/app1")
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(0xabcdef),
+		URIs:         []*url.URL{spiffeID},
+	}
+
+	r := NewRevocationList()
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.False(t, r.IsRevoked(cert))
+		assert.NoError(t, r.VerifyPeerCertificate(nil, [][]*x509.Certificate{{cert}}))
+	})
+
+	t.Run("revoked spiffe id", func(t *testing.T) {
+		r.Update([]string{"spiffe://public/ns/default/app1"}, nil)
+		assert.True(t, r.IsIDRevoked("spiffe://public/ns/default/app1"))
+		assert.True(t, r.IsRevoked(cert))
+		assert.Error(t, r.VerifyPeerCertificate(nil, [][]*x509.Certificate{{cert}}))
+	})
+
+	t.Run("revoked serial number", func(t *testing.T) {
+		r.Update(nil, []string{"00:AB:CD:EF"})
+		assert.False(t, r.IsIDRevoked("spiffe://public/ns/default/app1"))
+		assert.True(t, r.IsRevoked(cert))
+
+		ids, serials := r.Entries()
+		assert.Empty(t, ids)
+		assert.Equal(t, []string{"abcdef"}, serials)
+	})
+
+	t.Run("other certificate", func(t *testing.T) {
+		other := &x509.Certificate{SerialNumber: big.NewInt(1)}
+		assert.False(t, r.IsRevoked(other))
+	})
+}
+
+func TestNormalizeSerial(t *testing.T) {
+	assert.Equal(t, "abcdef", NormalizeSerial("AB:CD:EF"))
+	assert.Equal(t, "abcdef", NormalizeSerial("0x00abcdef"))
+	assert.Equal(t, SerialString(big.NewInt(255)), NormalizeSerial("ff"))
+}
@@ -43,11 +43,13 @@ type componentMetrics struct {
 	bulkPubsubEgressCount       *stats.Int64Measure
 	bulkPubsubEventEgressCount  *stats.Int64Measure
 	bulkPubsubEgressLatency     *stats.Float64Measure
+	pubsubIngressDuplicateCount *stats.Int64Measure
 
-	inputBindingCount    *stats.Int64Measure
-	inputBindingLatency  *stats.Float64Measure
-	outputBindingCount   *stats.Int64Measure
-	outputBindingLatency *stats.Float64Measure
+	inputBindingCount          *stats.Int64Measure
+	inputBindingLatency        *stats.Float64Measure
+	inputBindingDuplicateCount *stats.Int64Measure
+	outputBindingCount         *stats.Int64Measure
+	outputBindingLatency       *stats.Float64Measure
 
 	stateCount   *stats.Int64Measure
 	stateLatency *stats.Float64Measure
@@ -109,6 +111,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/pubsub_egress/bulk/latencies",
 			"The latency of the response for the bulk publish call from the pub/sub component.",
 			stats.UnitMilliseconds),
+		pubsubIngressDuplicateCount: stats.Int64(
+			"component/pubsub_ingress/duplicate_count",
+			"The number of incoming messages from the pub/sub component dropped as duplicates.",
+			stats.UnitDimensionless),
 		inputBindingCount: stats.Int64(
 			"component/input_binding/count",
 			"The number of incoming events arriving from the input binding component.",
@@ -117,6 +123,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/input_binding/latencies",
 			"The triggered app event processing latency.",
 			stats.UnitMilliseconds),
+		inputBindingDuplicateCount: stats.Int64(
+			"component/input_binding/duplicate_count",
+			"The number of incoming events from the input binding component dropped as duplicates.",
+			stats.UnitDimensionless),
 		outputBindingCount: stats.Int64(
 			"component/output_binding/count",
 			"The number of operations invoked on the output binding component.",
@@ -174,8 +184,10 @@ func (c *componentMetrics) Init(appID, namespace string) error {
 		diagUtils.NewMeasureView(c.bulkPubsubEventIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.pubsubEgressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, defaultLatencyDistribution),
 		diagUtils.NewMeasureView(c.pubsubEgressCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey, topicKey}, view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressDuplicateCount, []tag.Key{appIDKey, componentKey, namespaceKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.inputBindingLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, defaultLatencyDistribution),
 		diagUtils.NewMeasureView(c.inputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.inputBindingDuplicateCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
 		diagUtils.NewMeasureView(c.outputBindingLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, defaultLatencyDistribution),
 		diagUtils.NewMeasureView(c.outputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.stateLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, defaultLatencyDistribution),
@@ -274,6 +286,16 @@ func (c *componentMetrics) PubsubEgressEvent(ctx context.Context, component, top
 	}
 }
 
+// PubsubIngressDuplicate records a message from a pub/sub component dropped as a duplicate.
+func (c *componentMetrics) PubsubIngressDuplicate(ctx context.Context, component, topic string) {
+	if c.enabled {
+		stats.RecordWithTags(
+			ctx,
+			diagUtils.WithTags(c.pubsubIngressDuplicateCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, topic),
+			c.pubsubIngressDuplicateCount.M(1))
+	}
+}
+
 // InputBindingEvent records the metrics for an input binding event.
 func (c *componentMetrics) InputBindingEvent(ctx context.Context, component string, success bool, elapsed float64) {
 	if c.enabled {
@@ -291,6 +313,16 @@ func (c *componentMetrics) InputBindingEvent(ctx context.Context, component stri
 	}
 }
 
+// InputBindingDuplicate records an event from an input binding dropped as a duplicate.
+func (c *componentMetrics) InputBindingDuplicate(ctx context.Context, component string) {
+	if c.enabled {
+		stats.RecordWithTags(
+			ctx,
+			diagUtils.WithTags(c.inputBindingDuplicateCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace),
+			c.inputBindingDuplicateCount.M(1))
+	}
+}
+
 // OutputBindingEvent records the metrics for an output binding event.
 func (c *componentMetrics) OutputBindingEvent(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
@@ -45,6 +45,17 @@ func TestPubSub(t *testing.T) {
 		assert.Equal(t, float64(1), viewData[0].Data.(*view.DistributionData).Min)
 	})
 
+	t.Run("record ingress duplicate count", func(t *testing.T) {
+		c := componentsMetrics()
+
+		c.PubsubIngressDuplicate(context.Background(), componentName, "A")
+
+		viewData, _ := view.RetrieveData("component/pubsub_ingress/duplicate_count")
+		v := view.Find("component/pubsub_ingress/duplicate_count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+
 	t.Run("record egress latency", func(t *testing.T) {
 		c := componentsMetrics()
 
@@ -71,6 +82,17 @@ func TestBindings(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 	})
 
+	t.Run("record input binding duplicate count", func(t *testing.T) {
+		c := componentsMetrics()
+
+		c.InputBindingDuplicate(context.Background(), componentName)
+
+		viewData, _ := view.RetrieveData("component/input_binding/duplicate_count")
+		v := view.Find("component/input_binding/duplicate_count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+
 	t.Run("record input binding latency", func(t *testing.T) {
 		c := componentsMetrics()
 
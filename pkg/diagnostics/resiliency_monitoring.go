@@ -14,6 +14,9 @@ var (
 	CircuitBreakerPolicy PolicyType = "circuitbreaker"
 	RetryPolicy          PolicyType = "retry"
 	TimeoutPolicy        PolicyType = "timeout"
+	BulkheadPolicy       PolicyType = "bulkhead"
+	RateLimitPolicy      PolicyType = "ratelimit"
+	RetryBudgetPolicy    PolicyType = "retrybudget"
 
 	OutboundPolicyFlowDirection PolicyFlowDirection = "outbound"
 	InboundPolicyFlowDirection  PolicyFlowDirection = "inbound"
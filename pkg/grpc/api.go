@@ -43,6 +43,7 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/grpc/metadata"
 	"github.com/dapr/dapr/pkg/grpc/universalapi"
 	"github.com/dapr/dapr/pkg/messages"
@@ -54,7 +55,9 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/outbox"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 	"github.com/dapr/dapr/utils"
 )
 
@@ -84,6 +87,7 @@ type api struct {
 	tracingSpec           config.TracingSpec
 	accessControlList     *config.AccessControlList
 	compStore             *compstore.ComponentStore
+	outbox                *outbox.Outbox
 }
 
 // APIOpts contains options for NewAPI.
@@ -102,6 +106,9 @@ type APIOpts struct {
 	GetComponentsCapabilitiesFn func() map[string][]string
 	AppConnectionConfig         config.AppConnectionConfig
 	GlobalConfig                *config.Configuration
+	Outbox                      *outbox.Outbox
+	SubscriptionPauser          universalapi.SubscriptionPauser
+	InputBindingPauser          universalapi.InputBindingPauser
 }
 
 // NewAPI returns a new gRPC API.
@@ -117,6 +124,8 @@ func NewAPI(opts APIOpts) API {
 			GetComponentsCapabilitesFn: opts.GetComponentsCapabilitiesFn,
 			AppConnectionConfig:        opts.AppConnectionConfig,
 			GlobalConfig:               opts.GlobalConfig,
+			SubscriptionPauser:         opts.SubscriptionPauser,
+			InputBindingPauser:         opts.InputBindingPauser,
 		},
 		directMessaging:       opts.DirectMessaging,
 		resiliency:            opts.Resiliency,
@@ -126,6 +135,7 @@ func NewAPI(opts APIOpts) API {
 		tracingSpec:           opts.TracingSpec,
 		accessControlList:     opts.AccessControlList,
 		compStore:             opts.CompStore,
+		outbox:                opts.Outbox,
 	}
 }
 
@@ -223,6 +233,10 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			nerr = status.Errorf(codes.PermissionDenied, err.Error())
 		}
 
+		if errors.As(err, &runtimePubsub.SchemaValidationError{}) {
+			nerr = status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
 		if errors.As(err, &runtimePubsub.NotFoundError{}) {
 			nerr = status.Errorf(codes.NotFound, err.Error())
 		}
@@ -248,6 +262,21 @@ var (
 	invokeServiceHTTPDeprecationNoticeShown = atomic.Bool{}
 )
 
+// directInvokeError is the error of a service invocation which couldn't reach the target app.
+// It wraps the underlying error, so resiliency policies can match on it.
+type directInvokeError struct {
+	apiErr messages.APIError
+	err    error
+}
+
+func (e directInvokeError) Error() string {
+	return e.apiErr.Error()
+}
+
+func (e directInvokeError) Unwrap() error {
+	return e.err
+}
+
 // Deprecated: Use proxy mode service invocation instead.
 func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error) {
 	if a.directMessaging == nil {
@@ -260,9 +289,14 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	policyDef := a.resiliency.EndpointPolicy(in.Id, in.Id+":"+in.Message.Method)
 
 	req := invokev1.FromInvokeRequestMessage(in.GetMessage())
+	fallback := a.resiliency.EndpointFallbackPolicy(in.Id)
 	if policyDef != nil {
 		req.WithReplay(policyDef.HasRetries())
 	}
+	if fallback != nil && fallback.AppID != "" {
+		// The request is sent again to the alternate app
+		req.WithReplay(true)
+	}
 	defer req.Close()
 
 	if incomingMD, ok := metadata.FromIncomingContext(ctx); ok {
@@ -270,9 +304,8 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	}
 
 	policyRunner := resiliency.NewRunner[*invokeServiceResp](ctx, policyDef)
-	resp, err := policyRunner(func(ctx context.Context) (*invokeServiceResp, error) {
+	toResp := func(ctx context.Context, imr *invokev1.InvokeMethodResponse, rErr error) (*invokeServiceResp, error) {
 		rResp := &invokeServiceResp{}
-		imr, rErr := a.directMessaging.Invoke(ctx, in.Id, req)
 		if imr != nil {
 			// Read the entire message in memory then close imr
 			pd, pdErr := imr.ProtoWithData()
@@ -287,7 +320,10 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 			}
 		}
 		if rErr != nil {
-			return rResp, messages.ErrDirectInvoke.WithFormat(in.Id, rErr)
+			return rResp, directInvokeError{
+				apiErr: messages.ErrDirectInvoke.WithFormat(in.Id, rErr),
+				err:    rErr,
+			}
 		}
 
 		rResp.headers = invokev1.InternalMetadataToGrpcMetadata(ctx, imr.Headers(), true)
@@ -303,6 +339,10 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 			code := int(imr.Status().Code)
 			// If the status is OK, will be nil
 			rErr = invokev1.ErrorFromHTTPResponseCode(code, errorMessage)
+			if rErr != nil {
+				// The status code is included in the error so resiliency policies can match on it
+				rErr = resiliency.HTTPStatusError{StatusCode: code, Err: rErr}
+			}
 			// Populate http status code to header
 			rResp.headers.Set(daprHTTPStatusHeader, strconv.Itoa(code))
 		} else {
@@ -313,8 +353,24 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		}
 
 		return rResp, rErr
+	}
+	resp, err := policyRunner(func(ctx context.Context) (*invokeServiceResp, error) {
+		imr, rErr := a.directMessaging.Invoke(ctx, in.Id, req)
+		return toResp(ctx, imr, rErr)
 	})
 
+	// If the call failed, degrade gracefully with the fallback of the target
+	if fallback.ShouldFallback(err) {
+		apiServerLogger.Debugf("Invocation of %s failed, using fallback %s: %v", in.Id, fallback.Name, err)
+		if fallback.Response != nil {
+			resp, err = toResp(ctx, messaging.FallbackResponse(fallback.Response), nil)
+		} else {
+			messaging.WithFallbackMethod(req, fallback)
+			imr, rErr := a.directMessaging.Invoke(ctx, fallback.AppID, req)
+			resp, err = toResp(ctx, imr, rErr)
+		}
+	}
+
 	var message *commonv1pb.InvokeResponse
 	if resp != nil {
 		if resp.headers != nil {
@@ -326,6 +382,17 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		message = resp.message
 	}
 
+	// Return the errors of the invocation which were wrapped for resiliency policies as-is
+	var (
+		dErr    directInvokeError
+		httpErr resiliency.HTTPStatusError
+	)
+	if errors.As(err, &dErr) {
+		err = dErr.apiErr
+	} else if errors.As(err, &httpErr) && httpErr.Err != nil {
+		err = httpErr.Err
+	}
+
 	// In this case, there was an error with the actual request or a resiliency policy stopped the request.
 	if err != nil {
 		// Check if it's returned by status.Errorf
@@ -446,6 +513,10 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 			nerr = status.Errorf(codes.PermissionDenied, err.Error())
 		}
 
+		if errors.As(err, &runtimePubsub.SchemaValidationError{}) {
+			nerr = status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
 		if errors.As(err, &runtimePubsub.NotFoundError{}) {
 			nerr = status.Errorf(codes.NotFound, err.Error())
 		}
@@ -467,6 +538,110 @@ func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *runtimev1pb.BulkPu
 	return &bulkRes, nil
 }
 
+// CancelDelayedMessageAlpha1 cancels a message published with a delay which has not been published yet.
+func (a *api) CancelDelayedMessageAlpha1(ctx context.Context, in *runtimev1pb.CancelDelayedMessageRequestAlpha1) (*emptypb.Empty, error) {
+	if a.pubsubAdapter == nil {
+		err := status.Error(codes.FailedPrecondition, messages.ErrPubsubNotConfigured)
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+	canceler, ok := a.pubsubAdapter.(runtimePubsub.DelayedMessageCanceler)
+	if !ok {
+		err := status.Error(codes.Unimplemented, "delayed messages are not supported")
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	if in.PubsubName == "" {
+		err := status.Error(codes.InvalidArgument, messages.ErrPubsubEmpty)
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+	if in.MessageId == "" {
+		err := status.Error(codes.InvalidArgument, messages.ErrPubsubMessageIDEmpty)
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	err := canceler.CancelDelayedMessage(ctx, in.PubsubName, in.MessageId)
+	if err != nil {
+		nerr := status.Errorf(codes.Internal, messages.ErrPubsubCancelDelayed, in.MessageId, in.PubsubName, err.Error())
+		if errors.As(err, &runtimePubsub.NotFoundError{}) {
+			nerr = status.Errorf(codes.NotFound, err.Error())
+		} else if errors.Is(err, delayed.ErrNotFound) {
+			nerr = status.Errorf(codes.NotFound, messages.ErrPubsubDelayedNotFound, in.MessageId, in.PubsubName)
+		}
+		apiServerLogger.Debug(nerr)
+		return &emptypb.Empty{}, nerr
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (a *api) ReplayDeadLettersAlpha1(ctx context.Context, in *runtimev1pb.ReplayDeadLettersRequestAlpha1) (*runtimev1pb.ReplayDeadLettersResponseAlpha1, error) {
+	if a.pubsubAdapter == nil {
+		err := status.Error(codes.FailedPrecondition, messages.ErrPubsubNotConfigured)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, err
+	}
+	replayer, ok := a.pubsubAdapter.(runtimePubsub.DeadLetterReplayer)
+	if !ok {
+		err := status.Error(codes.Unimplemented, "replaying dead letter topics is not supported")
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, err
+	}
+
+	if in.PubsubName == "" {
+		err := status.Error(codes.InvalidArgument, messages.ErrPubsubEmpty)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, err
+	}
+	if in.DeadLetterTopic == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrTopicEmpty, in.PubsubName)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, err
+	}
+	if in.MaxMessages <= 0 {
+		err := status.Error(codes.InvalidArgument, messages.ErrPubsubReplayMaxMessages)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, err
+	}
+
+	req := runtimePubsub.ReplayRequest{
+		PubsubName:      in.PubsubName,
+		DeadLetterTopic: in.DeadLetterTopic,
+		Topic:           in.Topic,
+		Metadata:        in.Metadata,
+		MaxMessages:     int(in.MaxMessages),
+		IdleTimeout:     time.Duration(in.IdleTimeoutSeconds) * time.Second,
+	}
+	if in.Filter != "" {
+		req.Filter = &expr.Expr{}
+		if err := req.Filter.DecodeString(in.Filter); err != nil {
+			nerr := status.Errorf(codes.InvalidArgument, messages.ErrPubsubReplayFilter, err.Error())
+			apiServerLogger.Debug(nerr)
+			return &runtimev1pb.ReplayDeadLettersResponseAlpha1{}, nerr
+		}
+	}
+
+	replayed, err := replayer.ReplayDeadLetters(ctx, req)
+	res := &runtimev1pb.ReplayDeadLettersResponseAlpha1{ReplayedCount: int32(replayed)}
+	if err != nil {
+		nerr := status.Errorf(codes.Internal, messages.ErrPubsubReplay, in.DeadLetterTopic, in.PubsubName, err.Error())
+		if errors.As(err, &runtimePubsub.NotAllowedError{}) {
+			nerr = status.Errorf(codes.PermissionDenied, err.Error())
+		}
+
+		if errors.As(err, &runtimePubsub.NotFoundError{}) {
+			nerr = status.Errorf(codes.NotFound, err.Error())
+		}
+		apiServerLogger.Debug(nerr)
+		return res, nerr
+	}
+
+	return res, nil
+}
+
 func (a *api) InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRequest) (*runtimev1pb.InvokeBindingResponse, error) {
 	req := &bindings.InvokeRequest{
 		Metadata:  make(map[string]string, len(in.Metadata)),
@@ -610,8 +785,12 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.StoreName, diag.Get, err == nil, elapsed)
 
+	storeName := in.StoreName
 	if err != nil {
-		err = status.Errorf(codes.Internal, messages.ErrStateGet, in.Key, in.StoreName, err.Error())
+		getResponse, storeName, err = a.UniversalAPI.GetStateFallback(ctx, storeName, in.Key, req, err)
+	}
+	if err != nil {
+		err = status.Errorf(codes.Internal, messages.ErrStateGet, in.Key, storeName, err.Error())
 		a.UniversalAPI.Logger.Debug(err)
 		return &runtimev1pb.GetStateResponse{}, err
 	}
@@ -619,10 +798,10 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 	if getResponse == nil {
 		getResponse = &state.GetResponse{}
 	}
-	if encryption.EncryptedStateStore(in.StoreName) {
-		val, err := encryption.TryDecryptValue(in.StoreName, getResponse.Data)
+	if encryption.EncryptedStateStore(storeName) {
+		val, err := encryption.TryDecryptValue(storeName, getResponse.Data)
 		if err != nil {
-			err = status.Errorf(codes.Internal, messages.ErrStateGet, in.Key, in.StoreName, err.Error())
+			err = status.Errorf(codes.Internal, messages.ErrStateGet, in.Key, storeName, err.Error())
 			a.UniversalAPI.Logger.Debug(err)
 			return &runtimev1pb.GetStateResponse{}, err
 		}
@@ -909,10 +1088,22 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		}
 	}
 
+	// Publish intents are created from the values before they're encrypted
+	var outboxIntents []state.TransactionalStateOperation
+	if a.outbox != nil {
+		var err error
+		outboxIntents, err = a.outbox.Intents(in.StoreName, operations)
+		if err != nil {
+			err = status.Errorf(codes.Internal, messages.ErrStateTransaction, err.Error())
+			apiServerLogger.Debug(err)
+			return &emptypb.Empty{}, err
+		}
+	}
+
 	if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
 		max := maxMulti.MultiMaxSize()
-		if max > 0 && len(operations) > max {
-			err := messages.ErrStateTooManyTransactionalOp.WithFormat(len(operations), max)
+		if count := len(operations) + len(outboxIntents); max > 0 && count > max {
+			err := messages.ErrStateTooManyTransactionalOp.WithFormat(count, max)
 			apiServerLogger.Debug(err)
 			return &emptypb.Empty{}, err
 		}
@@ -936,6 +1127,8 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		}
 	}
 
+	operations = append(operations, outboxIntents...)
+
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[struct{}](ctx,
 		a.resiliency.ComponentOutboundPolicy(in.StoreName, resiliency.Statestore),
@@ -956,6 +1149,9 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
 	}
+	if a.outbox != nil {
+		a.outbox.Enqueue(in.StoreName, outboxIntents)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// SubscribeTopicEventsAlpha1 subscribes to a topic for as long as the stream is open.
+// The first message must be the initial request, and all subsequent messages are the
+// responses to the topic events sent to the app.
+func (a *api) SubscribeTopicEventsAlpha1(stream runtimev1pb.Dapr_SubscribeTopicEventsAlpha1Server) error { //nolint:nosnakecase
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	initial := req.GetInitialRequest()
+	if initial == nil {
+		err = status.Errorf(codes.InvalidArgument, messages.ErrPubsubStreamRequest, "the first message must be the initial request")
+		apiServerLogger.Debug(err)
+		return err
+	}
+
+	if a.pubsubAdapter == nil {
+		err = status.Error(codes.FailedPrecondition, messages.ErrPubsubNotConfigured)
+		apiServerLogger.Debug(err)
+		return err
+	}
+	subscriber, ok := a.pubsubAdapter.(runtimePubsub.StreamSubscriber)
+	if !ok {
+		err = status.Error(codes.Unimplemented, "streaming subscriptions are not supported")
+		apiServerLogger.Debug(err)
+		return err
+	}
+
+	if initial.PubsubName == "" {
+		err = status.Error(codes.InvalidArgument, messages.ErrPubsubEmpty)
+		apiServerLogger.Debug(err)
+		return err
+	}
+	if initial.Topic == "" {
+		err = status.Errorf(codes.InvalidArgument, messages.ErrTopicEmpty, initial.PubsubName)
+		apiServerLogger.Debug(err)
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	s := &topicEventStream{
+		stream:  stream,
+		pending: make(map[string]chan *runtimev1pb.TopicEventResponse),
+	}
+	err = subscriber.SubscribeStream(ctx, runtimePubsub.StreamSubscription{
+		PubsubName:      initial.PubsubName,
+		Topic:           initial.Topic,
+		Metadata:        initial.Metadata,
+		DeadLetterTopic: initial.GetDeadLetterTopic(),
+	}, s.deliver)
+	if err != nil {
+		nerr := status.Errorf(codes.Internal, messages.ErrPubsubSubscribe, initial.Topic, initial.PubsubName, err.Error())
+		if errors.As(err, &runtimePubsub.NotAllowedError{}) {
+			nerr = status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.As(err, &runtimePubsub.NotFoundError{}) {
+			nerr = status.Errorf(codes.NotFound, err.Error())
+		}
+		apiServerLogger.Debug(nerr)
+		return nerr
+	}
+
+	for {
+		req, err = stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				return nil
+			}
+			return err
+		}
+
+		res := req.GetEventResponse()
+		if res == nil {
+			err = status.Errorf(codes.InvalidArgument, messages.ErrPubsubStreamRequest, "messages after the initial request must be event responses")
+			apiServerLogger.Debug(err)
+			return err
+		}
+		s.respond(res)
+	}
+}
+
+// topicEventStream sends topic events to an app over a stream and matches them with the app's responses.
+type topicEventStream struct {
+	stream   runtimev1pb.Dapr_SubscribeTopicEventsAlpha1Server //nolint:nosnakecase
+	sendLock sync.Mutex
+
+	lock    sync.Mutex
+	pending map[string]chan *runtimev1pb.TopicEventResponse
+}
+
+func (s *topicEventStream) deliver(ctx context.Context, req *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+	ch := make(chan *runtimev1pb.TopicEventResponse, 1)
+	s.lock.Lock()
+	if _, ok := s.pending[req.Id]; ok {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("event %s is already being processed by the app", req.Id)
+	}
+	s.pending[req.Id] = ch
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		delete(s.pending, req.Id)
+		s.lock.Unlock()
+	}()
+
+	s.sendLock.Lock()
+	err := s.stream.Send(req)
+	s.sendLock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("error sending event to the app: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		return res, nil
+	}
+}
+
+func (s *topicEventStream) respond(res *runtimev1pb.SubscribeTopicEventsResponseAlpha1) {
+	s.lock.Lock()
+	ch, ok := s.pending[res.Id]
+	s.lock.Unlock()
+	if !ok {
+		apiServerLogger.Debugf("Ignoring response for unknown event %s", res.Id)
+		return
+	}
+
+	select {
+	case ch <- res.GetStatus():
+	default:
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/grpc/universalapi"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type streamPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	subscribeFn func(ctx context.Context, sub runtimePubsub.StreamSubscription, handler runtimePubsub.StreamHandler) error
+}
+
+func (a *streamPubSubAdapter) SubscribeStream(ctx context.Context, sub runtimePubsub.StreamSubscription, handler runtimePubsub.StreamHandler) error {
+	return a.subscribeFn(ctx, sub, handler)
+}
+
+func TestSubscribeTopicEventsAlpha1(t *testing.T) {
+	initialRequest := &runtimev1pb.SubscribeTopicEventsRequestAlpha1{
+		SubscribeTopicEventsRequestType: &runtimev1pb.SubscribeTopicEventsRequestAlpha1_InitialRequest{
+			InitialRequest: &runtimev1pb.SubscribeTopicEventsInitialRequestAlpha1{
+				PubsubName: "pubsub",
+				Topic:      "topic",
+			},
+		},
+	}
+
+	newClient := func(t *testing.T, srv *api) runtimev1pb.DaprClient {
+		server, lis := startDaprAPIServer(srv, "")
+		t.Cleanup(server.Stop)
+		clientConn := createTestClient(lis)
+		t.Cleanup(func() { clientConn.Close() })
+		return runtimev1pb.NewDaprClient(clientConn)
+	}
+
+	t.Run("first message must be the initial request", func(t *testing.T) {
+		client := newClient(t, &api{
+			UniversalAPI:  &universalapi.UniversalAPI{AppID: "fakeAPI"},
+			pubsubAdapter: &streamPubSubAdapter{},
+		})
+
+		stream, err := client.SubscribeTopicEventsAlpha1(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&runtimev1pb.SubscribeTopicEventsRequestAlpha1{
+			SubscribeTopicEventsRequestType: &runtimev1pb.SubscribeTopicEventsRequestAlpha1_EventResponse{
+				EventResponse: &runtimev1pb.SubscribeTopicEventsResponseAlpha1{Id: "1"},
+			},
+		}))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("adapter without streaming support", func(t *testing.T) {
+		client := newClient(t, &api{
+			UniversalAPI:  &universalapi.UniversalAPI{AppID: "fakeAPI"},
+			pubsubAdapter: &daprt.MockPubSubAdapter{},
+		})
+
+		stream, err := client.SubscribeTopicEventsAlpha1(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(initialRequest))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("subscription not allowed", func(t *testing.T) {
+		client := newClient(t, &api{
+			UniversalAPI: &universalapi.UniversalAPI{AppID: "fakeAPI"},
+			pubsubAdapter: &streamPubSubAdapter{
+				subscribeFn: func(context.Context, runtimePubsub.StreamSubscription, runtimePubsub.StreamHandler) error {
+					return runtimePubsub.NotAllowedError{Topic: "topic", ID: "fakeAPI"}
+				},
+			},
+		})
+
+		stream, err := client.SubscribeTopicEventsAlpha1(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(initialRequest))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("events are sent to the app and responses are returned to the handler", func(t *testing.T) {
+		responses := make(chan *runtimev1pb.TopicEventResponse, 1)
+		client := newClient(t, &api{
+			UniversalAPI: &universalapi.UniversalAPI{AppID: "fakeAPI"},
+			pubsubAdapter: &streamPubSubAdapter{
+				subscribeFn: func(ctx context.Context, sub runtimePubsub.StreamSubscription, handler runtimePubsub.StreamHandler) error {
+					assert.Equal(t, "pubsub", sub.PubsubName)
+					assert.Equal(t, "topic", sub.Topic)
+					go func() {
+						res, err := handler(ctx, &runtimev1pb.TopicEventRequest{Id: "1", Topic: "topic", PubsubName: "pubsub"})
+						if assert.NoError(t, err) {
+							responses <- res
+						}
+					}()
+					return nil
+				},
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream, err := client.SubscribeTopicEventsAlpha1(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(initialRequest))
+
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "1", event.Id)
+
+		require.NoError(t, stream.Send(&runtimev1pb.SubscribeTopicEventsRequestAlpha1{
+			SubscribeTopicEventsRequestType: &runtimev1pb.SubscribeTopicEventsRequestAlpha1_EventResponse{
+				EventResponse: &runtimev1pb.SubscribeTopicEventsResponseAlpha1{
+					Id:     "1",
+					Status: &runtimev1pb.TopicEventResponse{Status: runtimev1pb.TopicEventResponse_RETRY},
+				},
+			},
+		}))
+
+		select {
+		case res := <-responses:
+			assert.Equal(t, runtimev1pb.TopicEventResponse_RETRY, res.Status)
+		case <-time.After(5 * time.Second):
+			t.Fatal("response was not returned to the handler")
+		}
+	})
+}
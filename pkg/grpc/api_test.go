@@ -70,6 +70,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/kit/logger"
@@ -2503,6 +2504,124 @@ func TestPublishTopic(t *testing.T) {
 	})
 }
 
+type delayedPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	cancelFn func(ctx context.Context, pubsubName, id string) error
+}
+
+func (a *delayedPubSubAdapter) CancelDelayedMessage(ctx context.Context, pubsubName, id string) error {
+	return a.cancelFn(ctx, pubsubName, id)
+}
+
+func TestCancelDelayedMessageAlpha1(t *testing.T) {
+	srv := &api{
+		UniversalAPI: &universalapi.UniversalAPI{AppID: "fakeAPI"},
+		pubsubAdapter: &delayedPubSubAdapter{
+			cancelFn: func(ctx context.Context, pubsubName, id string) error {
+				switch {
+				case pubsubName == "errnotfound":
+					return runtimePubsub.NotFoundError{PubsubName: pubsubName}
+				case id == "unknown":
+					return delayed.ErrNotFound
+				case id == "error":
+					return errors.New("error from state store")
+				}
+				return nil
+			},
+		},
+	}
+
+	server, lis := startTestServerAPI(srv)
+	defer server.Stop()
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	testCases := map[string]struct {
+		req  *runtimev1pb.CancelDelayedMessageRequestAlpha1
+		code codes.Code
+	}{
+		"canceled":           {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{PubsubName: "pubsub", MessageId: "msg1"}, code: codes.OK},
+		"empty pubsub name":  {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{MessageId: "msg1"}, code: codes.InvalidArgument},
+		"empty message id":   {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{PubsubName: "pubsub"}, code: codes.InvalidArgument},
+		"pubsub not found":   {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{PubsubName: "errnotfound", MessageId: "msg1"}, code: codes.NotFound},
+		"message not found":  {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{PubsubName: "pubsub", MessageId: "unknown"}, code: codes.NotFound},
+		"error from adapter": {req: &runtimev1pb.CancelDelayedMessageRequestAlpha1{PubsubName: "pubsub", MessageId: "error"}, code: codes.Internal},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CancelDelayedMessageAlpha1(context.Background(), tc.req)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+type replayPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	replayFn func(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error)
+}
+
+func (a *replayPubSubAdapter) ReplayDeadLetters(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error) {
+	return a.replayFn(ctx, req)
+}
+
+func TestReplayDeadLettersAlpha1(t *testing.T) {
+	srv := &api{
+		UniversalAPI: &universalapi.UniversalAPI{AppID: "fakeAPI"},
+		pubsubAdapter: &replayPubSubAdapter{
+			replayFn: func(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error) {
+				switch req.PubsubName {
+				case "errnotfound":
+					return 0, runtimePubsub.NotFoundError{PubsubName: req.PubsubName}
+				case "errnotallowed":
+					return 0, runtimePubsub.NotAllowedError{Topic: req.DeadLetterTopic, ID: "fakeAPI"}
+				case "error":
+					return 1, errors.New("error from component")
+				}
+				if req.Filter != nil {
+					return 1, nil
+				}
+				return req.MaxMessages, nil
+			},
+		},
+	}
+
+	server, lis := startTestServerAPI(srv)
+	defer server.Stop()
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	testCases := map[string]struct {
+		req      *runtimev1pb.ReplayDeadLettersRequestAlpha1
+		code     codes.Code
+		replayed int32
+	}{
+		"replayed":             {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "pubsub", DeadLetterTopic: "dlq", MaxMessages: 3}, code: codes.OK, replayed: 3},
+		"replayed with filter": {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "pubsub", DeadLetterTopic: "dlq", MaxMessages: 3, Filter: `event.type == "order"`}, code: codes.OK, replayed: 1},
+		"invalid filter":       {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "pubsub", DeadLetterTopic: "dlq", MaxMessages: 3, Filter: `event.type ==`}, code: codes.InvalidArgument},
+		"empty pubsub name":    {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{DeadLetterTopic: "dlq", MaxMessages: 3}, code: codes.InvalidArgument},
+		"empty topic":          {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "pubsub", MaxMessages: 3}, code: codes.InvalidArgument},
+		"no max messages":      {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "pubsub", DeadLetterTopic: "dlq"}, code: codes.InvalidArgument},
+		"pubsub not found":     {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "errnotfound", DeadLetterTopic: "dlq", MaxMessages: 3}, code: codes.NotFound},
+		"topic not allowed":    {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "errnotallowed", DeadLetterTopic: "dlq", MaxMessages: 3}, code: codes.PermissionDenied},
+		"error from adapter":   {req: &runtimev1pb.ReplayDeadLettersRequestAlpha1{PubsubName: "error", DeadLetterTopic: "dlq", MaxMessages: 3}, code: codes.Internal},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := client.ReplayDeadLettersAlpha1(context.Background(), tc.req)
+			assert.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				assert.Equal(t, tc.replayed, res.ReplayedCount)
+			}
+		})
+	}
+}
+
 func TestBulkPublish(t *testing.T) {
 	fakeAPI := &api{
 		UniversalAPI: &universalapi.UniversalAPI{
@@ -3765,6 +3884,180 @@ func TestServiceInvocationWithResiliency(t *testing.T) {
 	})
 }
 
+func TestInvokeServiceWithFallback(t *testing.T) {
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+	fallbackResiliency := &v1alpha1.Resiliency{
+		Spec: v1alpha1.ResiliencySpec{
+			Policies: v1alpha1.Policies{
+				CircuitBreakers: map[string]v1alpha1.CircuitBreaker{
+					"tripOnFailure": {
+						MaxRequests: 1,
+						Timeout:     "1m",
+						Trip:        "consecutiveFailures > 0",
+					},
+				},
+				Fallbacks: map[string]v1alpha1.Fallback{
+					"staticResponse": {
+						Response: &v1alpha1.FallbackResponse{
+							StatusCode:  203,
+							ContentType: "text/plain",
+							Body:        "fallbackResponse",
+						},
+					},
+					"alternateApp": {
+						AppID:  "alternateApp",
+						Method: "alternateMethod",
+					},
+					"circuitBreakerOpen": {
+						Response: &v1alpha1.FallbackResponse{
+							Body: "circuitBreakerOpen",
+						},
+						OnCircuitBreakerOpen: true,
+					},
+				},
+			},
+			Targets: v1alpha1.Targets{
+				Apps: map[string]v1alpha1.EndpointPolicyNames{
+					"staticApp": {
+						Fallback: "staticResponse",
+					},
+					"failoverApp": {
+						Fallback: "alternateApp",
+					},
+					"circuitBreakerApp": {
+						CircuitBreaker: "tripOnFailure",
+						Fallback:       "circuitBreakerOpen",
+					},
+				},
+			},
+		},
+	}
+
+	// Setup Dapr API server
+	fakeAPI := &api{
+		UniversalAPI: &universalapi.UniversalAPI{
+			AppID: "fakeAPI",
+		},
+		directMessaging: mockDirectMessaging,
+		resiliency:      resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), fallbackResiliency),
+	}
+
+	// Run test server
+	server, lis := startDaprAPIServer(fakeAPI, "")
+	defer server.Stop()
+
+	// Create gRPC test client
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	newResponse := func(code int, body string) *invokev1.InvokeMethodResponse {
+		return invokev1.NewInvokeMethodResponse(int32(code), "", nil).
+			WithRawDataString(body).
+			WithContentType("application/json")
+	}
+	matchMethod := func(method string) any {
+		return mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+			return req.Message().Method == method
+		})
+	}
+	invoke := func(appID string, header *grpcMetadata.MD) (*commonv1pb.InvokeResponse, error) {
+		return client.InvokeService(context.Background(), &runtimev1pb.InvokeServiceRequest{
+			Id: appID,
+			Message: &commonv1pb.InvokeRequest{
+				Method: "fakeMethod",
+				Data:   &anypb.Any{Value: []byte("testData")},
+			},
+		}, grpc.Header(header))
+	}
+
+	t.Run("5xx response returns the static response", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"staticApp",
+			matchMethod("fakeMethod")).Return(newResponse(503, "unavailable"), nil).Once()
+
+		var header grpcMetadata.MD
+		res, err := invoke("staticApp", &header)
+
+		require.NoError(t, err)
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, []string{"203"}, header.Get(daprHTTPStatusHeader))
+		assert.Equal(t, "text/plain", res.ContentType)
+		assert.Equal(t, "fallbackResponse", string(res.Data.Value))
+	})
+
+	t.Run("4xx response is returned as-is", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"staticApp",
+			matchMethod("fakeMethod")).Return(newResponse(404, "not found"), nil).Once()
+
+		var header grpcMetadata.MD
+		_, err := invoke("staticApp", &header)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, []string{"404"}, header.Get(daprHTTPStatusHeader))
+	})
+
+	t.Run("invalid request is returned as-is", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"staticApp",
+			matchMethod("fakeMethod")).Return(nil, status.Error(codes.InvalidArgument, "invalid request")).Once()
+
+		var header grpcMetadata.MD
+		_, err := invoke("staticApp", &header)
+
+		require.Error(t, err)
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Contains(t, err.Error(), "invalid request")
+	})
+
+	t.Run("failed call is sent to the alternate app with the fallback method", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"failoverApp",
+			matchMethod("fakeMethod")).Return(newResponse(500, "internal error"), nil).Once()
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"alternateApp",
+			matchMethod("alternateMethod")).Return(newResponse(200, "alternateResponse"), nil).Once()
+
+		var header grpcMetadata.MD
+		res, err := invoke("failoverApp", &header)
+
+		require.NoError(t, err)
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 2)
+		assert.Equal(t, "alternateResponse", string(res.Data.Value))
+	})
+
+	t.Run("fallback restricted to open circuit breakers", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"circuitBreakerApp",
+			matchMethod("fakeMethod")).Return(newResponse(503, "unavailable"), nil).Once()
+
+		// The failure trips the circuit breaker but is returned as-is.
+		var header grpcMetadata.MD
+		_, err := invoke("circuitBreakerApp", &header)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, []string{"503"}, header.Get(daprHTTPStatusHeader))
+
+		// The call rejected by the open circuit breaker doesn't hit the app and returns the static response.
+		res, err := invoke("circuitBreakerApp", &header)
+		require.NoError(t, err)
+		assert.Equal(t, "circuitBreakerOpen", string(res.Data.Value))
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+	})
+}
+
 type mockConfigStore struct{}
 
 func (m *mockConfigStore) Init(ctx context.Context, metadata configuration.Metadata) error {
@@ -5,7 +5,10 @@ import (
 	"net/http"
 
 	"google.golang.org/grpc"
+	grpcCredentials "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
+	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/grpc/metadata"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 )
@@ -45,3 +48,39 @@ func checkAPITokenInContext(ctx context.Context, apiToken, authHeader string) er
 	md.Set(authHeader, "")
 	return nil
 }
+
+func getRevocationMiddlewares(revocations *credentials.RevocationList) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			err := checkPeerNotRevoked(ctx, revocations)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			err := checkPeerNotRevoked(stream.Context(), revocations)
+			if err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}
+}
+
+// Checks that the peer certificate has not been revoked since the connection was established; returns an error otherwise.
+func checkPeerNotRevoked(ctx context.Context, revocations *credentials.RevocationList) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(grpcCredentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+
+	for _, crt := range tlsInfo.State.PeerCertificates {
+		if revocations.IsRevoked(crt) {
+			return invokev1.ErrorFromHTTPResponseCode(http.StatusForbidden, "peer certificate has been revoked")
+		}
+	}
+	return nil
+}
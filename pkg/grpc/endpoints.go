@@ -20,8 +20,11 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/kit/logger"
 )
 
 const daprRuntimePrefix = "/dapr.proto.runtime."
@@ -46,10 +49,28 @@ var endpoints = map[string][]string{
 	},
 	"publish.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/BulkPublishEventAlpha1",
+		daprRuntimePrefix + "v1.Dapr/CancelDelayedMessageAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ReplayDeadLettersAlpha1",
+	},
+	"subscribe.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/SubscribeTopicEventsAlpha1",
+	},
+	"subscriptions.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/PauseSubscriptionAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResumeSubscriptionAlpha1",
 	},
 	"bindings.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeBinding",
 	},
+	"bindings.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/PauseInputBindingAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResumeInputBindingAlpha1",
+	},
+	"resiliency.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/GetResiliencyStatusAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResetCircuitBreakerAlpha1",
+		daprRuntimePrefix + "v1.Dapr/OpenCircuitBreakerAlpha1",
+	},
 	"secrets.v1": {
 		daprRuntimePrefix + "v1.Dapr/GetSecret",
 		daprRuntimePrefix + "v1.Dapr/GetBulkSecret",
@@ -181,3 +202,54 @@ func apiAccessRuleToMap(rules config.APIAccessRules) map[string]struct{} {
 
 	return res
 }
+
+// Returns the unary middleware which rejects requests to the API groups with load shedding enabled when
+// their adaptive concurrency limit is reached. Streaming calls are long-lived and are not limited.
+func getLoadSheddingMiddleware(rules []config.APILoadSheddingRule, log logger.Logger) grpc.UnaryServerInterceptor {
+	type groupLimiter struct {
+		group   string
+		limiter *concurrency.AdaptiveLimiter
+	}
+
+	// Map each method to the limiter of the first rule matching its API group.
+	limiters := map[string]groupLimiter{}
+	for _, rule := range rules {
+		if !rule.AppliesTo(config.APIAccessRuleProtocolGRPC) {
+			continue
+		}
+		opts, err := rule.LimiterOptions()
+		if err != nil {
+			log.Warnf("Using the default latency threshold for load shedding: %v", err)
+		}
+		gl := groupLimiter{group: rule.Name, limiter: concurrency.NewAdaptiveLimiter(opts)}
+		for key, list := range endpoints {
+			if !strings.HasPrefix(key, rule.Name+".") {
+				continue
+			}
+			for _, method := range list {
+				if _, ok := limiters[method]; !ok {
+					limiters[method] = gl
+				}
+			}
+		}
+	}
+
+	if len(limiters) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		gl, ok := limiters[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		done, ok := gl.limiter.TryAcquire()
+		if !ok {
+			return nil, messages.ErrAPIOverloaded.WithFormat(gl.group)
+		}
+		defer done()
+
+		return handler(ctx, req)
+	}
+}
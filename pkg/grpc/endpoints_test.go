@@ -20,10 +20,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dapr/dapr/pkg/config"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/kit/logger"
 )
 
 func TestEndpointCompleteness(t *testing.T) {
@@ -179,6 +183,30 @@ func TestSetAPIEndpointsMiddleware(t *testing.T) {
 		}
 	})
 
+	t.Run("subscribe endpoints allowed", func(t *testing.T) {
+		allowed := []config.APIAccessRule{
+			{
+				Name:     "subscribe",
+				Version:  "v1alpha1",
+				Protocol: "grpc",
+			},
+		}
+
+		tm := testMiddleware(setAPIEndpointsMiddlewares(allowed, nil))
+
+		for _, e := range endpoints["subscribe.v1alpha1"] {
+			tm(t, e, false)
+		}
+
+		for k, v := range endpoints {
+			if k != "subscribe.v1alpha1" {
+				for _, e := range v {
+					tm(t, e, true)
+				}
+			}
+		}
+	})
+
 	t.Run("actors endpoints allowed", func(t *testing.T) {
 		allowed := []config.APIAccessRule{
 			{
@@ -434,3 +462,54 @@ func TestSetAPIEndpointsMiddleware(t *testing.T) {
 		}
 	})
 }
+
+func TestGetLoadSheddingMiddleware(t *testing.T) {
+	log := logger.NewLogger("dapr.runtime.grpc.test")
+
+	t.Run("no rules for gRPC", func(t *testing.T) {
+		rules := []config.APILoadSheddingRule{
+			{Name: "state", Protocol: "http"},
+		}
+		assert.Nil(t, getLoadSheddingMiddleware(rules, log))
+	})
+
+	t.Run("requests over the limit are rejected", func(t *testing.T) {
+		rules := []config.APILoadSheddingRule{
+			{Name: "state", InitialLimit: 1, MinLimit: 1, MaxLimit: 1},
+		}
+		u := getLoadSheddingMiddleware(rules, log)
+		require.NotNil(t, u)
+
+		stateMethod := endpoints["state.v1"][0]
+		release := make(chan struct{})
+		started := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := u(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: stateMethod}, func(ctx context.Context, req any) (any, error) {
+				close(started)
+				<-release
+				return nil, nil
+			})
+			errCh <- err
+		}()
+		<-started
+
+		noop := func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		}
+
+		_, err := u(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: stateMethod}, noop)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Methods of other API groups are not limited.
+		_, err = u(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: endpoints["secrets.v1"][0]}, noop)
+		require.NoError(t, err)
+
+		close(release)
+		require.NoError(t, <-errCh)
+
+		_, err = u(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: stateMethod}, noop)
+		require.NoError(t, err)
+	})
+}
@@ -225,6 +225,8 @@ func (g *Manager) connectRemote(
 			ServerName:   serverName,
 			Certificates: []tls.Certificate{cert},
 			RootCAs:      signedCert.TrustChain,
+			// Refuse connections to peers presenting a certificate revoked by Sentry.
+			VerifyPeerCertificate: g.auth.GetRevocationList().VerifyPeerCertificate,
 		})
 		opts = append(opts, grpc.WithTransportCredentials(ta))
 	} else {
@@ -14,11 +14,13 @@ limitations under the License.
 package grpc
 
 import (
+	"context"
 	"crypto/x509"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/runtime/security"
 )
@@ -37,6 +39,12 @@ func (a *authenticatorMock) CreateSignedWorkloadCert(id, namespace, trustDomain
 	return nil, nil
 }
 
+func (a *authenticatorMock) GetRevocationList() *credentials.RevocationList {
+	return credentials.NewRevocationList()
+}
+
+func (a *authenticatorMock) WatchRevocationList(ctx context.Context) {}
+
 func TestNewGRPCManager(t *testing.T) {
 	t.Run("with self hosted", func(t *testing.T) {
 		m := NewGRPCManager(modes.StandaloneMode, &AppChannelConfig{})
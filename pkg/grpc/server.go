@@ -64,6 +64,7 @@ type server struct {
 	authenticator      auth.Authenticator
 	servers            []*grpcGo.Server
 	renewMutex         sync.Mutex
+	certLock           sync.RWMutex
 	signedCert         *auth.SignedCertificate
 	tlsCert            tls.Certificate
 	signedCertDuration time.Duration
@@ -205,15 +206,17 @@ func (s *server) generateWorkloadCert() error {
 		return fmt.Errorf("error creating x509 Key Pair: %w", err)
 	}
 
+	s.certLock.Lock()
 	s.signedCert = signedCert
 	s.tlsCert = tlsCert
+	s.certLock.Unlock()
 	s.signedCertDuration = signedCert.Expiry.Sub(time.Now().UTC())
 	return nil
 }
 
 func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
-	intr := make([]grpcGo.UnaryServerInterceptor, 0, 6)
-	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 5)
+	intr := make([]grpcGo.UnaryServerInterceptor, 0, 7)
+	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 6)
 
 	intr = append(intr, metadata.SetMetadataInContextUnary)
 
@@ -233,6 +236,13 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		intrStream = append(intrStream, stream)
 	}
 
+	if s.kind == internalServer && s.authenticator != nil {
+		// Connections are long-lived: also check the peer on each call.
+		unary, stream := getRevocationMiddlewares(s.authenticator.GetRevocationList())
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	}
+
 	if diagUtils.IsTracingEnabled(s.tracingSpec.SamplingRate) {
 		s.logger.Info("Enabled gRPC tracing middleware")
 		intr = append(intr, diag.GRPCTraceUnaryServerInterceptor(s.config.AppID, s.tracingSpec))
@@ -256,6 +266,13 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		intrStream = append(intrStream, stream)
 	}
 
+	if s.kind == apiServer && len(s.apiSpec.LoadShedding) > 0 {
+		if unary := getLoadSheddingMiddleware(s.apiSpec.LoadShedding, s.logger); unary != nil {
+			s.logger.Info("Enabled API load shedding on gRPC server")
+			intr = append(intr, unary)
+		}
+	}
+
 	return []grpcGo.ServerOption{
 		grpcGo.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(intr...)),
 		grpcGo.StreamInterceptor(grpcMiddleware.ChainStreamServer(intrStream...)),
@@ -280,8 +297,13 @@ func (s *server) getGRPCServer() (*grpcGo.Server, error) {
 			ClientCAs:  s.signedCert.TrustChain,
 			ClientAuth: tls.RequireAndVerifyClientCert,
 			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
-				return &s.tlsCert, nil
+				s.certLock.RLock()
+				defer s.certLock.RUnlock()
+				cert := s.tlsCert
+				return &cert, nil
 			},
+			// Refuse connections from peers presenting a certificate revoked by Sentry.
+			VerifyPeerCertificate: s.authenticator.GetRevocationList().VerifyPeerCertificate,
 		}
 
 		// In the internal server, enforce minimum version TLS 1.2
@@ -289,6 +311,17 @@ func (s *server) getGRPCServer() (*grpcGo.Server, error) {
 			tlsConfig.MinVersion = tls.VersionTLS12
 		}
 
+		// Verify clients with the trust chain of the current workload cert, which
+		// follows root certificate rotations in Sentry.
+		tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := tlsConfig.Clone()
+			cfg.GetConfigForClient = nil
+			s.certLock.RLock()
+			cfg.ClientCAs = s.signedCert.TrustChain
+			s.certLock.RUnlock()
+			return cfg, nil
+		}
+
 		ta := credentials.NewTLS(&tlsConfig)
 
 		opts = append(opts, grpcGo.Creds(ta))
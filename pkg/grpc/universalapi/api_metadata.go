@@ -76,6 +76,7 @@ func (a *UniversalAPI) GetMetadata(ctx context.Context, in *emptypb.Empty) (*run
 			Version:      comp.Spec.Version,
 			Type:         comp.Spec.Type,
 			Capabilities: metadataGetOrDefaultCapabilities(componentsCapabilities, comp.Name),
+			Paused:       a.CompStore.IsInputBindingPaused(comp.Name),
 		}
 	}
 
@@ -89,6 +90,7 @@ func (a *UniversalAPI) GetMetadata(ctx context.Context, in *emptypb.Empty) (*run
 			Metadata:        s.Metadata,
 			DeadLetterTopic: s.DeadLetterTopic,
 			Rules:           metadataConvertPubSubSubscriptionRules(s.Rules),
+			Paused:          a.CompStore.IsSubscriptionPaused(s.PubsubName, s.Topic),
 		}
 	}
 
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universalapi
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// PauseSubscriptionAlpha1 stops consuming messages from the topic of a subscription until it is resumed.
+func (a *UniversalAPI) PauseSubscriptionAlpha1(ctx context.Context, in *runtimev1pb.PauseSubscriptionRequestAlpha1) (*emptypb.Empty, error) {
+	if err := a.validateSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err := a.SubscriptionPauser.PauseSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		err = messages.ErrPauseSubscription.WithFormat(in.GetTopic(), in.GetPubsubName(), err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeSubscriptionAlpha1 resumes consuming messages from the topic of a paused subscription.
+func (a *UniversalAPI) ResumeSubscriptionAlpha1(ctx context.Context, in *runtimev1pb.ResumeSubscriptionRequestAlpha1) (*emptypb.Empty, error) {
+	if err := a.validateSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err := a.SubscriptionPauser.ResumeSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		err = messages.ErrResumeSubscription.WithFormat(in.GetTopic(), in.GetPubsubName(), err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// PauseInputBindingAlpha1 stops reading from an input binding until it is resumed.
+func (a *UniversalAPI) PauseInputBindingAlpha1(ctx context.Context, in *runtimev1pb.PauseInputBindingRequestAlpha1) (*emptypb.Empty, error) {
+	if err := a.validateInputBinding(in.GetName()); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err := a.InputBindingPauser.PauseInputBinding(in.GetName()); err != nil {
+		err = messages.ErrPauseInputBinding.WithFormat(in.GetName(), err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeInputBindingAlpha1 resumes reading from a paused input binding.
+func (a *UniversalAPI) ResumeInputBindingAlpha1(ctx context.Context, in *runtimev1pb.ResumeInputBindingRequestAlpha1) (*emptypb.Empty, error) {
+	if err := a.validateInputBinding(in.GetName()); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err := a.InputBindingPauser.ResumeInputBinding(in.GetName()); err != nil {
+		err = messages.ErrResumeInputBinding.WithFormat(in.GetName(), err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (a *UniversalAPI) validateSubscription(pubsubName, topic string) error {
+	var err error
+	switch {
+	case a.SubscriptionPauser == nil:
+		err = messages.ErrAPIUnimplemented
+	case pubsubName == "" || topic == "":
+		err = messages.ErrPubsubSubscriptionEmpty
+	default:
+		if _, ok := a.CompStore.GetTopicRoutes()[pubsubName][topic]; !ok {
+			err = messages.ErrPubsubSubscriptionMissing.WithFormat(topic, pubsubName)
+		}
+	}
+	if err != nil {
+		a.Logger.Debug(err)
+	}
+	return err
+}
+
+func (a *UniversalAPI) validateInputBinding(name string) error {
+	var err error
+	switch {
+	case a.InputBindingPauser == nil:
+		err = messages.ErrAPIUnimplemented
+	case name == "":
+		err = messages.ErrInputBindingNameEmpty
+	default:
+		if _, ok := a.CompStore.GetInputBinding(name); !ok {
+			err = messages.ErrInputBindingNotFound.WithFormat(name)
+		}
+	}
+	if err != nil {
+		a.Logger.Debug(err)
+	}
+	return err
+}
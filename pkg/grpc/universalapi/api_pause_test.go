@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universalapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	componentsV1alpha "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// fakePauser pauses the subscriptions and input bindings in the component store.
+type fakePauser struct {
+	compStore *compstore.ComponentStore
+	err       error
+}
+
+func (f *fakePauser) PauseSubscription(pubsubName, topic string) error {
+	f.compStore.SetSubscriptionPaused(pubsubName, topic, true)
+	return f.err
+}
+
+func (f *fakePauser) ResumeSubscription(pubsubName, topic string) error {
+	f.compStore.SetSubscriptionPaused(pubsubName, topic, false)
+	return f.err
+}
+
+func (f *fakePauser) PauseInputBinding(name string) error {
+	f.compStore.SetInputBindingPaused(name, true)
+	return f.err
+}
+
+func (f *fakePauser) ResumeInputBinding(name string) error {
+	f.compStore.SetInputBindingPaused(name, false)
+	return f.err
+}
+
+func TestPauseSubscription(t *testing.T) {
+	compStore := compstore.New()
+	compStore.SetSubscriptions([]runtimePubsub.Subscription{{PubsubName: "pubsub", Topic: "topic"}})
+	compStore.SetTopicRoutes(map[string]compstore.TopicRoutes{
+		"pubsub": {"topic": {}},
+	})
+	pauser := &fakePauser{compStore: compStore}
+	fakeAPI := &UniversalAPI{
+		Logger:                     testLogger,
+		CompStore:                  compStore,
+		SubscriptionPauser:         pauser,
+		GetComponentsCapabilitesFn: func() map[string][]string { return nil },
+		GlobalConfig:               &config.Configuration{},
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		_, err := fakeAPI.PauseSubscriptionAlpha1(context.Background(), &runtimev1pb.PauseSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+		})
+		require.NoError(t, err)
+
+		md, err := fakeAPI.GetMetadata(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, md.Subscriptions, 1)
+		assert.True(t, md.Subscriptions[0].Paused)
+
+		_, err = fakeAPI.ResumeSubscriptionAlpha1(context.Background(), &runtimev1pb.ResumeSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+		})
+		require.NoError(t, err)
+
+		md, err = fakeAPI.GetMetadata(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		assert.False(t, md.Subscriptions[0].Paused)
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		_, err := fakeAPI.PauseSubscriptionAlpha1(context.Background(), &runtimev1pb.PauseSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+		})
+		assert.ErrorIs(t, err, messages.ErrPubsubSubscriptionEmpty)
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		_, err := fakeAPI.ResumeSubscriptionAlpha1(context.Background(), &runtimev1pb.ResumeSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+			Topic:      "other",
+		})
+		assert.ErrorIs(t, err, messages.ErrPubsubSubscriptionMissing)
+	})
+
+	t.Run("pause fails", func(t *testing.T) {
+		pauser.err = errors.New("simulated")
+		defer func() { pauser.err = nil }()
+
+		_, err := fakeAPI.PauseSubscriptionAlpha1(context.Background(), &runtimev1pb.PauseSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+		})
+		assert.ErrorIs(t, err, messages.ErrPauseSubscription)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		api := &UniversalAPI{Logger: testLogger, CompStore: compStore}
+		_, err := api.PauseSubscriptionAlpha1(context.Background(), &runtimev1pb.PauseSubscriptionRequestAlpha1{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+		})
+		assert.ErrorIs(t, err, messages.ErrAPIUnimplemented)
+	})
+}
+
+func TestPauseInputBinding(t *testing.T) {
+	compStore := compstore.New()
+	comp := componentsV1alpha.Component{}
+	comp.Name = "binding"
+	compStore.AddComponent(comp)
+	compStore.AddInputBinding("binding", &daprt.MockBinding{})
+	pauser := &fakePauser{compStore: compStore}
+	fakeAPI := &UniversalAPI{
+		Logger:                     testLogger,
+		CompStore:                  compStore,
+		InputBindingPauser:         pauser,
+		GetComponentsCapabilitesFn: func() map[string][]string { return nil },
+		GlobalConfig:               &config.Configuration{},
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		_, err := fakeAPI.PauseInputBindingAlpha1(context.Background(), &runtimev1pb.PauseInputBindingRequestAlpha1{Name: "binding"})
+		require.NoError(t, err)
+
+		md, err := fakeAPI.GetMetadata(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, md.RegisteredComponents, 1)
+		assert.True(t, md.RegisteredComponents[0].Paused)
+
+		_, err = fakeAPI.ResumeInputBindingAlpha1(context.Background(), &runtimev1pb.ResumeInputBindingRequestAlpha1{Name: "binding"})
+		require.NoError(t, err)
+
+		md, err = fakeAPI.GetMetadata(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		assert.False(t, md.RegisteredComponents[0].Paused)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := fakeAPI.PauseInputBindingAlpha1(context.Background(), &runtimev1pb.PauseInputBindingRequestAlpha1{})
+		assert.ErrorIs(t, err, messages.ErrInputBindingNameEmpty)
+	})
+
+	t.Run("binding not found", func(t *testing.T) {
+		_, err := fakeAPI.ResumeInputBindingAlpha1(context.Background(), &runtimev1pb.ResumeInputBindingRequestAlpha1{Name: "other"})
+		assert.ErrorIs(t, err, messages.ErrInputBindingNotFound)
+	})
+
+	t.Run("resume fails", func(t *testing.T) {
+		pauser.err = errors.New("simulated")
+		defer func() { pauser.err = nil }()
+
+		_, err := fakeAPI.ResumeInputBindingAlpha1(context.Background(), &runtimev1pb.ResumeInputBindingRequestAlpha1{Name: "binding"})
+		assert.ErrorIs(t, err, messages.ErrResumeInputBinding)
+	})
+}
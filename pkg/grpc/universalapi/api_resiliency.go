@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universalapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// GetResiliencyStatusAlpha1 returns the live state of the circuit breakers and, for a single target, the policies resolved for it.
+func (a *UniversalAPI) GetResiliencyStatusAlpha1(ctx context.Context, in *runtimev1pb.GetResiliencyStatusRequestAlpha1) (*runtimev1pb.GetResiliencyStatusResponseAlpha1, error) {
+	sp, err := a.resiliencyStatusProvider()
+	if err != nil {
+		return nil, err
+	}
+	if in.GetTargetType() != "" {
+		if err = validateResiliencyTargetType(in.GetTargetType()); err != nil {
+			a.Logger.Debug(err)
+			return nil, err
+		}
+	}
+
+	statuses := sp.CircuitBreakerStatuses(in.GetTargetType(), in.GetTarget())
+	res := &runtimev1pb.GetResiliencyStatusResponseAlpha1{
+		CircuitBreakers: make([]*runtimev1pb.CircuitBreakerStatus, len(statuses)),
+	}
+	for i, s := range statuses {
+		res.CircuitBreakers[i] = &runtimev1pb.CircuitBreakerStatus{
+			TargetType:           s.TargetType,
+			Target:               s.Target,
+			Name:                 s.Name,
+			State:                string(s.State),
+			Requests:             s.Counts.Requests,
+			TotalSuccesses:       s.Counts.TotalSuccesses,
+			TotalFailures:        s.Counts.TotalFailures,
+			ConsecutiveSuccesses: s.Counts.ConsecutiveSuccesses,
+			ConsecutiveFailures:  s.Counts.ConsecutiveFailures,
+			ForcedOpen:           s.ForcedOpen,
+		}
+		if s.TimeToHalfOpen > 0 {
+			res.CircuitBreakers[i].TimeToHalfOpen = s.TimeToHalfOpen.String()
+		}
+	}
+
+	// Policies are resolved only when the request is for a single target.
+	if in.GetTargetType() != "" && in.GetTarget() != "" {
+		policies, err := sp.ResolvedPolicies(in.GetTargetType(), in.GetTarget(), resiliency.ComponentType(in.GetComponentType()))
+		if err != nil {
+			err = messages.ErrResiliencyTargetInvalid.WithFormat(err)
+			a.Logger.Debug(err)
+			return nil, err
+		}
+		res.Policies = resiliencyPolicyNamesToProto(policies.Outbound, policies.Default)
+		if in.GetTargetType() == resiliency.TargetTypeComponent {
+			res.InboundPolicies = resiliencyPolicyNamesToProto(policies.Inbound, policies.Default)
+		}
+	}
+
+	return res, nil
+}
+
+// ResetCircuitBreakerAlpha1 closes a circuit breaker and clears its counts, including circuit breakers that were forced open.
+// It is only allowed when the ResiliencyCircuitBreakerControl feature is enabled.
+func (a *UniversalAPI) ResetCircuitBreakerAlpha1(ctx context.Context, in *runtimev1pb.CircuitBreakerRequestAlpha1) (*emptypb.Empty, error) {
+	sp, err := a.validateCircuitBreakerRequest(in)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = sp.ResetCircuitBreaker(in.GetTargetType(), in.GetTarget(), in.GetName()); err != nil {
+		err = a.circuitBreakerError(messages.ErrResetCircuitBreaker, in, err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// OpenCircuitBreakerAlpha1 forces a circuit breaker open, so calls to its target fail fast until it is reset.
+// It is only allowed when the ResiliencyCircuitBreakerControl feature is enabled.
+func (a *UniversalAPI) OpenCircuitBreakerAlpha1(ctx context.Context, in *runtimev1pb.CircuitBreakerRequestAlpha1) (*emptypb.Empty, error) {
+	sp, err := a.validateCircuitBreakerRequest(in)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if err = sp.ForceOpenCircuitBreaker(in.GetTargetType(), in.GetTarget(), in.GetName()); err != nil {
+		err = a.circuitBreakerError(messages.ErrOpenCircuitBreaker, in, err)
+		a.Logger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (a *UniversalAPI) resiliencyStatusProvider() (resiliency.StatusProvider, error) {
+	sp, ok := a.Resiliency.(resiliency.StatusProvider)
+	if !ok {
+		err := messages.ErrAPIUnimplemented
+		a.Logger.Debug(err)
+		return nil, err
+	}
+	return sp, nil
+}
+
+func (a *UniversalAPI) validateCircuitBreakerRequest(in *runtimev1pb.CircuitBreakerRequestAlpha1) (resiliency.StatusProvider, error) {
+	// Changing the state of circuit breakers affects all calls made by the sidecar, so it must be explicitly allowed.
+	if a.GlobalConfig == nil || !a.GlobalConfig.IsFeatureEnabled(config.ResiliencyCircuitBreakerControl) {
+		err := messages.ErrCircuitBreakerControl
+		a.Logger.Debug(err)
+		return nil, err
+	}
+	sp, err := a.resiliencyStatusProvider()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case in.GetTarget() == "":
+		err = messages.ErrCircuitBreakerTargetEmpty
+	case in.GetName() == "" && in.GetTargetType() != resiliency.TargetTypeComponent:
+		err = messages.ErrCircuitBreakerTargetEmpty
+	default:
+		err = validateResiliencyTargetType(in.GetTargetType())
+	}
+	if err != nil {
+		a.Logger.Debug(err)
+		return nil, err
+	}
+	return sp, nil
+}
+
+func (a *UniversalAPI) circuitBreakerError(apiErr messages.APIError, in *runtimev1pb.CircuitBreakerRequestAlpha1, err error) error {
+	if errors.Is(err, resiliency.ErrCircuitBreakerNotFound) {
+		return messages.ErrCircuitBreakerNotFound.WithFormat(in.GetName(), in.GetTargetType(), in.GetTarget())
+	}
+	return apiErr.WithFormat(in.GetName(), in.GetTargetType(), in.GetTarget(), err)
+}
+
+func validateResiliencyTargetType(targetType string) error {
+	switch targetType {
+	case resiliency.TargetTypeApp, resiliency.TargetTypeActor, resiliency.TargetTypeComponent:
+		return nil
+	default:
+		return messages.ErrResiliencyTargetInvalid.WithFormat("target type must be one of app, actor, or component")
+	}
+}
+
+func resiliencyPolicyNamesToProto(names resiliency.PolicyNames, isDefault bool) *runtimev1pb.ResiliencyPolicyNames {
+	return &runtimev1pb.ResiliencyPolicyNames{
+		Default:        isDefault,
+		Timeout:        names.Timeout,
+		Retry:          names.Retry,
+		CircuitBreaker: names.CircuitBreaker,
+		Bulkhead:       names.Bulkhead,
+		RateLimit:      names.RateLimit,
+		Hedging:        names.Hedging,
+		Fallback:       names.Fallback,
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universalapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+func TestResiliencyStatus(t *testing.T) {
+	res := resiliency.FromConfigurations(testLogger, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{"fast": "1s"},
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"cb": {Trip: "consecutiveFailures > 0", Timeout: "1m"},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {
+						Outbound: resiliencyV1alpha.PolicyNames{Timeout: "fast", CircuitBreaker: "cb"},
+					},
+				},
+			},
+		},
+	})
+	res.ComponentOutboundPolicy("statestore1", resiliency.Statestore)
+
+	globalConfig := &config.Configuration{
+		Spec: config.ConfigurationSpec{
+			Features: []config.FeatureSpec{{Name: config.ResiliencyCircuitBreakerControl, Enabled: true}},
+		},
+	}
+	globalConfig.LoadFeatures()
+	fakeAPI := &UniversalAPI{
+		Logger:       testLogger,
+		Resiliency:   res,
+		GlobalConfig: globalConfig,
+	}
+
+	t.Run("get status", func(t *testing.T) {
+		resp, err := fakeAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{
+			TargetType:    resiliency.TargetTypeComponent,
+			Target:        "statestore1",
+			ComponentType: string(resiliency.Statestore),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetCircuitBreakers(), 1)
+		assert.Equal(t, "statestore1", resp.GetCircuitBreakers()[0].GetTarget())
+		assert.Equal(t, "closed", resp.GetCircuitBreakers()[0].GetState())
+		assert.Equal(t, "fast", resp.GetPolicies().GetTimeout())
+		assert.Equal(t, "cb", resp.GetPolicies().GetCircuitBreaker())
+		assert.NotNil(t, resp.GetInboundPolicies())
+	})
+
+	t.Run("open and reset circuit breaker", func(t *testing.T) {
+		req := &runtimev1pb.CircuitBreakerRequestAlpha1{
+			TargetType: resiliency.TargetTypeComponent,
+			Target:     "statestore1",
+		}
+		_, err := fakeAPI.OpenCircuitBreakerAlpha1(context.Background(), req)
+		require.NoError(t, err)
+
+		resp, err := fakeAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{})
+		require.NoError(t, err)
+		require.Len(t, resp.GetCircuitBreakers(), 1)
+		assert.Equal(t, "open", resp.GetCircuitBreakers()[0].GetState())
+		assert.True(t, resp.GetCircuitBreakers()[0].GetForcedOpen())
+		assert.Nil(t, resp.GetPolicies())
+
+		_, err = fakeAPI.ResetCircuitBreakerAlpha1(context.Background(), req)
+		require.NoError(t, err)
+
+		resp, err = fakeAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{})
+		require.NoError(t, err)
+		assert.Equal(t, "closed", resp.GetCircuitBreakers()[0].GetState())
+		assert.False(t, resp.GetCircuitBreakers()[0].GetForcedOpen())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := fakeAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{
+			TargetType: "route",
+		})
+		assert.ErrorIs(t, err, messages.ErrResiliencyTargetInvalid)
+
+		_, err = fakeAPI.ResetCircuitBreakerAlpha1(context.Background(), &runtimev1pb.CircuitBreakerRequestAlpha1{
+			TargetType: resiliency.TargetTypeApp,
+			Target:     "app1",
+		})
+		assert.ErrorIs(t, err, messages.ErrCircuitBreakerTargetEmpty)
+
+		_, err = fakeAPI.OpenCircuitBreakerAlpha1(context.Background(), &runtimev1pb.CircuitBreakerRequestAlpha1{
+			TargetType: resiliency.TargetTypeApp,
+			Target:     "app1",
+			Name:       "app1:method",
+		})
+		assert.ErrorIs(t, err, messages.ErrCircuitBreakerNotFound)
+	})
+
+	t.Run("circuit breaker control disabled", func(t *testing.T) {
+		disabledAPI := &UniversalAPI{
+			Logger:       testLogger,
+			Resiliency:   res,
+			GlobalConfig: &config.Configuration{},
+		}
+		req := &runtimev1pb.CircuitBreakerRequestAlpha1{
+			TargetType: resiliency.TargetTypeComponent,
+			Target:     "statestore1",
+		}
+		_, err := disabledAPI.OpenCircuitBreakerAlpha1(context.Background(), req)
+		assert.ErrorIs(t, err, messages.ErrCircuitBreakerControl)
+		_, err = disabledAPI.ResetCircuitBreakerAlpha1(context.Background(), req)
+		assert.ErrorIs(t, err, messages.ErrCircuitBreakerControl)
+
+		resp, err := disabledAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{})
+		require.NoError(t, err)
+		assert.Equal(t, "closed", resp.GetCircuitBreakers()[0].GetState())
+	})
+
+	t.Run("resiliency disabled", func(t *testing.T) {
+		noopAPI := &UniversalAPI{
+			Logger:     testLogger,
+			Resiliency: resiliency.New(testLogger),
+		}
+		_, err := noopAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{})
+		require.NoError(t, err)
+
+		noopAPI.Resiliency = &resiliency.NoOp{}
+		_, err = noopAPI.GetResiliencyStatusAlpha1(context.Background(), &runtimev1pb.GetResiliencyStatusRequestAlpha1{})
+		assert.ErrorIs(t, err, messages.ErrAPIUnimplemented)
+	})
+}
@@ -44,6 +44,35 @@ func (a *UniversalAPI) GetStateStore(name string) (state.Store, error) {
 	return state, nil
 }
 
+// GetStateFallback gets a key from the alternate state store of the fallback policy of a state store, after getting it from the state store failed with err.
+// It returns the name of the state store the response was read from, which is the original one if no fallback applies.
+func (a *UniversalAPI) GetStateFallback(ctx context.Context, storeName, key string, req *state.GetRequest, err error) (*state.GetResponse, string, error) {
+	fallback := a.Resiliency.ComponentFallbackPolicy(storeName, resiliency.Statestore)
+	if !fallback.ShouldFallback(err) {
+		return nil, storeName, err
+	}
+	store, ok := a.CompStore.GetStateStore(fallback.Component)
+	if !ok {
+		a.Logger.Warnf("Fallback state store %s of state store %s not found", fallback.Component, storeName)
+		return nil, storeName, err
+	}
+	k, kErr := stateLoader.GetModifiedStateKey(key, fallback.Component, a.AppID)
+	if kErr != nil {
+		return nil, storeName, err
+	}
+	a.Logger.Debugf("Getting key %s from state store %s failed, using fallback state store %s: %v", key, storeName, fallback.Component, err)
+
+	fallbackReq := *req
+	fallbackReq.Key = k
+	policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
+		a.Resiliency.ComponentOutboundPolicy(fallback.Component, resiliency.Statestore),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
+		return store.Get(ctx, &fallbackReq)
+	})
+	return resp, fallback.Component, err
+}
+
 func (a *UniversalAPI) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryStateRequest) (*runtimev1pb.QueryStateResponse, error) {
 	store, err := a.GetStateStore(in.StoreName)
 	if err != nil {
@@ -37,6 +37,20 @@ type UniversalAPI struct {
 	ExtendedMetadata           map[string]string
 	AppConnectionConfig        config.AppConnectionConfig
 	GlobalConfig               *config.Configuration
+	SubscriptionPauser         SubscriptionPauser
+	InputBindingPauser         InputBindingPauser
 
 	extendedMetadataLock sync.RWMutex
 }
+
+// SubscriptionPauser pauses and resumes the pubsub subscriptions of the app.
+type SubscriptionPauser interface {
+	PauseSubscription(pubsubName, topic string) error
+	ResumeSubscription(pubsubName, topic string) error
+}
+
+// InputBindingPauser pauses and resumes reading from input bindings.
+type InputBindingPauser interface {
+	PauseInputBinding(name string) error
+	ResumeInputBinding(name string) error
+}
@@ -48,6 +48,7 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/grpc/universalapi"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messaging"
@@ -56,7 +57,9 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/outbox"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/dapr/utils/responsewriter"
 )
@@ -87,6 +90,7 @@ type api struct {
 	tracingSpec             config.TracingSpec
 	maxRequestBodySize      int64 // In bytes
 	compStore               *compstore.ComponentStore
+	outbox                  *outbox.Outbox
 }
 
 const (
@@ -111,6 +115,7 @@ const (
 	consistencyParam         = "consistency"
 	concurrencyParam         = "concurrency"
 	pubsubnameparam          = "pubsubname"
+	messageIDParam           = "messageId"
 	traceparentHeader        = "traceparent"
 	tracestateHeader         = "tracestate"
 	daprAppID                = "dapr-app-id"
@@ -134,6 +139,9 @@ type APIOpts struct {
 	MaxRequestBodySize          int64 // In bytes
 	AppConnectionConfig         config.AppConnectionConfig
 	GlobalConfig                *config.Configuration
+	Outbox                      *outbox.Outbox
+	SubscriptionPauser          universalapi.SubscriptionPauser
+	InputBindingPauser          universalapi.InputBindingPauser
 }
 
 // NewAPI returns a new API.
@@ -148,6 +156,7 @@ func NewAPI(opts APIOpts) API {
 		tracingSpec:             opts.TracingSpec,
 		maxRequestBodySize:      opts.MaxRequestBodySize,
 		compStore:               opts.CompStore,
+		outbox:                  opts.Outbox,
 		universal: &universalapi.UniversalAPI{
 			AppID:                      opts.AppID,
 			Logger:                     log,
@@ -158,6 +167,8 @@ func NewAPI(opts APIOpts) API {
 			GetComponentsCapabilitesFn: opts.GetComponentsCapabilitiesFn,
 			AppConnectionConfig:        opts.AppConnectionConfig,
 			GlobalConfig:               opts.GlobalConfig,
+			SubscriptionPauser:         opts.SubscriptionPauser,
+			InputBindingPauser:         opts.InputBindingPauser,
 		},
 	}
 
@@ -170,6 +181,8 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructActorEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructDirectMessagingEndpoints()...)
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
+	api.endpoints = append(api.endpoints, api.constructPauseEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructResiliencyEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructShutdownEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConfigurationEndpoints()...)
@@ -260,6 +273,18 @@ func (a *api) constructPubSubEndpoints() []Endpoint {
 			Version:         apiVersionV1alpha1,
 			FastHTTPHandler: a.onBulkPublish,
 		},
+		{
+			Methods:         []string{nethttp.MethodDelete},
+			Route:           "publish/delayed/{pubsubname}/{messageId}",
+			Version:         apiVersionV1alpha1,
+			FastHTTPHandler: a.onCancelDelayedMessage,
+		},
+		{
+			Methods:         []string{nethttp.MethodPost},
+			Route:           "publish/replay/{pubsubname}/*",
+			Version:         apiVersionV1alpha1,
+			FastHTTPHandler: a.onReplayDeadLetters,
+		},
 	}
 }
 
@@ -614,6 +639,9 @@ func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 
 	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
 
+	if err != nil {
+		resp, storeName, err = a.universal.GetStateFallback(reqCtx, storeName, key, req, err)
+	}
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_GET", fmt.Sprintf(messages.ErrStateGet, key, storeName, err.Error()))
 		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusInternalServerError, msg))
@@ -716,7 +744,10 @@ func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *c
 			}
 
 			if rResp != nil && rResp.Status().Code != nethttp.StatusOK {
-				return struct{}{}, fmt.Errorf("error sending configuration item to application, status %d", rResp.Status().Code)
+				return struct{}{}, resiliency.HTTPStatusError{
+					StatusCode: int(rResp.Status().Code),
+					Err:        fmt.Errorf("error sending configuration item to application, status %d", rResp.Status().Code),
+				}
 			}
 			return struct{}{}, nil
 		})
@@ -1063,12 +1094,18 @@ func (a *api) getStateStoreName(reqCtx *fasthttp.RequestCtx) string {
 type invokeError struct {
 	statusCode int
 	msg        []byte
+	// err is the error returned by the invocation, if any.
+	err error
 }
 
 func (ie invokeError) Error() string {
 	return fmt.Sprintf("invokeError (statusCode='%d') msg='%v'", ie.statusCode, string(ie.msg))
 }
 
+func (ie invokeError) Unwrap() error {
+	return ie.err
+}
+
 func (a *api) isHTTPEndpoint(appID string) bool {
 	endpoint, ok := a.universal.CompStore.GetHTTPEndpoint(appID)
 	return ok && endpoint.Name == appID
@@ -1123,9 +1160,14 @@ func (a *api) onDirectMessage(w nethttp.ResponseWriter, r *nethttp.Request) {
 		WithContentType(r.Header.Get("content-type")).
 		// Save headers to internal metadata
 		WithHTTPHeaders(r.Header)
+	fallback := a.universal.Resiliency.EndpointFallbackPolicy(targetID)
 	if policyDef != nil {
 		req.WithReplay(policyDef.HasRetries())
 	}
+	if fallback != nil && fallback.AppID != "" {
+		// The request is sent again to the alternate app
+		req.WithReplay(true)
+	}
 	defer req.Close()
 
 	policyRunner := resiliency.NewRunnerWithOptions(
@@ -1135,15 +1177,16 @@ func (a *api) onDirectMessage(w nethttp.ResponseWriter, r *nethttp.Request) {
 		},
 	)
 	// Since we don't want to return the actual error, we have to extract several things in order to construct our response.
-	resp, err := policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
-		rResp, rErr := a.directMessaging.Invoke(ctx, targetID, req)
+	invoke := func(ctx context.Context, appID string) (*invokev1.InvokeMethodResponse, error) {
+		rResp, rErr := a.directMessaging.Invoke(ctx, appID, req)
 		if rErr != nil {
 			// Allowlist policies that are applied on the callee side can return a Permission Denied error.
 			// For everything else, treat it as a gRPC transport error
-			apiErr := messages.ErrDirectInvoke.WithFormat(targetID, rErr)
+			apiErr := messages.ErrDirectInvoke.WithFormat(appID, rErr)
 			invokeErr := invokeError{
 				statusCode: apiErr.HTTPCode(),
 				msg:        apiErr.JSONErrorValue(),
+				err:        rErr,
 			}
 
 			if status.Code(rErr) == codes.PermissionDenied {
@@ -1175,13 +1218,33 @@ func (a *api) onDirectMessage(w nethttp.ResponseWriter, r *nethttp.Request) {
 		} else if resStatus.Code < 200 || resStatus.Code > 399 {
 			// We are not returning an `invokeError` here on purpose.
 			// Returning an error that is not an `invokeError` will cause Resiliency to retry the request (if retries are enabled), but if the request continues to fail, the response is sent to the user with whatever status code the app returned so the "received non-successful status code" is "swallowed" (will appear in logs but won't be returned to the app).
-			return rResp, fmt.Errorf("received non-successful status code: %d", resStatus.Code)
+			// The status code is included in the error so retry policies can match on it.
+			return rResp, resiliency.HTTPStatusError{StatusCode: int(resStatus.Code)}
 		}
 		return rResp, nil
+	}
+	resp, err := policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
+		return invoke(ctx, targetID)
 	})
 
+	// If the call failed, degrade gracefully with the fallback of the target
+	if fallback.ShouldFallback(err) {
+		log.Debugf("Invocation of %s failed, using fallback %s: %v", targetID, fallback.Name, err)
+		if resp != nil {
+			_ = resp.Close()
+		}
+		if fallback.Response != nil {
+			resp, err = messaging.FallbackResponse(fallback.Response), nil
+		} else {
+			messaging.WithFallbackMethod(req, fallback)
+			resp, err = invoke(r.Context(), fallback.AppID)
+		}
+	}
+
 	// Special case for timeouts/circuit breakers since they won't go through the rest of the logic.
-	if errors.Is(err, context.DeadlineExceeded) || breaker.IsErrorPermanent(err) {
+	invokeErr := invokeError{}
+	isInvokeErr := errors.As(err, &invokeErr)
+	if !isInvokeErr && (errors.Is(err, context.DeadlineExceeded) || breaker.IsErrorPermanent(err)) {
 		respondWithError(w, messages.ErrDirectInvoke.WithFormat(targetID, err))
 		return
 	}
@@ -1193,8 +1256,7 @@ func (a *api) onDirectMessage(w nethttp.ResponseWriter, r *nethttp.Request) {
 		}
 	}
 
-	invokeErr := invokeError{}
-	if errors.As(err, &invokeErr) {
+	if isInvokeErr {
 		respondWithData(w, invokeErr.statusCode, invokeErr.msg)
 		if resp != nil {
 			_ = resp.Close()
@@ -1776,6 +1838,11 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 			status = nethttp.StatusForbidden
 		}
 
+		if errors.As(err, &runtimePubsub.SchemaValidationError{}) {
+			msg = NewErrorResponse("ERR_PUBSUB_SCHEMA_VALIDATION", err.Error())
+			status = nethttp.StatusBadRequest
+		}
+
 		if errors.As(err, &runtimePubsub.NotFoundError{}) {
 			msg = NewErrorResponse("ERR_PUBSUB_NOT_FOUND", err.Error())
 			status = nethttp.StatusBadRequest
@@ -1965,6 +2032,15 @@ func (a *api) onBulkPublish(reqCtx *fasthttp.RequestCtx) {
 			return
 		}
 
+		if errors.As(err, &runtimePubsub.SchemaValidationError{}) {
+			msg := NewErrorResponse("ERR_PUBSUB_SCHEMA_VALIDATION", err.Error())
+			status = nethttp.StatusBadRequest
+			fasthttpRespond(reqCtx, fasthttpResponseWithError(status, msg), closeChildSpans)
+			log.Debug(msg)
+
+			return
+		}
+
 		// Return the error along with the list of failed entries.
 		resData, _ := json.Marshal(bulkRes)
 		fasthttpRespond(reqCtx, fasthttpResponseWithJSON(status, resData), closeChildSpans)
@@ -1975,6 +2051,131 @@ func (a *api) onBulkPublish(reqCtx *fasthttp.RequestCtx) {
 	fasthttpRespond(reqCtx, fasthttpResponseWithEmpty(), closeChildSpans)
 }
 
+func (a *api) onCancelDelayedMessage(reqCtx *fasthttp.RequestCtx) {
+	if a.pubsubAdapter == nil {
+		msg := NewErrorResponse("ERR_PUBSUB_NOT_CONFIGURED", messages.ErrPubsubNotConfigured)
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	canceler, ok := a.pubsubAdapter.(runtimePubsub.DelayedMessageCanceler)
+	if !ok {
+		msg := NewErrorResponse("ERR_PUBSUB_DELAYED_NOT_SUPPORTED", "delayed messages are not supported")
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusNotImplemented, msg))
+		log.Debug(msg)
+		return
+	}
+
+	pubsubName := reqCtx.UserValue(pubsubnameparam).(string)
+	messageID := reqCtx.UserValue(messageIDParam).(string)
+	if messageID == "" {
+		msg := NewErrorResponse("ERR_PUBSUB_MESSAGE_ID_EMPTY", messages.ErrPubsubMessageIDEmpty)
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+
+	err := canceler.CancelDelayedMessage(reqCtx, pubsubName, messageID)
+	if err != nil {
+		status := nethttp.StatusInternalServerError
+		msg := NewErrorResponse("ERR_PUBSUB_CANCEL_DELAYED",
+			fmt.Sprintf(messages.ErrPubsubCancelDelayed, messageID, pubsubName, err.Error()))
+
+		if errors.As(err, &runtimePubsub.NotFoundError{}) {
+			msg = NewErrorResponse("ERR_PUBSUB_NOT_FOUND", err.Error())
+			status = nethttp.StatusBadRequest
+		} else if errors.Is(err, delayed.ErrNotFound) {
+			msg = NewErrorResponse("ERR_PUBSUB_DELAYED_NOT_FOUND",
+				fmt.Sprintf(messages.ErrPubsubDelayedNotFound, messageID, pubsubName))
+			status = nethttp.StatusNotFound
+		}
+
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(status, msg))
+		log.Debug(msg)
+		return
+	}
+
+	fasthttpRespond(reqCtx, fasthttpResponseWithEmpty())
+}
+
+type replayDeadLettersRequest struct {
+	MaxMessages        int    `json:"maxMessages"`
+	Filter             string `json:"filter,omitempty"`
+	Topic              string `json:"topic,omitempty"`
+	IdleTimeoutSeconds int    `json:"idleTimeoutSeconds,omitempty"`
+}
+
+type replayDeadLettersResponse struct {
+	ReplayedCount int `json:"replayedCount"`
+}
+
+func (a *api) onReplayDeadLetters(reqCtx *fasthttp.RequestCtx) {
+	_, pubsubName, topic, sc, errRes := a.validateAndGetPubsubAndTopic(reqCtx)
+	if errRes != nil {
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(sc, *errRes))
+		log.Debug(errRes)
+		return
+	}
+	replayer, ok := a.pubsubAdapter.(runtimePubsub.DeadLetterReplayer)
+	if !ok {
+		msg := NewErrorResponse("ERR_PUBSUB_REPLAY_NOT_SUPPORTED", "replaying dead letter topics is not supported")
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusNotImplemented, msg))
+		log.Debug(msg)
+		return
+	}
+
+	var in replayDeadLettersRequest
+	if err := json.Unmarshal(reqCtx.PostBody(), &in); err != nil {
+		msg := messages.ErrMalformedRequest.WithFormat(err)
+		universalFastHTTPErrorResponder(reqCtx, msg)
+		log.Debug(msg)
+		return
+	}
+	if in.MaxMessages <= 0 {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", messages.ErrPubsubReplayMaxMessages)
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+
+	req := runtimePubsub.ReplayRequest{
+		PubsubName:      pubsubName,
+		DeadLetterTopic: topic,
+		Topic:           in.Topic,
+		Metadata:        getMetadataFromFastHTTPRequest(reqCtx),
+		MaxMessages:     in.MaxMessages,
+		IdleTimeout:     time.Duration(in.IdleTimeoutSeconds) * time.Second,
+	}
+	if in.Filter != "" {
+		req.Filter = &expr.Expr{}
+		if err := req.Filter.DecodeString(in.Filter); err != nil {
+			msg := NewErrorResponse("ERR_PUBSUB_REPLAY_FILTER", fmt.Sprintf(messages.ErrPubsubReplayFilter, err))
+			fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusBadRequest, msg))
+			log.Debug(msg)
+			return
+		}
+	}
+
+	replayed, err := replayer.ReplayDeadLetters(reqCtx, req)
+	if err != nil {
+		status := nethttp.StatusInternalServerError
+		msg := NewErrorResponse("ERR_PUBSUB_REPLAY",
+			fmt.Sprintf(messages.ErrPubsubReplay, topic, pubsubName, err.Error()))
+
+		if errors.As(err, &runtimePubsub.NotAllowedError{}) {
+			msg = NewErrorResponse("ERR_PUBSUB_FORBIDDEN", err.Error())
+			status = nethttp.StatusForbidden
+		}
+
+		fasthttpRespond(reqCtx, fasthttpResponseWithError(status, msg))
+		log.Debug(msg)
+		return
+	}
+
+	b, _ := json.Marshal(replayDeadLettersResponse{ReplayedCount: replayed})
+	fasthttpRespond(reqCtx, fasthttpResponseWithJSON(nethttp.StatusOK, b))
+}
+
 // validateAndGetPubsubAndTopic takes input as request context and returns the pubsub interface, pubsub name, topic name,
 // or error status code and an ErrorResponse object.
 func (a *api) validateAndGetPubsubAndTopic(reqCtx *fasthttp.RequestCtx) (pubsub.PubSub, string, string, int, *ErrorResponse) {
@@ -2153,10 +2354,23 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		}
 	}
 
+	// Publish intents are created from the values before they're encrypted
+	var outboxIntents []state.TransactionalStateOperation
+	if a.outbox != nil {
+		var err error
+		outboxIntents, err = a.outbox.Intents(storeName, operations)
+		if err != nil {
+			msg := NewErrorResponse("ERR_STATE_TRANSACTION", fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
+			fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusInternalServerError, msg))
+			log.Debug(msg)
+			return
+		}
+	}
+
 	if maxMulti, ok := store.(state.TransactionalStoreMultiMaxSize); ok {
 		max := maxMulti.MultiMaxSize()
-		if max > 0 && len(operations) > max {
-			err := messages.ErrStateTooManyTransactionalOp.WithFormat(len(operations), max)
+		if count := len(operations) + len(outboxIntents); max > 0 && count > max {
+			err := messages.ErrStateTooManyTransactionalOp.WithFormat(count, max)
 			log.Debug(err)
 			universalFastHTTPErrorResponder(reqCtx, err)
 			return
@@ -2184,6 +2398,8 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		}
 	}
 
+	operations = append(operations, outboxIntents...)
+
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[any](reqCtx,
 		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
@@ -2204,6 +2420,9 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		fasthttpRespond(reqCtx, fasthttpResponseWithError(nethttp.StatusInternalServerError, msg))
 		log.Debug(msg)
 	} else {
+		if a.outbox != nil {
+			a.outbox.Enqueue(storeName, outboxIntents)
+		}
 		fasthttpRespond(reqCtx, fasthttpResponseWithEmpty())
 	}
 }
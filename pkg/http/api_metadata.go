@@ -41,6 +41,35 @@ func (a *api) constructMetadataEndpoints() []Endpoint {
 	}
 }
 
+func (a *api) constructPauseEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "subscriptions/{pubsubname}/{topic}/pause",
+			Version: apiVersionV1alpha1,
+			Handler: a.onPauseSubscription(),
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "subscriptions/{pubsubname}/{topic}/resume",
+			Version: apiVersionV1alpha1,
+			Handler: a.onResumeSubscription(),
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/pause",
+			Version: apiVersionV1alpha1,
+			Handler: a.onPauseInputBinding(),
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/resume",
+			Version: apiVersionV1alpha1,
+			Handler: a.onResumeInputBinding(),
+		},
+	}
+}
+
 func (a *api) onGetMetadata() http.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.GetMetadata,
@@ -85,6 +114,7 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 							Topic:           v.Topic,
 							Metadata:        v.Metadata,
 							DeadLetterTopic: v.DeadLetterTopic,
+							Paused:          v.Paused,
 						}
 
 						if v.Rules != nil && len(v.Rules.Rules) > 0 {
@@ -130,6 +160,76 @@ func (a *api) onPutMetadata() http.HandlerFunc {
 	)
 }
 
+func (a *api) onPauseSubscription() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.PauseSubscriptionAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.PauseSubscriptionRequestAlpha1, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.PauseSubscriptionRequestAlpha1) (*runtimev1pb.PauseSubscriptionRequestAlpha1, error) {
+				in.PubsubName = chi.URLParam(r, pubsubnameparam)
+				in.Topic = chi.URLParam(r, topicParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
+func (a *api) onResumeSubscription() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ResumeSubscriptionAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ResumeSubscriptionRequestAlpha1, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ResumeSubscriptionRequestAlpha1) (*runtimev1pb.ResumeSubscriptionRequestAlpha1, error) {
+				in.PubsubName = chi.URLParam(r, pubsubnameparam)
+				in.Topic = chi.URLParam(r, topicParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
+func (a *api) onPauseInputBinding() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.PauseInputBindingAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.PauseInputBindingRequestAlpha1, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.PauseInputBindingRequestAlpha1) (*runtimev1pb.PauseInputBindingRequestAlpha1, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
+func (a *api) onResumeInputBinding() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ResumeInputBindingAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ResumeInputBindingRequestAlpha1, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ResumeInputBindingRequestAlpha1) (*runtimev1pb.ResumeInputBindingRequestAlpha1, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
 type metadataResponse struct {
 	ID                      string                                  `json:"id,omitempty"`
 	RuntimeVersion          string                                  `json:"runtimeVersion,omitempty"`
@@ -148,6 +248,7 @@ type metadataResponsePubsubSubscription struct {
 	Metadata        map[string]string                        `json:"metadata,omitempty"`
 	Rules           []metadataResponsePubsubSubscriptionRule `json:"rules,omitempty"`
 	DeadLetterTopic string                                   `json:"deadLetterTopic"`
+	Paused          bool                                     `json:"paused,omitempty"`
 }
 
 type metadataResponsePubsubSubscriptionRule struct {
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+func (a *api) constructResiliencyEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "resiliency/status",
+			Version: apiVersionV1alpha1,
+			Handler: a.onGetResiliencyStatus(),
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "resiliency/circuitbreakers/reset",
+			Version: apiVersionV1alpha1,
+			Handler: a.onCircuitBreakerRequest(a.universal.ResetCircuitBreakerAlpha1),
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "resiliency/circuitbreakers/open",
+			Version: apiVersionV1alpha1,
+			Handler: a.onCircuitBreakerRequest(a.universal.OpenCircuitBreakerAlpha1),
+		},
+	}
+}
+
+func (a *api) onGetResiliencyStatus() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetResiliencyStatusAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetResiliencyStatusRequestAlpha1, *runtimev1pb.GetResiliencyStatusResponseAlpha1]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.GetResiliencyStatusRequestAlpha1) (*runtimev1pb.GetResiliencyStatusRequestAlpha1, error) {
+				q := r.URL.Query()
+				in.TargetType = q.Get("targetType")
+				in.Target = q.Get("target")
+				in.ComponentType = q.Get("componentType")
+				return in, nil
+			},
+		},
+	)
+}
+
+func (a *api) onCircuitBreakerRequest(fn func(context.Context, *runtimev1pb.CircuitBreakerRequestAlpha1) (*emptypb.Empty, error)) http.HandlerFunc {
+	return UniversalHTTPHandler(
+		fn,
+		UniversalHTTPHandlerOpts[*runtimev1pb.CircuitBreakerRequestAlpha1, *emptypb.Empty]{
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
@@ -68,6 +68,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/dapr/utils"
@@ -313,6 +314,140 @@ func TestPubSubEndpoints(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+type delayedPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	cancelFn func(ctx context.Context, pubsubName, id string) error
+}
+
+func (a *delayedPubSubAdapter) CancelDelayedMessage(ctx context.Context, pubsubName, id string) error {
+	return a.cancelFn(ctx, pubsubName, id)
+}
+
+func TestCancelDelayedMessageEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		universal: &universalapi.UniversalAPI{
+			AppID: "fakeAPI",
+		},
+		pubsubAdapter: &delayedPubSubAdapter{
+			cancelFn: func(ctx context.Context, pubsubName, id string) error {
+				switch {
+				case pubsubName == "errnotfound":
+					return runtimePubsub.NotFoundError{PubsubName: pubsubName}
+				case id == "unknown":
+					return delayed.ErrNotFound
+				case id == "error":
+					return errors.New("error from state store")
+				}
+				return nil
+			},
+		},
+		compStore: compstore.New(),
+	}
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("Cancel successfully - 204 No Content", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", apiVersionV1alpha1+"/publish/delayed/pubsubname/msg1", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+	})
+
+	t.Run("Cancel unknown message - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", apiVersionV1alpha1+"/publish/delayed/pubsubname/unknown", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_DELAYED_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Pubsub not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", apiVersionV1alpha1+"/publish/delayed/errnotfound/msg1", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Cancel error - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", apiVersionV1alpha1+"/publish/delayed/pubsubname/error", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_CANCEL_DELAYED", resp.ErrorBody["errorCode"])
+	})
+}
+
+type replayPubSubAdapter struct {
+	daprt.MockPubSubAdapter
+	replayFn func(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error)
+}
+
+func (a *replayPubSubAdapter) ReplayDeadLetters(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error) {
+	return a.replayFn(ctx, req)
+}
+
+func TestReplayDeadLettersEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		universal: &universalapi.UniversalAPI{
+			AppID: "fakeAPI",
+		},
+		pubsubAdapter: &replayPubSubAdapter{
+			replayFn: func(ctx context.Context, req runtimePubsub.ReplayRequest) (int, error) {
+				switch req.PubsubName {
+				case "errnotallowed":
+					return 0, runtimePubsub.NotAllowedError{Topic: req.DeadLetterTopic, ID: "fakeAPI"}
+				case "errorpubsub":
+					return 0, errors.New("error from component")
+				}
+				assert.Equal(t, "dlq", req.DeadLetterTopic)
+				assert.Equal(t, "orders", req.Topic)
+				assert.Equal(t, 5*time.Second, req.IdleTimeout)
+				return req.MaxMessages, nil
+			},
+		},
+		compStore: compstore.New(),
+	}
+	mock := daprt.MockPubSub{}
+	testAPI.compStore.AddPubSub("pubsubname", compstore.PubsubItem{Component: &mock})
+	testAPI.compStore.AddPubSub("errnotallowed", compstore.PubsubItem{Component: &mock})
+	testAPI.compStore.AddPubSub("errorpubsub", compstore.PubsubItem{Component: &mock})
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	body := []byte(`{"maxMessages": 3, "topic": "orders", "idleTimeoutSeconds": 5}`)
+
+	t.Run("Replay successfully - 200 OK", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/pubsubname/dlq", body, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"replayedCount":3}`, string(resp.RawBody))
+	})
+
+	t.Run("Missing max messages - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/pubsubname/dlq", []byte(`{}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Invalid filter - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/pubsubname/dlq", []byte(`{"maxMessages": 3, "filter": "event.type =="}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REPLAY_FILTER", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Pubsub not found - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/notfound/dlq", body, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Topic not allowed - 403", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/errnotallowed/dlq", body, nil)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_FORBIDDEN", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Replay error - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/publish/replay/errorpubsub/dlq", body, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REPLAY", resp.ErrorBody["errorCode"])
+	})
+}
+
 func TestBulkPubSubEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
@@ -1884,6 +2019,163 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestV1DirectMessagingEndpointsWithFallback(t *testing.T) {
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+	fallbackResiliency := &v1alpha1.Resiliency{
+		Spec: v1alpha1.ResiliencySpec{
+			Policies: v1alpha1.Policies{
+				CircuitBreakers: map[string]v1alpha1.CircuitBreaker{
+					"tripOnFailure": {
+						MaxRequests: 1,
+						Timeout:     "1m",
+						Trip:        "consecutiveFailures > 0",
+					},
+				},
+				Fallbacks: map[string]v1alpha1.Fallback{
+					"staticResponse": {
+						Response: &v1alpha1.FallbackResponse{
+							StatusCode:  203,
+							Headers:     map[string]string{"X-Fallback": "staticResponse"},
+							ContentType: "text/plain",
+							Body:        "fallbackResponse",
+						},
+					},
+					"alternateApp": {
+						AppID:  "alternateApp",
+						Method: "alternateMethod",
+					},
+					"circuitBreakerOpen": {
+						Response: &v1alpha1.FallbackResponse{
+							Body: "circuitBreakerOpen",
+						},
+						OnCircuitBreakerOpen: true,
+					},
+				},
+			},
+			Targets: v1alpha1.Targets{
+				Apps: map[string]v1alpha1.EndpointPolicyNames{
+					"staticApp": {
+						Fallback: "staticResponse",
+					},
+					"failoverApp": {
+						Fallback: "alternateApp",
+					},
+					"circuitBreakerApp": {
+						CircuitBreaker: "tripOnFailure",
+						Fallback:       "circuitBreakerOpen",
+					},
+				},
+			},
+		},
+	}
+
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		directMessaging: mockDirectMessaging,
+		universal: &universalapi.UniversalAPI{
+			CompStore:  compstore.New(),
+			Resiliency: resiliency.FromConfigurations(logger.NewLogger("messaging.test"), fallbackResiliency),
+		},
+	}
+	fakeServer.StartServer(testAPI.constructDirectMessagingEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	matchAppID := func(appID string) any {
+		return mock.MatchedBy(func(b string) bool {
+			return b == appID
+		})
+	}
+	matchMethod := func(method string) any {
+		return mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+			return req.Message().Method == method
+		})
+	}
+
+	t.Run("5xx response returns the static response", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("staticApp"), matchMethod("fakeMethod")).
+			Return(getFakeDirectMessageResponseWithStatusCode(gohttp.StatusServiceUnavailable), nil).
+			Once()
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/staticApp/method/fakeMethod", []byte("fakeData"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, 203, resp.StatusCode)
+		assert.Equal(t, "text/plain", resp.ContentType)
+		assert.Equal(t, "staticResponse", resp.RawHeader.Get("X-Fallback"))
+		assert.Equal(t, "fallbackResponse", string(resp.RawBody))
+	})
+
+	t.Run("4xx response is returned as-is", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("staticApp"), matchMethod("fakeMethod")).
+			Return(getFakeDirectMessageResponseWithStatusCode(gohttp.StatusNotFound), nil).
+			Once()
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/staticApp/method/fakeMethod", []byte("fakeData"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, gohttp.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "fakeDirectMessageResponse", string(resp.RawBody))
+	})
+
+	t.Run("invalid request is returned as-is", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("staticApp"), matchMethod("fakeMethod")).
+			Return(nil, status.Error(codes.PermissionDenied, "permission denied")).
+			Once()
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/staticApp/method/fakeMethod", []byte("fakeData"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, gohttp.StatusForbidden, resp.StatusCode)
+		assert.NotContains(t, string(resp.RawBody), "fallbackResponse")
+	})
+
+	t.Run("failed call is sent to the alternate app with the fallback method", func(t *testing.T) {
+		fakeDirectMessageResponse := getFakeDirectMessageResponse()
+		defer fakeDirectMessageResponse.Close()
+
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("failoverApp"), matchMethod("fakeMethod")).
+			Return(getFakeDirectMessageResponseWithStatusCode(gohttp.StatusInternalServerError), nil).
+			Once()
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("alternateApp"), matchMethod("alternateMethod")).
+			Return(fakeDirectMessageResponse, nil).
+			Once()
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/failoverApp/method/fakeMethod", []byte("fakeData"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 2)
+		assert.Equal(t, gohttp.StatusOK, resp.StatusCode)
+		assert.Equal(t, "fakeDirectMessageResponse", string(resp.RawBody))
+	})
+
+	t.Run("fallback restricted to open circuit breakers", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On("Invoke", mock.MatchedBy(matchContextInterface), matchAppID("circuitBreakerApp"), matchMethod("fakeMethod")).
+			Return(getFakeDirectMessageResponseWithStatusCode(gohttp.StatusServiceUnavailable), nil).
+			Once()
+
+		// The failure trips the circuit breaker but is returned as-is.
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/circuitBreakerApp/method/fakeMethod", []byte("fakeData"), nil)
+		assert.Equal(t, gohttp.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, "fakeDirectMessageResponse", string(resp.RawBody))
+
+		// The call rejected by the open circuit breaker doesn't hit the app and returns the static response.
+		resp = fakeServer.DoRequest("POST", "v1.0/invoke/circuitBreakerApp/method/fakeMethod", []byte("fakeData"), nil)
+		assert.Equal(t, gohttp.StatusOK, resp.StatusCode)
+		assert.Equal(t, "circuitBreakerOpen", string(resp.RawBody))
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+	})
+}
+
 func TestV1ActorEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	rc := resiliency.FromConfigurations(logger.NewLogger("test.api.http.actors"), testResiliency)
@@ -2843,6 +3135,81 @@ func TestV1MetadataEndpoint(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+type fakeResourcePauser struct {
+	calls []string
+}
+
+func (f *fakeResourcePauser) PauseSubscription(pubsubName, topic string) error {
+	f.calls = append(f.calls, "pause "+pubsubName+"/"+topic)
+	return nil
+}
+
+func (f *fakeResourcePauser) ResumeSubscription(pubsubName, topic string) error {
+	f.calls = append(f.calls, "resume "+pubsubName+"/"+topic)
+	return nil
+}
+
+func (f *fakeResourcePauser) PauseInputBinding(name string) error {
+	f.calls = append(f.calls, "pause "+name)
+	return nil
+}
+
+func (f *fakeResourcePauser) ResumeInputBinding(name string) error {
+	f.calls = append(f.calls, "resume "+name)
+	return nil
+}
+
+func TestV1PauseEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	compStore.SetTopicRoutes(map[string]compstore.TopicRoutes{
+		"pubsub": {"topic": {}},
+	})
+	compStore.AddInputBinding("binding", &daprt.MockBinding{})
+
+	pauser := &fakeResourcePauser{}
+	testAPI := &api{
+		universal: &universalapi.UniversalAPI{
+			Logger:             log,
+			CompStore:          compStore,
+			SubscriptionPauser: pauser,
+			InputBindingPauser: pauser,
+		},
+	}
+
+	fakeServer.StartServer(testAPI.constructPauseEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("Pause and resume subscription", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsub/topic/pause", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsub/topic/resume", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+	})
+
+	t.Run("Pause and resume input binding", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/binding/pause", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/binding/resume", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+	})
+
+	t.Run("Subscription not found", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsub/other/pause", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Input binding not found", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/other/resume", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_INPUT_BINDING_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	assert.Equal(t, []string{"pause pubsub/topic", "resume pubsub/topic", "pause binding", "resume binding"}, pauser.calls)
+}
+
 func createExporters(buffer *string) {
 	exporter := testtrace.NewStringExporter(buffer, logger.NewLogger("fakeLogger"))
 	exporter.Register("fakeID")
@@ -4774,6 +5141,78 @@ func TestV1StateEndpoints(t *testing.T) {
 	})
 }
 
+func TestV1StateEndpointsWithFallback(t *testing.T) {
+	fallbackResiliency := &v1alpha1.Resiliency{
+		Spec: v1alpha1.ResiliencySpec{
+			Policies: v1alpha1.Policies{
+				Timeouts: map[string]string{
+					"fast": "100ms",
+				},
+				Fallbacks: map[string]v1alpha1.Fallback{
+					"fallbackStore": {
+						Component: "fallbackStore",
+					},
+				},
+			},
+			Targets: v1alpha1.Targets{
+				Components: map[string]v1alpha1.ComponentPolicyNames{
+					"failStore": {
+						Outbound: v1alpha1.PolicyNames{
+							Timeout:  "fast",
+							Fallback: "fallbackStore",
+						},
+					},
+				},
+			},
+		},
+	}
+	failingStore := &daprt.FailingStatestore{
+		Failure: daprt.NewFailure(
+			map[string]int{
+				"error-key": 1,
+			},
+			map[string]time.Duration{
+				"good-key": time.Second,
+			},
+			map[string]int{},
+		),
+	}
+
+	fakeServer := newFakeHTTPServer()
+	compStore := compstore.New()
+	compStore.AddStateStore("failStore", failingStore)
+	compStore.AddStateStore("fallbackStore", newFakeStateStoreQuerier())
+	rc := resiliency.FromConfigurations(logger.NewLogger("state.test"), fallbackResiliency)
+	testAPI := &api{
+		resiliency: rc,
+		universal: &universalapi.UniversalAPI{
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: rc,
+		},
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("timeout gets the key from the fallback store", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/failStore/good-key", nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "\"bGlmZSBpcyBnb29k\"", string(resp.RawBody))
+		assert.Equal(t, "`~!@#$%^&*()_+-={}[]|\\:\";'<>?,./'", resp.RawHeader.Get("ETag"))
+		assert.Equal(t, 1, failingStore.Failure.CallCount("good-key"))
+	})
+
+	t.Run("other errors are returned as-is", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/failStore/error-key", nil, nil)
+
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_GET", resp.ErrorBody["errorCode"])
+		assert.Contains(t, resp.ErrorBody["message"], "forced failure")
+		assert.Equal(t, 1, failingStore.Failure.CallCount("error-key"))
+	})
+}
+
 func TestStateStoreQuerierNotImplemented(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	compStore := compstore.New()
@@ -21,6 +21,8 @@ import (
 
 	chi "github.com/go-chi/chi/v5"
 
+	"github.com/dapr/dapr/pkg/concurrency"
+	"github.com/dapr/dapr/pkg/messages"
 	authConsts "github.com/dapr/dapr/pkg/runtime/security/consts"
 	"github.com/dapr/dapr/utils/streams"
 )
@@ -35,6 +37,22 @@ func MaxBodySizeMiddleware(maxSize int64) func(next http.Handler) http.Handler {
 	}
 }
 
+// LoadSheddingMiddleware rejects requests with a 429 status code when the adaptive concurrency limit of the API group is reached.
+func LoadSheddingMiddleware(group string, limiter *concurrency.AdaptiveLimiter) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			done, ok := limiter.TryAcquire()
+			if !ok {
+				respondWithError(w, messages.ErrAPIOverloaded.WithFormat(group))
+				return
+			}
+			defer done()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // APITokenAuthMiddleware enforces authentication using the dapr-api-token header.
 func APITokenAuthMiddleware(token string) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
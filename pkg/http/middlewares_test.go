@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/dapr/dapr/pkg/concurrency"
 	authConsts "github.com/dapr/dapr/pkg/runtime/security/consts"
 )
 
@@ -157,6 +158,51 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 	})
 }
 
+func TestLoadSheddingMiddleware(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("block") != "" {
+			close(started)
+			<-release
+		}
+		fmt.Fprint(w, "👋")
+	})
+
+	limiter := concurrency.NewAdaptiveLimiter(concurrency.AdaptiveLimiterOptions{
+		InitialLimit: 1,
+		MinLimit:     1,
+		MaxLimit:     1,
+	})
+	h := LoadSheddingMiddleware("state", limiter)(handler)
+
+	blocked := httptest.NewRecorder()
+	doneCh := make(chan struct{})
+	go func() {
+		h.ServeHTTP(blocked, httptest.NewRequest(http.MethodGet, "/v1.0/state/foo?block=1", nil))
+		close(doneCh)
+	}()
+	<-started
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1.0/state/foo", nil))
+	res := w.Result()
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Contains(t, string(resBody), "ERR_TOO_MANY_REQUESTS")
+
+	close(release)
+	<-doneCh
+	assert.Equal(t, http.StatusOK, blocked.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1.0/state/foo", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "👋", w.Body.String())
+}
+
 // Below is a modified version of the code from https://github.com/go-chi/chi/blob/v5.0.8/middleware/strip_test.go
 // Original code Copyright (c) 2015-present Peter Kieltyka (https://github.com/pkieltyka), Google Inc.
 // Original code license: MIT: https://github.com/go-chi/chi/blob/v5.0.8/LICENSE
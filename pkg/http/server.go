@@ -34,6 +34,7 @@ import (
 	chi "github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
 
+	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	corsDapr "github.com/dapr/dapr/pkg/cors"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -349,12 +350,23 @@ func (s *server) setupRoutes(r chi.Router, endpoints []Endpoint) {
 	// Build the API allowlist and denylist
 	allowedAPIs := s.apiSpec.Allowed.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 	deniedAPIs := s.apiSpec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+	loadShedding := s.getLoadSheddingMiddlewares()
 
 	for _, e := range endpoints {
 		if !e.IsAllowed(allowedAPIs, deniedAPIs) {
 			continue
 		}
 
+		if !e.IsHealthCheck {
+			for _, ls := range loadShedding {
+				if isRouteInGroup(e.Route, ls.group) {
+					e.Handler = ls.middleware(e.GetHandler()).ServeHTTP
+					e.FastHTTPHandler = nil
+					break
+				}
+			}
+		}
+
 		path := "/" + e.Version + "/" + e.Route
 		s.handle(
 			e, path, r,
@@ -364,6 +376,37 @@ func (s *server) setupRoutes(r chi.Router, endpoints []Endpoint) {
 	}
 }
 
+// isRouteInGroup returns true if the route belongs to the API group, e.g. "state/{storeName}" to "state".
+// Groups which are a prefix of another group's name, like "s" of "secrets", don't match its routes.
+func isRouteInGroup(route string, group string) bool {
+	return route == group || strings.HasPrefix(route, group+"/")
+}
+
+type loadSheddingMiddleware struct {
+	group      string
+	middleware func(next http.Handler) http.Handler
+}
+
+// getLoadSheddingMiddlewares returns the middlewares for the API groups with load shedding enabled, in the order of the rules.
+func (s *server) getLoadSheddingMiddlewares() []loadSheddingMiddleware {
+	res := make([]loadSheddingMiddleware, 0, len(s.apiSpec.LoadShedding))
+	for _, rule := range s.apiSpec.LoadShedding {
+		if !rule.AppliesTo(config.APIAccessRuleProtocolHTTP) {
+			continue
+		}
+		opts, err := rule.LimiterOptions()
+		if err != nil {
+			log.Warnf("Using the default latency threshold for load shedding: %v", err)
+		}
+		log.Infof("Enabled load shedding HTTP middleware for API group %s", rule.Name)
+		res = append(res, loadSheddingMiddleware{
+			group:      rule.Name,
+			middleware: LoadSheddingMiddleware(rule.Name, concurrency.NewAdaptiveLimiter(opts)),
+		})
+	}
+	return res
+}
+
 func (s *server) handle(e Endpoint, path string, r chi.Router, unescapeParameters bool, apiLogging bool) {
 	handler := e.GetHandler()
 
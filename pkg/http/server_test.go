@@ -454,3 +454,26 @@ func TestClose(t *testing.T) {
 		assert.NoError(t, server.Close())
 	})
 }
+
+func TestIsRouteInGroup(t *testing.T) {
+	tests := []struct {
+		route string
+		group string
+		want  bool
+	}{
+		{route: "state/{storeName}/{key}", group: "state", want: true},
+		{route: "healthz", group: "healthz", want: true},
+		{route: "secrets/{secretStoreName}/{key}", group: "secrets", want: true},
+		{route: "secrets/{secretStoreName}/{key}", group: "s", want: false},
+		{route: "s/{name}", group: "s", want: true},
+		{route: "configuration/{storeName}", group: "config", want: false},
+		{route: "configuration/{storeName}", group: "configuration", want: true},
+		{route: "config/{name}", group: "configuration", want: false},
+		{route: "config", group: "config", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.group+" "+tt.route, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRouteInGroup(tt.route, tt.group))
+		})
+	}
+}
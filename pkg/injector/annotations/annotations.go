@@ -69,4 +69,6 @@ const (
 	KeyPluggableComponentContainer      = "dapr.io/component-container"
 	KeyPluggableComponentsInjection     = "dapr.io/inject-pluggable-components"
 	KeyAppChannel                       = "dapr.io/app-channel-address"
+	KeyTopologyRegion                   = "dapr.io/topology-region"
+	KeyTopologyZone                     = "dapr.io/topology-zone"
 )
@@ -105,6 +105,8 @@ type SidecarConfig struct {
 	ComponentContainer                  string `annotation:"dapr.io/component-container"`
 	InjectPluggableComponents           bool   `annotation:"dapr.io/inject-pluggable-components"`
 	AppChannelAddress                   string `annotation:"dapr.io/app-channel-address"`
+	TopologyRegion                      string `annotation:"dapr.io/topology-region"`
+	TopologyZone                        string `annotation:"dapr.io/topology-zone"`
 
 	pod *corev1.Pod
 }
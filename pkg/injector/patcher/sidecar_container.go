@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	env "github.com/dapr/dapr/pkg/config/env"
 	"github.com/dapr/dapr/pkg/config/protocol"
 	injectorConsts "github.com/dapr/dapr/pkg/injector/consts"
 	authConsts "github.com/dapr/dapr/pkg/runtime/security/consts"
@@ -344,6 +345,8 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		})
 	}
 
+	container.Env = append(container.Env, c.getTopologyEnv()...)
+
 	// Resources for the container
 	resources, err := c.getResourceRequirements()
 	if err != nil {
@@ -355,6 +358,39 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 	return container, nil
 }
 
+// getTopologyEnv returns the env vars with the failure domain of the pod, used for topology-aware actor placement.
+// The region and the zone are set from the annotations if present, otherwise from the topology labels of the pod,
+// which are copied from the node by Kubernetes when the PodTopologyLabelsAdmission feature is enabled.
+// The pod isn't scheduled yet when it is injected, so the labels of the node can't be read here.
+func (c *SidecarConfig) getTopologyEnv() []corev1.EnvVar {
+	fromAnnotationOrLabel := func(name, value, label string) corev1.EnvVar {
+		if value != "" {
+			return corev1.EnvVar{Name: name, Value: value}
+		}
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.labels['" + label + "']",
+				},
+			},
+		}
+	}
+
+	return []corev1.EnvVar{
+		fromAnnotationOrLabel(env.TopologyRegion, c.TopologyRegion, corev1.LabelTopologyRegion),
+		fromAnnotationOrLabel(env.TopologyZone, c.TopologyZone, corev1.LabelTopologyZone),
+		{
+			Name: env.TopologyNode,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "spec.nodeName",
+				},
+			},
+		},
+	}
+}
+
 func (c *SidecarConfig) getResourceRequirements() (*corev1.ResourceRequirements, error) {
 	r := corev1.ResourceRequirements{
 		Limits:   corev1.ResourceList{},
@@ -1094,4 +1094,45 @@ func TestGetSidecarContainer(t *testing.T) {
 			},
 		},
 	}))
+
+	t.Run("topology", testSuiteGenerator([]testCase{
+		{
+			name:        "from pod labels and node name by default",
+			annotations: map[string]string{},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				found := map[string]string{}
+				for _, e := range container.Env {
+					if strings.HasPrefix(e.Name, "DAPR_TOPOLOGY_") {
+						require.NotNil(t, e.ValueFrom)
+						found[e.Name] = e.ValueFrom.FieldRef.FieldPath
+					}
+				}
+				assert.Equal(t, map[string]string{
+					"DAPR_TOPOLOGY_REGION": "metadata.labels['topology.kubernetes.io/region']",
+					"DAPR_TOPOLOGY_ZONE":   "metadata.labels['topology.kubernetes.io/zone']",
+					"DAPR_TOPOLOGY_NODE":   "spec.nodeName",
+				}, found)
+			},
+		},
+		{
+			name: "from annotations",
+			annotations: map[string]string{
+				annotations.KeyTopologyRegion: "region-1",
+				annotations.KeyTopologyZone:   "zone-a",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				found := map[string]string{}
+				for _, e := range container.Env {
+					switch e.Name {
+					case "DAPR_TOPOLOGY_REGION", "DAPR_TOPOLOGY_ZONE":
+						found[e.Name] = e.Value
+					}
+				}
+				assert.Equal(t, map[string]string{
+					"DAPR_TOPOLOGY_REGION": "region-1",
+					"DAPR_TOPOLOGY_ZONE":   "zone-a",
+				}, found)
+			},
+		},
+	}))
 }
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package watch notifies the runtime when resources loaded from the resources paths or from the operator change.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// batchInterval is how long changes to the resources paths are batched for before onChange is called.
+	batchInterval = 500 * time.Millisecond
+	// missingPathsInterval is how often the resources paths which don't exist are checked for again.
+	missingPathsInterval = time.Second
+)
+
+// UpdateEvent is an event sent by the operator when a resource is created, modified or deleted.
+type UpdateEvent interface {
+	GetDeleted() bool
+}
+
+// UpdateStream is a stream of update events sent by the operator.
+type UpdateStream[E UpdateEvent] interface {
+	Recv() (E, error)
+}
+
+// Local watches the resources paths and calls onChange every time a file in them is created, modified or removed.
+// Paths which don't exist, or which are removed, are watched once they are created, and onChange is then called.
+// kind describes the resources which are reloaded by onChange, e.g. "declarative subscriptions".
+// It blocks until the context is canceled.
+func Local(ctx context.Context, log logger.Logger, kind string, paths []string, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// watched contains all the paths, and missing the ones which aren't watched because they don't exist.
+	watched := make(map[string]struct{}, len(paths))
+	missing := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		watched[filepath.Clean(path)] = struct{}{}
+		missing[filepath.Clean(path)] = struct{}{}
+	}
+	// watchMissing watches the missing paths which now exist, and returns true if any was added.
+	watchMissing := func() bool {
+		added := false
+		for path := range missing {
+			if _, err := os.Stat(path); err != nil {
+				continue
+			}
+			if err := watcher.Add(path); err != nil {
+				log.Warnf("failed to watch %s: %s", path, err)
+				continue
+			}
+			delete(missing, path)
+			added = true
+		}
+		return added
+	}
+	watchMissing()
+
+	batch := time.NewTimer(batchInterval)
+	batch.Stop()
+	defer batch.Stop()
+	retry := time.NewTicker(missingPathsInterval)
+	defer retry.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			// The watch on a path is dropped when it's removed, so it's watched again once it's re-created.
+			if _, ok := watched[event.Name]; ok && event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				missing[event.Name] = struct{}{}
+			}
+			// Reload once no change happened for the batch interval.
+			batch.Reset(batchInterval)
+
+		case <-retry.C:
+			if len(missing) > 0 && watchMissing() {
+				batch.Reset(batchInterval)
+			}
+
+		case <-batch.C:
+			log.Infof("resources changed, reloading %s", kind)
+			onChange()
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			// Errors such as dropped events don't stop the watcher, so the resources are reloaded in case changes were missed.
+			log.Warnf("error watching the resources paths for %s: %s", kind, err)
+			batch.Reset(batchInterval)
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Operator opens a stream of update events with connect and calls onChange every time an event is received.
+// The stream is opened again when it fails, and onChange is then called once more so updates sent while it was
+// closed aren't missed. kind describes the resources which are reloaded by onChange, e.g. "declarative subscriptions".
+// It blocks until the context is canceled.
+func Operator[E UpdateEvent](ctx context.Context, log logger.Logger, kind string, connect func(context.Context) (UpdateStream[E], error), onChange func()) error {
+	needList := false
+	for ctx.Err() == nil {
+		stream, err := backoff.RetryWithData(func() (UpdateStream[E], error) {
+			stream, err := connect(ctx)
+			if err != nil {
+				log.Errorf("error from operator stream: %s", err)
+				return nil, err
+			}
+			return stream, nil
+		}, backoff.WithContext(backoff.NewExponentialBackOff(), ctx))
+		if err != nil {
+			needList = true
+			continue
+		}
+
+		if needList {
+			// Get all resources again to avoid missing any updates during the failure time.
+			onChange()
+		}
+
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				// Retry on stream error.
+				needList = true
+				if ctx.Err() == nil {
+					log.Errorf("error from operator stream: %s", err)
+				}
+				break
+			}
+
+			log.Debugf("received update of %s (deleted: %t)", kind, e.GetDeleted())
+			onChange()
+		}
+	}
+
+	return nil
+}
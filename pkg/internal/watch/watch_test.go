@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.test")
+
+type fakeEvent struct {
+	deleted bool
+}
+
+func (e *fakeEvent) GetDeleted() bool {
+	return e.deleted
+}
+
+// fakeStream returns the events sent on its channel, and an error once the channel is closed.
+type fakeStream struct {
+	events chan *fakeEvent
+}
+
+func (s *fakeStream) Recv() (*fakeEvent, error) {
+	e, ok := <-s.events
+	if !ok {
+		return nil, errors.New("stream closed")
+	}
+	return e, nil
+}
+
+func waitChange(t *testing.T, changes <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "onChange was not called")
+	}
+}
+
+func TestLocal(t *testing.T) {
+	dir := t.TempDir()
+	missingDir := filepath.Join(dir, "missing")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	changes := make(chan struct{}, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Local(ctx, log, "resources", []string{dir, missingDir}, func() {
+			changes <- struct{}{}
+		})
+	}()
+	// Wait for the watcher to start.
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("files are watched", func(t *testing.T) {
+		filePath := filepath.Join(dir, "resource.yaml")
+		require.NoError(t, os.WriteFile(filePath, []byte("kind: Resource"), 0o600))
+		waitChange(t, changes)
+
+		require.NoError(t, os.Remove(filePath))
+		waitChange(t, changes)
+	})
+
+	t.Run("paths are watched once they are created", func(t *testing.T) {
+		require.NoError(t, os.Mkdir(missingDir, 0o700))
+		waitChange(t, changes)
+
+		require.NoError(t, os.WriteFile(filepath.Join(missingDir, "resource.yaml"), []byte("kind: Resource"), 0o600))
+		waitChange(t, changes)
+	})
+
+	t.Run("paths are watched again once they are re-created", func(t *testing.T) {
+		require.NoError(t, os.RemoveAll(missingDir))
+		waitChange(t, changes)
+
+		require.NoError(t, os.Mkdir(missingDir, 0o700))
+		waitChange(t, changes)
+
+		require.NoError(t, os.WriteFile(filepath.Join(missingDir, "resource.yaml"), []byte("kind: Resource"), 0o600))
+		waitChange(t, changes)
+	})
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestOperator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	streams := make(chan *fakeStream, 2)
+	connect := func(context.Context) (UpdateStream[*fakeEvent], error) {
+		select {
+		case s := <-streams:
+			return s, nil
+		default:
+			return nil, errors.New("operator unavailable")
+		}
+	}
+
+	changes := make(chan struct{}, 1)
+	errCh := make(chan error, 1)
+	first := &fakeStream{events: make(chan *fakeEvent)}
+	streams <- first
+	go func() {
+		errCh <- Operator(ctx, log, "resources", connect, func() {
+			changes <- struct{}{}
+		})
+	}()
+
+	t.Run("onChange is called for every event", func(t *testing.T) {
+		first.events <- &fakeEvent{}
+		waitChange(t, changes)
+		first.events <- &fakeEvent{deleted: true}
+		waitChange(t, changes)
+	})
+
+	t.Run("onChange is called after reconnecting", func(t *testing.T) {
+		second := &fakeStream{events: make(chan *fakeEvent)}
+		streams <- second
+		close(first.events)
+		waitChange(t, changes)
+
+		second.events <- &fakeEvent{}
+		waitChange(t, changes)
+
+		cancel()
+		close(second.events)
+	})
+
+	require.NoError(t, <-errCh)
+	assert.Empty(t, changes)
+}
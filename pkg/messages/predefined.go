@@ -50,6 +50,14 @@ const (
 	ErrPubsubUnmarshal          = "error when unmarshaling the request for topic %s pubsub %s: %s"
 	ErrPubsubMarshal            = "error marshaling events to bytes for topic %s pubsub %s: %s"
 	ErrPubsubGetSubscriptions   = "unable to get app subscriptions %s"
+	ErrPubsubSubscribe          = "error when subscribing to topic %s in pubsub %s: %s"
+	ErrPubsubStreamRequest      = "invalid subscription stream request: %s"
+	ErrPubsubMessageIDEmpty     = "message id is empty"
+	ErrPubsubDelayedNotFound    = "delayed message %s not found in pubsub %s"
+	ErrPubsubCancelDelayed      = "error when canceling delayed message %s in pubsub %s: %s"
+	ErrPubsubReplayMaxMessages  = "the maximum number of messages to replay must be greater than zero"
+	ErrPubsubReplayFilter       = "failed to parse the replay filter: %s"
+	ErrPubsubReplay             = "error when replaying dead letter topic %s in pubsub %s: %s"
 
 	// AppChannel.
 	ErrChannelNotFound       = "app channel is not initialized"
@@ -84,6 +92,7 @@ var (
 	// Generic.
 	ErrBadRequest       = APIError{"invalid request: %v", "ERR_BAD_REQUEST", http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrAPIUnimplemented = APIError{"this API is currently not implemented", "ERR_API_UNIMPLEMENTED", http.StatusNotImplemented, grpcCodes.Unimplemented}
+	ErrAPIOverloaded    = APIError{"too many concurrent requests to the %s API, try again later", "ERR_TOO_MANY_REQUESTS", http.StatusTooManyRequests, grpcCodes.ResourceExhausted}
 
 	// HTTP.
 	ErrBodyRead         = APIError{"failed to read request body: %v", "ERR_BODY_READ", http.StatusBadRequest, grpcCodes.InvalidArgument}
@@ -108,6 +117,24 @@ var (
 
 	// PubSub.
 	ErrPubSubMetadataDeserialize = APIError{"failed deserializing metadata: %v", "ERR_PUBSUB_REQUEST_METADATA", http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrPubsubSubscriptionEmpty   = APIError{"pubsub name and topic are required", "ERR_PUBSUB_SUBSCRIPTION_EMPTY", http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrPubsubSubscriptionMissing = APIError{"app is not subscribed to topic %s on pubsub %s", "ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", http.StatusNotFound, grpcCodes.NotFound}
+	ErrPauseSubscription         = APIError{"error pausing subscription to topic %s on pubsub %s: %s", "ERR_PAUSE_SUBSCRIPTION", http.StatusInternalServerError, grpcCodes.Internal}
+	ErrResumeSubscription        = APIError{"error resuming subscription to topic %s on pubsub %s: %s", "ERR_RESUME_SUBSCRIPTION", http.StatusInternalServerError, grpcCodes.Internal}
+
+	// Bindings.
+	ErrInputBindingNameEmpty = APIError{"input binding name is required", "ERR_INPUT_BINDING_NAME_EMPTY", http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrInputBindingNotFound  = APIError{"input binding %s not found", "ERR_INPUT_BINDING_NOT_FOUND", http.StatusNotFound, grpcCodes.NotFound}
+	ErrPauseInputBinding     = APIError{"error pausing input binding %s: %s", "ERR_PAUSE_INPUT_BINDING", http.StatusInternalServerError, grpcCodes.Internal}
+	ErrResumeInputBinding    = APIError{"error resuming input binding %s: %s", "ERR_RESUME_INPUT_BINDING", http.StatusInternalServerError, grpcCodes.Internal}
+
+	// Resiliency.
+	ErrResiliencyTargetInvalid   = APIError{"invalid resiliency target: %v", "ERR_RESILIENCY_TARGET_INVALID", http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrCircuitBreakerTargetEmpty = APIError{"target type, target, and circuit breaker name are required", "ERR_CIRCUIT_BREAKER_TARGET_EMPTY", http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrCircuitBreakerNotFound    = APIError{"circuit breaker %s not found for %s %s", "ERR_CIRCUIT_BREAKER_NOT_FOUND", http.StatusNotFound, grpcCodes.NotFound}
+	ErrResetCircuitBreaker       = APIError{"error resetting circuit breaker %s for %s %s: %s", "ERR_RESET_CIRCUIT_BREAKER", http.StatusInternalServerError, grpcCodes.Internal}
+	ErrOpenCircuitBreaker        = APIError{"error opening circuit breaker %s for %s %s: %s", "ERR_OPEN_CIRCUIT_BREAKER", http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCircuitBreakerControl     = APIError{"resetting and opening circuit breakers is disabled; enable the ResiliencyCircuitBreakerControl feature to allow it", "ERR_CIRCUIT_BREAKER_CONTROL_DISABLED", http.StatusForbidden, grpcCodes.PermissionDenied}
 
 	// Secrets.
 	ErrSecretStoreNotConfigured = APIError{"secret store is not configured", "ERR_SECRET_STORES_NOT_CONFIGURED", http.StatusInternalServerError, grpcCodes.FailedPrecondition}
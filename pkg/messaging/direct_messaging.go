@@ -150,7 +150,13 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 		return d.invokeLocal(ctx, req)
 	}
 
-	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
+	// Hedge idempotent requests if the app has a hedging policy
+	var fn remoteInvokeFn = d.invokeRemote
+	if hp := d.resiliency.EndpointHedgingPolicy(app.id); hp != nil && hp.CanHedge(req.Message().GetHttpExtension().GetVerb().String(), req.Message().GetMethod()) {
+		fn = d.invokeHedged(hp, d.invokeRemote)
+	}
+
+	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, fn, req)
 }
 
 // SetAppChannel sets the appChannel property in the object.
@@ -204,7 +210,7 @@ func (d *directMessaging) invokeWithRetry(
 	numRetries int,
 	backoffInterval time.Duration,
 	app remoteApp,
-	fn func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error),
+	fn remoteInvokeFn,
 	req *invokev1.InvokeMethodRequest,
 ) (*invokev1.InvokeMethodResponse, error) {
 	if !d.resiliency.PolicyDefined(app.id, resiliency.EndpointPolicy{}) {
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// FallbackResponse returns the static response of a fallback policy as an HTTP response.
+func FallbackResponse(fr *resiliency.FallbackResponse) *invokev1.InvokeMethodResponse {
+	headers := make(map[string][]string, len(fr.Headers))
+	for k, v := range fr.Headers {
+		headers[k] = []string{v}
+	}
+	return invokev1.NewInvokeMethodResponse(int32(fr.StatusCode), "", nil).
+		WithHTTPHeaders(headers).
+		WithRawDataBytes(fr.Body).
+		WithContentType(fr.ContentType)
+}
+
+// WithFallbackMethod sets the method of a fallback policy which invokes an alternate app on the request.
+// The method of the request is unchanged if the policy doesn't set one.
+func WithFallbackMethod(req *invokev1.InvokeMethodRequest, fp *resiliency.FallbackPolicy) *invokev1.InvokeMethodRequest {
+	if fp.Method != "" {
+		req.Message().Method = fp.Method
+	}
+	return req
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// remoteInvokeFn is the function that invokes a method on a remote app.
+type remoteInvokeFn func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error)
+
+type hedgedResult struct {
+	attempt  int
+	resp     *invokev1.InvokeMethodResponse
+	teardown func(destroy bool)
+	err      error
+}
+
+// maxHedgeResolveAttempts is the number of times the app is resolved to find an instance which hasn't been sent the request yet.
+const maxHedgeResolveAttempts = 3
+
+// invokeHedged returns a function that invokes fn, and if no response is received within the delay of the hedging policy,
+// invokes it again on another instance of the app resolved via name resolution.
+// Hedging stops when name resolution doesn't return an instance which hasn't been sent the request yet, for example
+// when the app is resolved to a single address by a Kubernetes service.
+// The first successful response is returned and the other requests are canceled.
+func (d *directMessaging) invokeHedged(hp *resiliency.HedgingPolicy, fn remoteInvokeFn) remoteInvokeFn {
+	return func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error) {
+		// Read the request in memory so it can be sent more than once concurrently
+		pd, err := req.ProtoWithData()
+		if err != nil {
+			return nil, nopTeardown, err
+		}
+
+		results := make(chan hedgedResult, hp.MaxAttempts)
+		// Each request has its own context so the others can be canceled without interrupting the response that is returned.
+		// The context of the returned response is released when the parent context is done, as its body may still be streamed.
+		cancels := make([]context.CancelFunc, 0, hp.MaxAttempts)
+		send := func(address string) {
+			attemptCtx, cancel := context.WithCancel(ctx)
+			attempt := len(cancels)
+			cancels = append(cancels, cancel)
+			go func() {
+				attemptReq, rErr := invokev1.InternalInvokeRequest(proto.Clone(pd).(*internalv1pb.InternalInvokeRequest))
+				if rErr != nil {
+					results <- hedgedResult{attempt: attempt, teardown: nopTeardown, err: rErr}
+					return
+				}
+				defer attemptReq.Close()
+				start := time.Now()
+				resp, teardown, rErr := fn(attemptCtx, appID, namespace, address, attemptReq)
+				if rErr == nil {
+					hp.RecordLatency(time.Since(start))
+				}
+				results <- hedgedResult{attempt: attempt, resp: resp, teardown: teardown, err: rErr}
+			}()
+		}
+
+		send(appAddress)
+		sentTo := map[string]struct{}{appAddress: {}}
+		sent, pending := 1, 1
+		delay := hp.HedgeDelay()
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		var last hedgedResult
+		for pending > 0 {
+			select {
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					// Cancel the other requests and discard their responses
+					for i, cancel := range cancels {
+						if i != res.attempt {
+							cancel()
+						}
+					}
+					go discardHedgedResults(results, pending)
+					return res.resp, res.teardown, nil
+				}
+				cancels[res.attempt]()
+				if last.teardown != nil {
+					releaseHedgedResult(last)
+				}
+				last = res
+			case <-timer.C:
+				if sent >= hp.MaxAttempts {
+					continue
+				}
+				address, ok := d.resolveHedgeAddress(appID, namespace, sentTo)
+				if !ok {
+					log.Debugf("No other instance of app %s to send a hedged request to", appID)
+					sent = hp.MaxAttempts
+					continue
+				}
+				log.Debugf("No response from app %s after %v, sending hedged request to %s", appID, delay, address)
+				sentTo[address] = struct{}{}
+				send(address)
+				sent++
+				pending++
+				if sent < hp.MaxAttempts {
+					delay = hp.HedgeDelay()
+					timer.Reset(delay)
+				}
+			}
+		}
+
+		// All requests failed: return the error of the last one
+		return last.resp, last.teardown, last.err
+	}
+}
+
+// resolveHedgeAddress resolves the address of an instance of the app which is not in sentTo.
+func (d *directMessaging) resolveHedgeAddress(appID, namespace string, sentTo map[string]struct{}) (string, bool) {
+	for i := 0; i < maxHedgeResolveAttempts; i++ {
+		address, err := d.resolver.ResolveID(nr.ResolveRequest{ID: appID, Namespace: namespace, Port: d.grpcPort})
+		if err != nil {
+			log.Debugf("Failed to resolve app %s for hedged request: %v", appID, err)
+			return "", false
+		}
+		if _, ok := sentTo[address]; !ok {
+			return address, true
+		}
+	}
+	return "", false
+}
+
+// discardHedgedResults waits for the pending hedged requests after they are canceled, and releases their resources.
+func discardHedgedResults(results <-chan hedgedResult, pending int) {
+	for i := 0; i < pending; i++ {
+		releaseHedgedResult(<-results)
+	}
+}
+
+func releaseHedgedResult(res hedgedResult) {
+	if res.resp != nil {
+		_ = res.resp.Close()
+	}
+	code := status.Code(res.err)
+	res.teardown(code == codes.Unavailable || code == codes.Unauthenticated)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// fakeResolver resolves apps to its addresses in turn.
+type fakeResolver struct {
+	addresses []string
+	next      atomic.Int32
+}
+
+func (f *fakeResolver) Init(nr.Metadata) error {
+	return nil
+}
+
+func (f *fakeResolver) ResolveID(nr.ResolveRequest) (string, error) {
+	i := int(f.next.Add(1)-1) % len(f.addresses)
+	return f.addresses[i], nil
+}
+
+func TestInvokeHedged(t *testing.T) {
+	r := resiliency.FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Hedging: map[string]resiliencyV1alpha.Hedging{
+					"hedge": {Delay: "20ms", MaxAttempts: 3, Verbs: []string{"GET"}},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"app1": {Hedging: "hedge"},
+				},
+			},
+		},
+	})
+	hp := r.EndpointHedgingPolicy("app1")
+	require.NotNil(t, hp)
+
+	// The "slow" instance doesn't respond until the request is canceled, the "fast" one responds immediately and the "broken" one fails
+	newInvoke := func(calls *atomic.Int32, canceled *atomic.Int32) remoteInvokeFn {
+		return func(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error) {
+			calls.Add(1)
+			data, err := req.RawDataFull()
+			if err != nil || string(data) != "payload" {
+				return nil, nopTeardown, errors.New("invalid request data")
+			}
+			switch {
+			case appAddress == "slow":
+				<-ctx.Done()
+				canceled.Add(1)
+				return nil, nopTeardown, ctx.Err()
+			case strings.HasPrefix(appAddress, "broken"):
+				return nil, nopTeardown, errors.New("simulated")
+			default:
+				return invokev1.NewInvokeMethodResponse(200, "OK", nil).WithRawDataString(appAddress), nopTeardown, nil
+			}
+		}
+	}
+	newRequest := func() *invokev1.InvokeMethodRequest {
+		return invokev1.NewInvokeMethodRequest("method").
+			WithHTTPExtension("GET", "").
+			WithRawDataString("payload")
+	}
+
+	t.Run("hedged request wins", func(t *testing.T) {
+		calls, canceled := atomic.Int32{}, atomic.Int32{}
+		dm := &directMessaging{resolver: &fakeResolver{addresses: []string{"fast"}}}
+		req := newRequest()
+		defer req.Close()
+
+		resp, _, err := dm.invokeHedged(hp, newInvoke(&calls, &canceled))(context.Background(), "app1", "default", "slow", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		data, err := resp.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "fast", string(data))
+		assert.Equal(t, int32(2), calls.Load())
+
+		// The slow request is canceled
+		assert.Eventually(t, func() bool {
+			return canceled.Load() == 1
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("no hedged request if the first one responds in time", func(t *testing.T) {
+		calls, canceled := atomic.Int32{}, atomic.Int32{}
+		dm := &directMessaging{resolver: &fakeResolver{addresses: []string{"fast"}}}
+		req := newRequest()
+		defer req.Close()
+
+		resp, _, err := dm.invokeHedged(hp, newInvoke(&calls, &canceled))(context.Background(), "app1", "default", "first", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		data, err := resp.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "first", string(data))
+
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("all requests fail", func(t *testing.T) {
+		calls, canceled := atomic.Int32{}, atomic.Int32{}
+		dm := &directMessaging{resolver: &fakeResolver{addresses: []string{"broken1", "broken2"}}}
+		req := newRequest()
+		defer req.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, _, err := dm.invokeHedged(hp, newInvoke(&calls, &canceled))(ctx, "app1", "default", "slow", req)
+		require.Error(t, err)
+		// The first request and the two hedged ones
+		assert.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("no hedged request to the instances already sent to", func(t *testing.T) {
+		calls, canceled := atomic.Int32{}, atomic.Int32{}
+		resolver := &fakeResolver{addresses: []string{"slow"}}
+		dm := &directMessaging{resolver: resolver}
+		req := newRequest()
+		defer req.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, _, err := dm.invokeHedged(hp, newInvoke(&calls, &canceled))(ctx, "app1", "default", "slow", req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), calls.Load())
+		// The app is resolved again a few times, and hedging stops after that
+		assert.Equal(t, int32(maxHedgeResolveAttempts), resolver.next.Load())
+	})
+}
@@ -58,6 +58,8 @@ type Server interface {
 	Ready(context.Context) error
 	OnComponentUpdated(ctx context.Context, component *componentsapi.Component)
 	OnHTTPEndpointUpdated(ctx context.Context, endpoint *httpendpointsapi.HTTPEndpoint)
+	OnSubscriptionUpdated(ctx context.Context, subscription *subscriptionsapiV2alpha1.Subscription, deleted bool)
+	OnResiliencyUpdated(ctx context.Context, resiliency *resiliencyapi.Resiliency, deleted bool)
 }
 
 type apiServer struct {
@@ -68,6 +70,8 @@ type apiServer struct {
 	endpointLock           sync.Mutex
 	allConnUpdateChan      map[string]chan *componentsapi.Component
 	allEndpointsUpdateChan map[string]chan *httpendpointsapi.HTTPEndpoint
+	subscriptionUpdates    *updateStreams[*subscriptionsapiV2alpha1.Subscription]
+	resiliencyUpdates      *updateStreams[*resiliencyapi.Resiliency]
 	readyCh                chan struct{}
 	running                atomic.Bool
 }
@@ -78,6 +82,8 @@ func NewAPIServer(client client.Client) Server {
 		Client:                 client,
 		allConnUpdateChan:      make(map[string]chan *componentsapi.Component),
 		allEndpointsUpdateChan: make(map[string]chan *httpendpointsapi.HTTPEndpoint),
+		subscriptionUpdates:    newUpdateStreams[*subscriptionsapiV2alpha1.Subscription]("subscription"),
+		resiliencyUpdates:      newUpdateStreams[*resiliencyapi.Resiliency]("resiliency"),
 		readyCh:                make(chan struct{}),
 	}
 }
@@ -145,6 +151,14 @@ func (a *apiServer) OnHTTPEndpointUpdated(_ context.Context, endpoint *httpendpo
 	a.endpointLock.Unlock()
 }
 
+func (a *apiServer) OnSubscriptionUpdated(_ context.Context, subscription *subscriptionsapiV2alpha1.Subscription, deleted bool) {
+	a.subscriptionUpdates.broadcast(subscription, deleted)
+}
+
+func (a *apiServer) OnResiliencyUpdated(_ context.Context, resiliency *resiliencyapi.Resiliency, deleted bool) {
+	a.resiliencyUpdates.broadcast(resiliency, deleted)
+}
+
 func (a *apiServer) Ready(ctx context.Context) error {
 	select {
 	case <-a.readyCh:
@@ -571,3 +585,23 @@ func (a *apiServer) HTTPEndpointUpdate(in *operatorv1pb.HTTPEndpointUpdateReques
 		}
 	}
 }
+
+// SubscriptionUpdate updates Dapr sidecars whenever a pub/sub subscription in the cluster is created, modified or deleted.
+func (a *apiServer) SubscriptionUpdate(in *operatorv1pb.SubscriptionUpdateRequest, srv operatorv1pb.Operator_SubscriptionUpdateServer) error { //nolint:nosnakecase
+	return a.subscriptionUpdates.serve(srv.Context(), in.Namespace, in.PodName, func(b []byte, deleted bool) error {
+		return srv.Send(&operatorv1pb.SubscriptionUpdateEvent{
+			Subscription: b,
+			Deleted:      deleted,
+		})
+	})
+}
+
+// ResiliencyUpdate updates Dapr sidecars whenever a resiliency configuration in the cluster is created, modified or deleted.
+func (a *apiServer) ResiliencyUpdate(in *operatorv1pb.ResiliencyUpdateRequest, srv operatorv1pb.Operator_ResiliencyUpdateServer) error { //nolint:nosnakecase
+	return a.resiliencyUpdates.serve(srv.Context(), in.Namespace, in.PodName, func(b []byte, deleted bool) error {
+		return srv.Send(&operatorv1pb.ResiliencyUpdateEvent{
+			Resiliency: b,
+			Deleted:    deleted,
+		})
+	})
+}
@@ -66,6 +66,25 @@ func (m *mockHTTPEndpointUpdateServer) Context() context.Context {
 	return context.TODO()
 }
 
+// mockUpdateServer is a sidecar connected for the update events E.
+type mockUpdateServer[E interface{ GetDeleted() bool }] struct {
+	grpc.ServerStream
+	Calls   atomic.Int64
+	Deleted atomic.Int64
+}
+
+func (m *mockUpdateServer[E]) Send(e E) error {
+	m.Calls.Add(1)
+	if e.GetDeleted() {
+		m.Deleted.Add(1)
+	}
+	return nil
+}
+
+func (m *mockUpdateServer[E]) Context() context.Context {
+	return context.TODO()
+}
+
 func TestProcessComponentSecrets(t *testing.T) {
 	t.Run("secret ref exists, not kubernetes secret store, no error", func(t *testing.T) {
 		c := componentsapi.Component{
@@ -343,6 +362,115 @@ func TestHTTPEndpointUpdate(t *testing.T) {
 	})
 }
 
+func TestSubscriptionUpdate(t *testing.T) {
+	sub := &subscriptionsapiV2alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sub1",
+			Namespace: "ns1",
+		},
+	}
+	testResourceUpdates(t, sub, func(api *apiServer) *updateStreams[*subscriptionsapiV2alpha1.Subscription] {
+		return api.subscriptionUpdates
+	}, func(api *apiServer, namespace string, sidecar *mockUpdateServer[*operatorv1pb.SubscriptionUpdateEvent]) error {
+		return api.SubscriptionUpdate(&operatorv1pb.SubscriptionUpdateRequest{Namespace: namespace}, sidecar)
+	})
+}
+
+func TestResiliencyUpdate(t *testing.T) {
+	res := &resiliencyapi.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "res1",
+			Namespace: "ns1",
+		},
+	}
+	testResourceUpdates(t, res, func(api *apiServer) *updateStreams[*resiliencyapi.Resiliency] {
+		return api.resiliencyUpdates
+	}, func(api *apiServer, namespace string, sidecar *mockUpdateServer[*operatorv1pb.ResiliencyUpdateEvent]) error {
+		return api.ResiliencyUpdate(&operatorv1pb.ResiliencyUpdateRequest{Namespace: namespace}, sidecar)
+	})
+}
+
+// testResourceUpdates tests that the updates of resource, which is in the namespace "ns1", are sent to the sidecars
+// connected with serve to the streams returned by getStreams.
+func testResourceUpdates[T namespacedResource, E interface{ GetDeleted() bool }](
+	t *testing.T,
+	resource T,
+	getStreams func(api *apiServer) *updateStreams[T],
+	serve func(api *apiServer, namespace string, sidecar *mockUpdateServer[E]) error,
+) {
+	s := runtime.NewScheme()
+	err := scheme.AddToScheme(s)
+	assert.NoError(t, err)
+
+	client := fake.NewClientBuilder().
+		WithScheme(s).Build()
+
+	api := NewAPIServer(client).(*apiServer)
+	streams := getStreams(api)
+	sendUpdates := func(updates ...resourceUpdate[T]) {
+		assert.Eventually(t, func() bool {
+			streams.lock.Lock()
+			defer streams.lock.Unlock()
+			return len(streams.chans) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		streams.lock.Lock()
+		defer streams.lock.Unlock()
+		for key := range streams.chans {
+			for _, u := range updates {
+				streams.chans[key] <- u
+			}
+			close(streams.chans[key])
+		}
+	}
+
+	t.Run("skip sidecar update if namespace doesn't match", func(t *testing.T) {
+		mockSidecar := &mockUpdateServer[E]{}
+		go sendUpdates(resourceUpdate[T]{resource: resource})
+
+		// Start sidecar update loop
+		assert.NoError(t, serve(api, "ns2", mockSidecar))
+
+		assert.Equal(t, int64(0), mockSidecar.Calls.Load())
+	})
+
+	t.Run("sidecar is updated when namespace is a match", func(t *testing.T) {
+		mockSidecar := &mockUpdateServer[E]{}
+		go sendUpdates(resourceUpdate[T]{resource: resource}, resourceUpdate[T]{resource: resource, deleted: true})
+
+		// Start sidecar update loop
+		assert.NoError(t, serve(api, "ns1", mockSidecar))
+
+		assert.Equal(t, int64(2), mockSidecar.Calls.Load())
+		assert.Equal(t, int64(1), mockSidecar.Deleted.Load())
+	})
+
+	t.Run("updates are broadcast to the connected sidecars", func(t *testing.T) {
+		mockSidecar := &mockUpdateServer[E]{}
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- serve(api, "ns1", mockSidecar)
+		}()
+		assert.Eventually(t, func() bool {
+			streams.lock.Lock()
+			defer streams.lock.Unlock()
+			return len(streams.chans) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		streams.broadcast(resource, true)
+		assert.Eventually(t, func() bool {
+			return mockSidecar.Deleted.Load() == 1
+		}, time.Second, 10*time.Millisecond)
+
+		streams.lock.Lock()
+		for key := range streams.chans {
+			close(streams.chans[key])
+		}
+		streams.lock.Unlock()
+		assert.NoError(t, <-errCh)
+	})
+}
+
 func TestListsNamespaced(t *testing.T) {
 	t.Run("list components namespace scoping", func(t *testing.T) {
 		s := runtime.NewScheme()
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// namespacedResource is a resource which is sent to the sidecars of its namespace when it changes.
+type namespacedResource interface {
+	GetName() string
+	GetNamespace() string
+}
+
+// resourceUpdate is a resource which was created, updated or deleted.
+type resourceUpdate[T namespacedResource] struct {
+	resource T
+	deleted  bool
+}
+
+// updateStreams sends the updates of a kind of resource to the sidecars connected for them.
+type updateStreams[T namespacedResource] struct {
+	kind  string
+	lock  sync.Mutex
+	chans map[string]chan resourceUpdate[T]
+}
+
+func newUpdateStreams[T namespacedResource](kind string) *updateStreams[T] {
+	return &updateStreams[T]{
+		kind:  kind,
+		chans: make(map[string]chan resourceUpdate[T]),
+	}
+}
+
+// broadcast sends the update of a resource to all the connected sidecars.
+func (s *updateStreams[T]) broadcast(resource T, deleted bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, updateChan := range s.chans {
+		go func(updateChan chan resourceUpdate[T]) {
+			updateChan <- resourceUpdate[T]{resource: resource, deleted: deleted}
+		}(updateChan)
+	}
+}
+
+// serve calls send with the serialized updates of the resources in the namespace until ctx is done.
+func (s *updateStreams[T]) serve(ctx context.Context, namespace, podName string, send func(b []byte, deleted bool) error) error {
+	log.Infof("sidecar connected for %s updates", s.kind)
+	keyObj, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	key := keyObj.String()
+
+	s.lock.Lock()
+	updateChan := make(chan resourceUpdate[T], 1)
+	s.chans[key] = updateChan
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.chans, key)
+	}()
+
+	sendUpdate := func(u resourceUpdate[T]) {
+		r := u.resource
+		if r.GetNamespace() != namespace {
+			return
+		}
+
+		b, err := json.Marshal(r)
+		if err != nil {
+			log.Warnf("error serializing %s %s from pod %s/%s: %s", s.kind, r.GetName(), namespace, podName, err)
+			return
+		}
+
+		if err = send(b, u.deleted); err != nil {
+			log.Warnf("error updating sidecar with %s %s from pod %s/%s: %s", s.kind, r.GetName(), namespace, podName, err)
+			return
+		}
+
+		log.Infof("updated sidecar with %s %s from pod %s/%s", s.kind, r.GetName(), namespace, podName)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case u, ok := <-updateChan:
+			if !ok {
+				return nil
+			}
+			sendUpdate(u)
+		}
+	}
+}
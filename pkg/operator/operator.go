@@ -187,6 +187,32 @@ func (o *operator) syncHTTPEndpoint(ctx context.Context) func(obj interface{}) {
 	}
 }
 
+func (o *operator) syncSubscription(ctx context.Context, deleted bool) func(obj interface{}) {
+	return func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		s, ok := obj.(*subscriptionsapiV2alpha1.Subscription)
+		if ok {
+			log.Debugf("Observed subscription to be synced: %s/%s", s.Namespace, s.Name)
+			o.apiServer.OnSubscriptionUpdated(ctx, s, deleted)
+		}
+	}
+}
+
+func (o *operator) syncResiliency(ctx context.Context, deleted bool) func(obj interface{}) {
+	return func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		r, ok := obj.(*resiliencyapi.Resiliency)
+		if ok {
+			log.Debugf("Observed resiliency to be synced: %s/%s", r.Namespace, r.Name)
+			o.apiServer.OnResiliencyUpdated(ctx, r, deleted)
+		}
+	}
+}
+
 func (o *operator) loadCertChain(ctx context.Context) (*credentials.CertChain, error) {
 	log.Info("Getting TLS certificates")
 
@@ -361,6 +387,60 @@ func (o *operator) Run(ctx context.Context) error {
 		return err
 	}
 
+	err = o.mgr.Add(nonLeaderRunnable{func(ctx context.Context) error {
+		if !o.mgr.GetCache().WaitForCacheSync(ctx) {
+			return errors.New("failed to wait for cache sync")
+		}
+
+		subscriptionInformer, rErr := o.mgr.GetCache().GetInformer(ctx, &subscriptionsapiV2alpha1.Subscription{})
+		if rErr != nil {
+			return fmt.Errorf("unable to get subscription informer: %w", rErr)
+		}
+
+		_, rErr = subscriptionInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: o.syncSubscription(ctx, false),
+			UpdateFunc: func(_, newObj interface{}) {
+				o.syncSubscription(ctx, false)(newObj)
+			},
+			DeleteFunc: o.syncSubscription(ctx, true),
+		})
+		if rErr != nil {
+			return fmt.Errorf("unable to add subscription informer event handler: %w", rErr)
+		}
+		<-ctx.Done()
+		return nil
+	}})
+	if err != nil {
+		return err
+	}
+
+	err = o.mgr.Add(nonLeaderRunnable{func(ctx context.Context) error {
+		if !o.mgr.GetCache().WaitForCacheSync(ctx) {
+			return errors.New("failed to wait for cache sync")
+		}
+
+		resiliencyInformer, rErr := o.mgr.GetCache().GetInformer(ctx, &resiliencyapi.Resiliency{})
+		if rErr != nil {
+			return fmt.Errorf("unable to get resiliency informer: %w", rErr)
+		}
+
+		_, rErr = resiliencyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: o.syncResiliency(ctx, false),
+			UpdateFunc: func(_, newObj interface{}) {
+				o.syncResiliency(ctx, false)(newObj)
+			},
+			DeleteFunc: o.syncResiliency(ctx, true),
+		})
+		if rErr != nil {
+			return fmt.Errorf("unable to add resiliency informer event handler: %w", rErr)
+		}
+		<-ctx.Done()
+		return nil
+	}})
+	if err != nil {
+		return err
+	}
+
 	err = o.mgr.Start(ctx)
 	if err != nil {
 		return fmt.Errorf("error running operator: %w", err)
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupHandler(t *testing.T) {
+	testServer, err := NewPlacementService(testRaftServer, nil)
+	require.NoError(t, err)
+
+	t.Run("backup", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		testServer.BackupHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/placement/backup", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+		assert.NotEmpty(t, rec.Body.Bytes())
+	})
+
+	t.Run("backup with wrong method", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		testServer.BackupHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/placement/backup", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
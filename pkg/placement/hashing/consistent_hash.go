@@ -27,12 +27,24 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 
 	blake2b "github.com/minio/blake2b-simd"
 )
 
+const (
+	// defaultLoadFactor is the load factor used by GetLeast when the table was
+	// created without a load factor.
+	defaultLoadFactor = 1.25
+
+	// boundedLoadPartitions is the number of partitions keys are mapped to in
+	// tables with bounded loads. Partitions, rather than keys, are assigned to
+	// hosts, so the assignment only depends on the hosts in the table.
+	boundedLoadPartitions = 2048
+)
+
 var replicationFactor int
 
 // ErrNoHosts is an error for no hosts.
@@ -44,12 +56,59 @@ type ConsistentHashTables struct {
 	Entries map[string]*Consistent
 }
 
+// ZonePolicy is the topology-aware placement policy of a consistent hashing table.
+type ZonePolicy string
+
+const (
+	// ZonePolicyNone ignores the topology of the hosts.
+	ZonePolicyNone ZonePolicy = ""
+	// ZonePolicySpread distributes keys evenly across zones, regardless of the number
+	// of hosts in each zone, before distributing them across the hosts of the zone.
+	ZonePolicySpread ZonePolicy = "spread"
+)
+
+// IsValid returns true if the zone policy is known.
+func (p ZonePolicy) IsValid() bool {
+	switch p {
+	case ZonePolicyNone, ZonePolicySpread:
+		return true
+	default:
+		return false
+	}
+}
+
+// Topology is the failure domain of a host.
+type Topology struct {
+	Region string
+	Zone   string
+	Node   string
+}
+
 // Host represents a host of stateful entities with a given name, id, port and load.
 type Host struct {
-	Name  string
-	Port  int64
-	Load  int64
-	AppID string
+	Name     string
+	Port     int64
+	Load     int64
+	AppID    string
+	Topology Topology
+}
+
+// Options contains the placement settings of a single consistent hashing table.
+type Options struct {
+	// ReplicationFactor is the number of virtual nodes per host.
+	// The global replication factor is used when it is zero.
+	ReplicationFactor int
+	// LoadFactor enables Consistent Hashing With Bounded Loads when greater than 1.
+	// Keys are mapped to a fixed number of partitions, and no host owns more than
+	// LoadFactor times the average number of partitions per host.
+	LoadFactor float64
+	// ZonePolicy is the topology-aware placement policy.
+	ZonePolicy ZonePolicy
+}
+
+// BoundedLoad returns true if the options enable Consistent Hashing With Bounded Loads.
+func (o Options) BoundedLoad() bool {
+	return o.LoadFactor > 1
 }
 
 // Consistent represents a data structure for consistent hashing.
@@ -59,6 +118,14 @@ type Consistent struct {
 	loadMap   map[string]*Host
 	totalLoad int64
 
+	replicationFactor int
+	loadFactor        float64
+	zonePolicy        ZonePolicy
+
+	// partitions is the owner of each partition in tables with bounded loads.
+	// It is computed from the hosts in the table whenever they change.
+	partitions []string
+
 	sync.RWMutex
 }
 
@@ -82,20 +149,50 @@ func NewHost(name, id string, load int64, port int64) *Host {
 
 // NewConsistentHash returns a new consistent hash.
 func NewConsistentHash() *Consistent {
+	return NewConsistentHashWithOptions(Options{})
+}
+
+// NewConsistentHashWithOptions returns a new consistent hash with the given placement settings.
+func NewConsistentHashWithOptions(opts Options) *Consistent {
 	return &Consistent{
-		hosts:     map[uint64]string{},
-		sortedSet: []uint64{},
-		loadMap:   map[string]*Host{},
+		hosts:             map[uint64]string{},
+		sortedSet:         []uint64{},
+		loadMap:           map[string]*Host{},
+		replicationFactor: opts.ReplicationFactor,
+		loadFactor:        opts.LoadFactor,
+		zonePolicy:        opts.ZonePolicy,
 	}
 }
 
 // NewFromExisting creates a new consistent hash from existing values.
-func NewFromExisting(hosts map[uint64]string, sortedSet []uint64, loadMap map[string]*Host) *Consistent {
-	return &Consistent{
-		hosts:     hosts,
-		sortedSet: sortedSet,
-		loadMap:   loadMap,
+func NewFromExisting(hosts map[uint64]string, sortedSet []uint64, loadMap map[string]*Host, opts Options) *Consistent {
+	var totalLoad int64
+	for _, h := range loadMap {
+		totalLoad += h.Load
 	}
+
+	c := &Consistent{
+		hosts:             hosts,
+		sortedSet:         sortedSet,
+		loadMap:           loadMap,
+		totalLoad:         totalLoad,
+		replicationFactor: opts.ReplicationFactor,
+		loadFactor:        opts.LoadFactor,
+		zonePolicy:        opts.ZonePolicy,
+	}
+	c.assignPartitions()
+	return c
+}
+
+// LoadFactor returns the load factor of the consistent hash.
+// Zero means bounded loads are disabled.
+func (c *Consistent) LoadFactor() float64 {
+	return c.loadFactor
+}
+
+// ZonePolicy returns the topology-aware placement policy of the consistent hash.
+func (c *Consistent) ZonePolicy() ZonePolicy {
+	return c.zonePolicy
 }
 
 // ReadInternals returns the internal data structure of the consistent hash.
@@ -116,7 +213,7 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 	}
 
 	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port}
-	for i := 0; i < replicationFactor; i++ {
+	for i := 0; i < c.vnodes(); i++ {
 		h := c.hash(fmt.Sprintf("%s%d", host, i))
 		c.hosts[h] = host
 		c.sortedSet = append(c.sortedSet, h)
@@ -125,6 +222,7 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 	sort.Slice(c.sortedSet, func(i int, j int) bool {
 		return c.sortedSet[i] < c.sortedSet[j]
 	})
+	c.assignPartitions()
 
 	return false
 }
@@ -148,13 +246,156 @@ func (c *Consistent) Get(key string) (string, error) {
 }
 
 // GetHost gets a host.
+//
+// If the consistent hash was created with bounded loads enabled, the host is
+// the owner of the partition of the key. The owner of a key only depends on the
+// hosts in the table, so every caller resolves a key to the same host.
 func (c *Consistent) GetHost(key string) (*Host, error) {
-	h, err := c.Get(key)
-	if err != nil {
-		return nil, err
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(c.hosts) == 0 {
+		return nil, ErrNoHosts
+	}
+
+	if c.partitions != nil {
+		return c.loadMap[c.partitions[c.partition(key)]], nil
+	}
+
+	idx := c.search(c.hash(key))
+	if c.zonePolicy == ZonePolicySpread {
+		if zone := c.spreadZone(key); zone != "" {
+			host, ok := c.walk(idx, func(host string) bool {
+				return c.loadMap[host].Topology.Zone == zone
+			})
+			if ok {
+				return c.loadMap[host], nil
+			}
+		}
+	}
+
+	return c.loadMap[c.hosts[c.sortedSet[idx]]], nil
+}
+
+// partition returns the partition of key in tables with bounded loads.
+func (c *Consistent) partition(key string) int {
+	return int(c.hash(key) % boundedLoadPartitions)
+}
+
+// assignPartitions computes the owner of each partition in tables with bounded loads,
+// using Consistent Hashing With Bounded Loads: every partition is owned by the first
+// host after it in the ring which doesn't own more than its share of partitions yet.
+// Because the assignment only depends on the hosts in the table, every caller resolves
+// a key to the same host, and it doesn't change with the load of the hosts.
+// caller should holds lock.
+func (c *Consistent) assignPartitions() {
+	if c.loadFactor <= 1 || len(c.sortedSet) == 0 {
+		c.partitions = nil
+		return
+	}
+
+	capacity := func(partitions, hosts int) int {
+		return int(math.Ceil(float64(partitions) / float64(hosts) * c.loadFactor))
+	}
+
+	// With the spread zone policy, each partition is owned by a host in its zone,
+	// and hosts share the partitions of their zone.
+	zones := make([]string, boundedLoadPartitions)
+	zonePartitions := map[string]int{}
+	zoneHosts := map[string]int{}
+	if c.zonePolicy == ZonePolicySpread {
+		for _, h := range c.loadMap {
+			if h.Topology.Zone != "" {
+				zoneHosts[h.Topology.Zone]++
+			}
+		}
+		for p := range zones {
+			zones[p] = c.spreadZone(partitionKey(p))
+			zonePartitions[zones[p]]++
+		}
+	}
+
+	globalCapacity := capacity(boundedLoadPartitions, len(c.loadMap))
+	assigned := make(map[string]int, len(c.loadMap))
+	c.partitions = make([]string, boundedLoadPartitions)
+	for p := range c.partitions {
+		idx := c.search(c.hash(partitionKey(p)))
+
+		host, ok := "", false
+		if zone := zones[p]; zone != "" {
+			zoneCapacity := capacity(zonePartitions[zone], zoneHosts[zone])
+			host, ok = c.walk(idx, func(host string) bool {
+				return c.loadMap[host].Topology.Zone == zone && assigned[host] < zoneCapacity
+			})
+		}
+		if !ok {
+			host, ok = c.walk(idx, func(host string) bool {
+				return assigned[host] < globalCapacity
+			})
+		}
+		if !ok {
+			host = c.hosts[c.sortedSet[idx]]
+		}
+
+		c.partitions[p] = host
+		assigned[host]++
+	}
+}
+
+func partitionKey(partition int) string {
+	return "partition" + strconv.Itoa(partition)
+}
+
+// walk returns the first host in the ring starting at idx which is accepted.
+// Every virtual node is visited at most once.
+// caller should holds lock.
+func (c *Consistent) walk(idx int, accept func(host string) bool) (string, bool) {
+	i := idx
+	for n := 0; n < len(c.sortedSet); n++ {
+		host := c.hosts[c.sortedSet[i]]
+		if accept(host) {
+			return host, true
+		}
+		i++
+		if i >= len(c.sortedSet) {
+			i = 0
+		}
+	}
+	return "", false
+}
+
+// spreadZone picks the zone of the key using rendezvous hashing, so each zone
+// owns the same share of keys and only the keys of a removed zone move.
+// caller should holds lock.
+func (c *Consistent) spreadZone(key string) string {
+	var (
+		zone      string
+		maxWeight uint64
+	)
+	for _, h := range c.loadMap {
+		z := h.Topology.Zone
+		if z == "" || z == zone {
+			continue
+		}
+		if w := c.hash(z + "/" + key); zone == "" || w > maxWeight || (w == maxWeight && z < zone) {
+			zone, maxWeight = z, w
+		}
 	}
+	return zone
+}
+
+// UpdateTopology sets the topology of `host` to the given `topology`.
+// The partitions are only assigned again if the topology changed.
+func (c *Consistent) UpdateTopology(host string, topology Topology) {
+	c.Lock()
+	defer c.Unlock()
 
-	return c.loadMap[h], nil
+	h, ok := c.loadMap[host]
+	if !ok || h.Topology == topology {
+		return
+	}
+	h.Topology = topology
+	c.assignPartitions()
 }
 
 // GetLeast uses Consistent Hashing With Bounded loads
@@ -175,17 +416,13 @@ func (c *Consistent) GetLeast(key string) (string, error) {
 	h := c.hash(key)
 	idx := c.search(h)
 
-	i := idx
-	for {
-		host := c.hosts[c.sortedSet[i]]
-		if c.loadOK(host) {
-			return host, nil
-		}
-		i++
-		if i >= len(c.hosts) {
-			i = 0
-		}
+	// If all hosts are above capacity (which can only happen with stale loads),
+	// the owner of the key in the ring is returned.
+	if host, ok := c.walk(idx, c.loadOK); ok {
+		return host, nil
 	}
+
+	return c.hosts[c.sortedSet[idx]], nil
 }
 
 func (c *Consistent) search(key uint64) int {
@@ -242,12 +479,16 @@ func (c *Consistent) Remove(host string) bool {
 	c.Lock()
 	defer c.Unlock()
 
-	for i := 0; i < replicationFactor; i++ {
+	for i := 0; i < c.vnodes(); i++ {
 		h := c.hash(fmt.Sprintf("%s%d", host, i))
 		delete(c.hosts, h)
 		c.delSlice(h)
 	}
+	if h, ok := c.loadMap[host]; ok {
+		c.totalLoad -= h.Load
+	}
 	delete(c.loadMap, host)
+	c.assignPartitions()
 	return true
 }
 
@@ -273,39 +514,51 @@ func (c *Consistent) GetLoads() map[string]int64 {
 
 // MaxLoad returns the maximum load of the single host
 // which is:
-// (total_load/number_of_hosts)*1.25
+// (total_load/number_of_hosts)*load_factor
 // total_load = is the total number of active requests served by hosts
 // for more info:
 // https://research.googleblog.com/2017/04/consistent-hashing-with-bounded-loads.html
 func (c *Consistent) MaxLoad() int64 {
-	if c.totalLoad == 0 {
-		c.totalLoad = 1
+	c.RLock()
+	defer c.RUnlock()
+
+	totalLoad := c.totalLoad
+	if totalLoad == 0 {
+		totalLoad = 1
 	}
 	var avgLoadPerNode float64
-	avgLoadPerNode = float64(c.totalLoad / int64(len(c.loadMap)))
+	avgLoadPerNode = float64(totalLoad / int64(len(c.loadMap)))
 	if avgLoadPerNode == 0 {
 		avgLoadPerNode = 1
 	}
-	avgLoadPerNode = math.Ceil(avgLoadPerNode * 1.25)
+	avgLoadPerNode = math.Ceil(avgLoadPerNode * c.effectiveLoadFactor())
 	return int64(avgLoadPerNode)
 }
 
+func (c *Consistent) effectiveLoadFactor() float64 {
+	if c.loadFactor > 1 {
+		return c.loadFactor
+	}
+	return defaultLoadFactor
+}
+
 func (c *Consistent) loadOK(host string) bool {
 	// a safety check if someone performed c.Done more than needed
-	if c.totalLoad < 0 {
-		c.totalLoad = 0
+	totalLoad := c.totalLoad
+	if totalLoad < 0 {
+		totalLoad = 0
 	}
 
 	var avgLoadPerNode float64
-	avgLoadPerNode = float64((c.totalLoad + 1) / int64(len(c.loadMap)))
+	avgLoadPerNode = float64((totalLoad + 1) / int64(len(c.loadMap)))
 	if avgLoadPerNode == 0 {
 		avgLoadPerNode = 1
 	}
-	avgLoadPerNode = math.Ceil(avgLoadPerNode * 1.25)
+	avgLoadPerNode = math.Ceil(avgLoadPerNode * c.effectiveLoadFactor())
 
 	bhost, ok := c.loadMap[host]
 	if !ok {
-		panic(fmt.Sprintf("given host(%s) not in loadsMap", bhost.Name))
+		panic(fmt.Sprintf("given host(%s) not in loadsMap", host))
 	}
 
 	if float64(bhost.Load)+1 <= avgLoadPerNode {
@@ -335,6 +588,13 @@ func (c *Consistent) delSlice(val uint64) {
 	}
 }
 
+func (c *Consistent) vnodes() int {
+	if c.replicationFactor > 0 {
+		return c.replicationFactor
+	}
+	return replicationFactor
+}
+
 func (c *Consistent) hash(key string) uint64 {
 	out := blake2b.Sum512([]byte(key))
 	return binary.LittleEndian.Uint64(out[:])
@@ -15,9 +15,11 @@ package hashing
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var nodes = []string{"node1", "node2", "node3", "node4", "node5"}
@@ -71,3 +73,205 @@ func TestSetReplicationFactor(t *testing.T) {
 
 	assert.Equal(t, f, replicationFactor)
 }
+
+func TestReplicationFactorPerTable(t *testing.T) {
+	SetReplicationFactor(100)
+
+	h := NewConsistentHashWithOptions(Options{ReplicationFactor: 10})
+	for _, n := range nodes {
+		h.Add(n, n, 1)
+	}
+	assert.Len(t, h.sortedSet, 10*len(nodes))
+
+	h.Remove("node1")
+	assert.Len(t, h.sortedSet, 10*(len(nodes)-1))
+
+	d := NewConsistentHash()
+	d.Add("node1", "node1", 1)
+	assert.Len(t, d.sortedSet, 100)
+}
+
+func TestBoundedLoad(t *testing.T) {
+	SetReplicationFactor(100)
+
+	keys := []string{}
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprint(i))
+	}
+
+	t.Run("disabled bounded load uses the ring owner", func(t *testing.T) {
+		h := NewConsistentHash()
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+		assert.Nil(t, h.partitions)
+
+		for _, k := range keys {
+			owner, err := h.Get(k)
+			require.NoError(t, err)
+			host, err := h.GetHost(k)
+			require.NoError(t, err)
+			assert.Equal(t, owner, host.Name)
+		}
+	})
+
+	t.Run("partitions are bounded per host", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{LoadFactor: 1.25})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+
+		require.Len(t, h.partitions, boundedLoadPartitions)
+		owned := map[string]int{}
+		for _, host := range h.partitions {
+			owned[host]++
+		}
+		assert.Len(t, owned, len(nodes))
+		for _, n := range owned {
+			assert.LessOrEqual(t, n, int(math.Ceil(float64(boundedLoadPartitions)/float64(len(nodes))*1.25)))
+		}
+	})
+
+	t.Run("reported loads don't change the owners", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{LoadFactor: 1.25})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+		before := map[string]string{}
+		for _, k := range keys {
+			host, err := h.GetHost(k)
+			require.NoError(t, err)
+			before[k] = host.Name
+		}
+
+		h.UpdateLoad("node1", 1000)
+		for _, k := range keys {
+			host, err := h.GetHost(k)
+			require.NoError(t, err)
+			assert.Equal(t, before[k], host.Name)
+		}
+	})
+
+	t.Run("existing table resolves the same owners", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{LoadFactor: 1.5})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+
+		var existing *Consistent
+		h.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, loadMap map[string]*Host, _ int64) {
+			loads := map[string]*Host{}
+			for k, v := range loadMap {
+				loads[k] = NewHost(v.Name, v.AppID, 0, v.Port)
+			}
+			existing = NewFromExisting(hosts, sortedSet, loads, Options{LoadFactor: 1.5})
+		})
+
+		assert.Equal(t, 1.5, existing.LoadFactor())
+		for _, k := range keys {
+			expected, err := h.GetHost(k)
+			require.NoError(t, err)
+			host, err := existing.GetHost(k)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Name, host.Name)
+		}
+	})
+
+	t.Run("removing a host only moves a fraction of the partitions", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{LoadFactor: 1.25})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+		before := append([]string{}, h.partitions...)
+
+		h.Remove("node1")
+		moved := 0
+		for p, host := range h.partitions {
+			assert.NotEqual(t, "node1", host)
+			if before[p] != "node1" && before[p] != host {
+				moved++
+			}
+		}
+		assert.Less(t, moved, boundedLoadPartitions/2)
+	})
+}
+
+func TestZonePolicy(t *testing.T) {
+	SetReplicationFactor(100)
+
+	keys := []string{}
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprint(i))
+	}
+
+	// zone-a has four hosts, zone-b has one host.
+	zones := map[string]string{
+		"node1": "zone-a",
+		"node2": "zone-a",
+		"node3": "zone-a",
+		"node4": "zone-a",
+		"node5": "zone-b",
+	}
+	newHash := func(policy ZonePolicy) *Consistent {
+		h := NewConsistentHashWithOptions(Options{ZonePolicy: policy})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+			h.UpdateTopology(n, Topology{Region: "region", Zone: zones[n], Node: n})
+		}
+		return h
+	}
+
+	t.Run("no zone policy ignores the topology", func(t *testing.T) {
+		h := newHash(ZonePolicyNone)
+		for _, k := range keys {
+			owner, err := h.Get(k)
+			assert.NoError(t, err)
+			host, err := h.GetHost(k)
+			assert.NoError(t, err)
+			assert.Equal(t, owner, host.Name)
+		}
+	})
+
+	t.Run("spread across zones", func(t *testing.T) {
+		h := newHash(ZonePolicySpread)
+		perZone := map[string]int{}
+		for _, k := range keys {
+			host, err := h.GetHost(k)
+			assert.NoError(t, err)
+			perZone[host.Topology.Zone]++
+		}
+
+		// Without spreading, zone-b would own about a fifth of the keys.
+		assert.InDelta(t, len(keys)/2, perZone["zone-b"], float64(len(keys))/10)
+	})
+
+	t.Run("spread without topology", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{ZonePolicy: ZonePolicySpread})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+		}
+		for _, k := range keys[:100] {
+			owner, err := h.Get(k)
+			assert.NoError(t, err)
+			host, err := h.GetHost(k)
+			assert.NoError(t, err)
+			assert.Equal(t, owner, host.Name)
+		}
+	})
+
+	t.Run("partitions are only assigned again when the topology changes", func(t *testing.T) {
+		h := NewConsistentHashWithOptions(Options{LoadFactor: 1.25, ZonePolicy: ZonePolicySpread})
+		for _, n := range nodes {
+			h.Add(n, n, 1)
+			h.UpdateTopology(n, Topology{Region: "region", Zone: zones[n], Node: n})
+		}
+		partitions := h.partitions
+		require.NotEmpty(t, partitions)
+
+		h.UpdateTopology("node1", Topology{Region: "region", Zone: "zone-a", Node: "node1"})
+		assert.Same(t, &partitions[0], &h.partitions[0])
+
+		h.UpdateTopology("node1", Topology{Region: "region", Zone: "zone-b", Node: "node1"})
+		assert.NotSame(t, &partitions[0], &h.partitions[0])
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/utils/clock"
 
 	daprCredentials "github.com/dapr/dapr/pkg/credentials"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -226,12 +227,14 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 			p.lastHeartBeat.Store(req.Name, p.clock.Now().UnixNano())
 
 			members := p.raftNode.FSM().State().Members()
+			topology := hostTopology(req)
 
 			// Upsert incoming member only if it is an actor service (not actor client) and
 			// the existing member info is unmatched with the incoming member info.
 			upsertRequired := true
 			if m, ok := members[req.Name]; ok {
-				if m.AppID == req.Id && m.Name == req.Name && cmp.Equal(m.Entities, req.Entities) {
+				if m.AppID == req.Id && m.Name == req.Name && cmp.Equal(m.Entities, req.Entities) &&
+					m.Topology == topology {
 					upsertRequired = false
 				}
 			}
@@ -243,6 +246,7 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 						Name:      req.Name,
 						AppID:     req.Id,
 						Entities:  req.Entities,
+						Topology:  topology,
 						UpdatedAt: p.clock.Now().UnixNano(),
 					},
 				}
@@ -276,6 +280,16 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 	return status.Error(codes.FailedPrecondition, "only leader can serve the request")
 }
 
+// hostTopology returns the failure domain reported by the host.
+func hostTopology(req *placementv1pb.Host) hashing.Topology {
+	t := req.GetTopology()
+	return hashing.Topology{
+		Region: t.GetRegion(),
+		Zone:   t.GetZone(),
+		Node:   t.GetNode(),
+	}
+}
+
 // addStreamConn adds stream connection between runtime and placement to the dissemination pool.
 func (p *Service) addStreamConn(conn placementGRPCStream) {
 	p.streamConnPoolLock.Lock()
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb"
+)
+
+const (
+	// backupFormatVersion is the version of the backup format.
+	// Bump this version if there are breaking changes to the schema of DaprHostMemberStateData.
+	backupFormatVersion uint16 = 1
+)
+
+// backupMagic is the magic number at the beginning of every placement backup.
+var backupMagic = []byte("DAPRPLCB")
+
+// ErrInvalidBackup is returned when the backup header is missing or unsupported.
+var ErrInvalidBackup = errors.New("invalid placement backup")
+
+// writeBackup writes the header followed by the state in the snapshot format to w.
+func (s *DaprHostMemberState) writeBackup(w io.Writer) error {
+	header := make([]byte, len(backupMagic)+2)
+	copy(header, backupMagic)
+	binary.BigEndian.PutUint16(header[len(backupMagic):], backupFormatVersion)
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	return s.persist(w)
+}
+
+// readBackup validates the backup header and the state read from r
+// and returns the state in the snapshot format.
+func readBackup(r io.Reader) ([]byte, error) {
+	header := make([]byte, len(backupMagic)+2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("%w: failed to read header: %v", ErrInvalidBackup, err)
+	}
+
+	if !bytes.Equal(header[:len(backupMagic)], backupMagic) {
+		return nil, fmt.Errorf("%w: unknown header", ErrInvalidBackup)
+	}
+
+	if version := binary.BigEndian.Uint16(header[len(backupMagic):]); version != backupFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrInvalidBackup, version)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the state once to make sure the backup is not corrupted before
+	// handing it to raft.
+	if err := newDaprHostMemberState(nil).restore(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode state: %v", ErrInvalidBackup, err)
+	}
+
+	return data, nil
+}
+
+// Backup writes a backup of the current placement state to w.
+func (s *Server) Backup(w io.Writer) error {
+	if s.fsm == nil {
+		return errors.New("raft server is not started")
+	}
+
+	return s.fsm.State().clone().writeBackup(w)
+}
+
+// RestoreOptions contains the options for RestoreBackup.
+type RestoreOptions struct {
+	// ID is the raft ID of the placement node.
+	ID string
+	// Peers is the raft configuration of the cluster the backup is restored into.
+	Peers []PeerInfo
+	// LogStorePath is the path of the raft log store of the placement node.
+	LogStorePath string
+}
+
+// RestoreBackup restores the backup read from r into the raft log store of a
+// placement node which is not running. The backup is written as a snapshot
+// newer than any existing state, with the given peers as raft configuration,
+// and the existing raft logs are discarded. An empty log store is seeded with
+// the backup, so the same backup can be restored on every node of a new cluster.
+func RestoreBackup(opts RestoreOptions, r io.Reader) error {
+	addr := raftAddressForID(opts.ID, opts.Peers)
+	if addr == "" {
+		return fmt.Errorf("raft ID %s is not one of the peers", opts.ID)
+	}
+	if opts.LogStorePath == "" {
+		return errors.New("raft log store path is required")
+	}
+
+	data, err := readBackup(r)
+	if err != nil {
+		return err
+	}
+
+	if err = ensureDir(opts.LogStorePath); err != nil {
+		return fmt.Errorf("failed to create log store directory: %w", err)
+	}
+
+	store, err := raftboltdb.NewBoltStore(filepath.Join(opts.LogStorePath, "raft.db"))
+	if err != nil {
+		return fmt.Errorf("failed to open log store: %w", err)
+	}
+	defer store.Close()
+
+	snaps, err := raft.NewFileSnapshotStoreWithLogger(opts.LogStorePath, snapshotsRetained, newLoggerAdapter())
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot store: %w", err)
+	}
+
+	// The restored snapshot must come after everything in the existing state,
+	// otherwise raft would replay the existing logs on top of it.
+	index, term, err := lastIndexAndTerm(store, snaps)
+	if err != nil {
+		return err
+	}
+	index++
+
+	configuration := raft.Configuration{
+		Servers: make([]raft.Server, len(opts.Peers)),
+	}
+	for i, p := range opts.Peers {
+		configuration.Servers[i] = raft.Server{
+			ID:      raft.ServerID(p.ID),
+			Address: raft.ServerAddress(p.Address),
+		}
+	}
+
+	_, trans := raft.NewInmemTransport(raft.ServerAddress(addr))
+	sink, err := snaps.Create(raft.SnapshotVersionMax, index, term, configuration, index, trans)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+	if _, err = sink.Write(data); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err = sink.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	first, err := store.FirstIndex()
+	if err != nil {
+		return err
+	}
+	last, err := store.LastIndex()
+	if err != nil {
+		return err
+	}
+	if last > 0 {
+		if err = store.DeleteRange(first, last); err != nil {
+			return fmt.Errorf("failed to discard raft logs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// lastIndexAndTerm returns the index and the term of the latest entry in the
+// raft logs or snapshots. The term is at least 1.
+func lastIndexAndTerm(logs raft.LogStore, snaps raft.SnapshotStore) (uint64, uint64, error) {
+	var index, term uint64 = 0, 1
+
+	last, err := logs.LastIndex()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read raft logs: %w", err)
+	}
+	if last > 0 {
+		var entry raft.Log
+		if err = logs.GetLog(last, &entry); err != nil {
+			return 0, 0, fmt.Errorf("failed to read raft logs: %w", err)
+		}
+		index = last
+		if entry.Term > term {
+			term = entry.Term
+		}
+	}
+
+	list, err := snaps.List()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for _, snap := range list {
+		if snap.Index > index {
+			index = snap.Index
+		}
+		if snap.Term > term {
+			term = snap.Term
+		}
+	}
+
+	return index, term, nil
+}
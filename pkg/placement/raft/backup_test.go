@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	// arrange
+	s := newDaprHostMemberState(nil)
+	s.upsertMember(&DaprHostMember{
+		Name:     "127.0.0.1:3030",
+		AppID:    "fakeAppID",
+		Entities: []string{"actorTypeOne", "actorTypeTwo"},
+	})
+
+	t.Run("backup can be restored", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, s.writeBackup(buf))
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), backupMagic))
+
+		data, err := readBackup(buf)
+		require.NoError(t, err)
+
+		restored := newDaprHostMemberState(nil)
+		require.NoError(t, restored.restore(bytes.NewReader(data)))
+		assert.Equal(t, s.TableGeneration(), restored.TableGeneration())
+		assert.EqualValues(t, s.Members(), restored.Members())
+		assert.Len(t, restored.hashingTableMap(), 2)
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, s.persist(buf))
+
+		_, err := readBackup(buf)
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, s.writeBackup(buf))
+		b := buf.Bytes()
+		binary.BigEndian.PutUint16(b[len(backupMagic):], backupFormatVersion+1)
+
+		_, err := readBackup(bytes.NewReader(b))
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+
+	t.Run("corrupted state", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, s.writeBackup(buf))
+		b := buf.Bytes()[:buf.Len()-10]
+
+		_, err := readBackup(bytes.NewReader(b))
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+}
+
+func TestRestoreBackup(t *testing.T) {
+	// arrange
+	s := newDaprHostMemberState(nil)
+	s.upsertMember(&DaprHostMember{
+		Name:     "127.0.0.1:3030",
+		AppID:    "fakeAppID",
+		Entities: []string{"actorTypeOne", "actorTypeTwo"},
+	})
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, s.writeBackup(buf))
+	backup := buf.Bytes()
+
+	opts := RestoreOptions{
+		ID: "node0",
+		Peers: []PeerInfo{
+			{ID: "node0", Address: "127.0.0.1:8201"},
+			{ID: "node1", Address: "127.0.0.1:8202"},
+		},
+		LogStorePath: t.TempDir(),
+	}
+
+	latestSnapshot := func(t *testing.T) (*raft.SnapshotMeta, *DaprHostMemberState) {
+		snaps, err := raft.NewFileSnapshotStore(opts.LogStorePath, snapshotsRetained, io.Discard)
+		require.NoError(t, err)
+		list, err := snaps.List()
+		require.NoError(t, err)
+		require.NotEmpty(t, list)
+
+		meta, rc, err := snaps.Open(list[0].ID)
+		require.NoError(t, err)
+		defer rc.Close()
+		restored := newDaprHostMemberState(nil)
+		require.NoError(t, restored.restore(rc))
+		return meta, restored
+	}
+
+	t.Run("seed an empty log store", func(t *testing.T) {
+		require.NoError(t, RestoreBackup(opts, bytes.NewReader(backup)))
+
+		meta, restored := latestSnapshot(t)
+		assert.Equal(t, uint64(1), meta.Index)
+		assert.Equal(t, uint64(1), meta.Term)
+		assert.Len(t, meta.Configuration.Servers, 2)
+		assert.EqualValues(t, s.Members(), restored.Members())
+	})
+
+	t.Run("restore over existing state", func(t *testing.T) {
+		store, err := raftboltdb.NewBoltStore(filepath.Join(opts.LogStorePath, "raft.db"))
+		require.NoError(t, err)
+		require.NoError(t, store.StoreLogs([]*raft.Log{
+			{Index: 2, Term: 3, Type: raft.LogCommand},
+			{Index: 3, Term: 3, Type: raft.LogCommand},
+		}))
+		require.NoError(t, store.Close())
+
+		require.NoError(t, RestoreBackup(opts, bytes.NewReader(backup)))
+
+		meta, restored := latestSnapshot(t)
+		assert.Equal(t, uint64(4), meta.Index)
+		assert.Equal(t, uint64(3), meta.Term)
+		assert.EqualValues(t, s.Members(), restored.Members())
+
+		store, err = raftboltdb.NewBoltStore(filepath.Join(opts.LogStorePath, "raft.db"))
+		require.NoError(t, err)
+		defer store.Close()
+		last, err := store.LastIndex()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), last)
+	})
+
+	t.Run("invalid backup", func(t *testing.T) {
+		err := RestoreBackup(opts, bytes.NewReader([]byte("invalid")))
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+	})
+
+	t.Run("unknown raft ID", func(t *testing.T) {
+		o := opts
+		o.ID = "node2"
+		assert.Error(t, RestoreBackup(o, bytes.NewReader(backup)))
+	})
+}
@@ -48,11 +48,15 @@ type FSM struct {
 	// Raft side, so doesn't need to lock this.
 	stateLock sync.RWMutex
 	state     *DaprHostMemberState
+
+	// tableOptions is the map of placement settings per actor type.
+	tableOptions map[string]hashing.Options
 }
 
-func newFSM() *FSM {
+func newFSM(tableOptions map[string]hashing.Options) *FSM {
 	return &FSM{
-		state: newDaprHostMemberState(),
+		tableOptions: tableOptions,
+		state:        newDaprHostMemberState(tableOptions),
 	}
 }
 
@@ -82,10 +86,12 @@ func (c *FSM) PlacementState() *v1pb.PlacementTables {
 		var table v1pb.PlacementTable
 		v.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, loadMap map[string]*hashing.Host, totalLoad int64) {
 			table = v1pb.PlacementTable{
-				Hosts:     make(map[uint64]string),
-				SortedSet: make([]uint64, len(sortedSet)),
-				TotalLoad: totalLoad,
-				LoadMap:   make(map[string]*v1pb.Host),
+				Hosts:      make(map[uint64]string),
+				SortedSet:  make([]uint64, len(sortedSet)),
+				TotalLoad:  totalLoad,
+				LoadMap:    make(map[string]*v1pb.Host),
+				LoadFactor: v.LoadFactor(),
+				ZonePolicy: string(v.ZonePolicy()),
 			}
 
 			for lk, lv := range hosts {
@@ -101,6 +107,13 @@ func (c *FSM) PlacementState() *v1pb.PlacementTables {
 					Port: lv.Port,
 					Id:   lv.AppID,
 				}
+				if lv.Topology != (hashing.Topology{}) {
+					h.Topology = &v1pb.HostTopology{
+						Region: lv.Topology.Region,
+						Zone:   lv.Topology.Zone,
+						Node:   lv.Topology.Node,
+					}
+				}
 				table.LoadMap[lk] = &h
 			}
 		})
@@ -190,7 +203,7 @@ func (c *FSM) Snapshot() (raft.FSMSnapshot, error) {
 func (c *FSM) Restore(old io.ReadCloser) error {
 	defer old.Close()
 
-	members := newDaprHostMemberState()
+	members := newDaprHostMemberState(c.tableOptions)
 	if err := members.restore(old); err != nil {
 		return err
 	}
@@ -23,7 +23,7 @@ import (
 )
 
 func TestFSMApply(t *testing.T) {
-	fsm := newFSM()
+	fsm := newFSM(nil)
 
 	t.Run("upsertMember", func(t *testing.T) {
 		cmdLog, err := makeRaftLogCommand(MemberUpsert, DaprHostMember{
@@ -76,9 +76,9 @@ func TestFSMApply(t *testing.T) {
 
 func TestRestore(t *testing.T) {
 	// arrange
-	fsm := newFSM()
+	fsm := newFSM(nil)
 
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 	s.upsertMember(&DaprHostMember{
 		Name:     "127.0.0.1:8080",
 		AppID:    "FakeID",
@@ -98,7 +98,7 @@ func TestRestore(t *testing.T) {
 }
 
 func TestPlacementState(t *testing.T) {
-	fsm := newFSM()
+	fsm := newFSM(nil)
 	m := DaprHostMember{
 		Name:     "127.0.0.1:3030",
 		AppID:    "fakeAppID",
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/raft"
 	raftboltdb "github.com/hashicorp/raft-boltdb"
 	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/placement/hashing"
 )
 
 const (
@@ -70,6 +72,9 @@ type Server struct {
 
 	raftLogStorePath string
 
+	// tableOptions is the map of placement settings per actor type.
+	tableOptions map[string]hashing.Options
+
 	clock clock.Clock
 }
 
@@ -79,6 +84,9 @@ type Options struct {
 	Peers        []PeerInfo
 	LogStorePath string
 	Clock        clock.Clock
+	// TableOptions is the map of placement settings per actor type.
+	// Actor types without an entry use the default settings.
+	TableOptions map[string]hashing.Options
 }
 
 // New creates Raft server node.
@@ -99,6 +107,7 @@ func New(opts Options) *Server {
 		raftBind:         raftBind,
 		peers:            opts.Peers,
 		raftLogStorePath: opts.LogStorePath,
+		tableOptions:     opts.TableOptions,
 		clock:            cl,
 		raftReady:        make(chan struct{}),
 	}
@@ -138,7 +147,7 @@ func (s *Server) StartRaft(ctx context.Context, config *raft.Config) error {
 		}
 	}()
 
-	s.fsm = newFSM()
+	s.fsm = newFSM(s.tableOptions)
 
 	addr, err := s.tryResolveRaftAdvertiseAddr(ctx, s.raftBind)
 	if err != nil {
@@ -41,7 +41,7 @@ func (m *MockSnapShotSink) Close() error {
 
 func TestPersist(t *testing.T) {
 	// arrange
-	fsm := newFSM()
+	fsm := newFSM(nil)
 	testMember := DaprHostMember{
 		Name:     "127.0.0.1:3030",
 		AppID:    "fakeAppID",
@@ -64,7 +64,7 @@ func TestPersist(t *testing.T) {
 	snap.Persist(fakeSink)
 
 	// assert
-	restoredState := newDaprHostMemberState()
+	restoredState := newDaprHostMemberState(nil)
 	err = restoredState.restore(buf)
 	assert.NoError(t, err)
 
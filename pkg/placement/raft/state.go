@@ -31,6 +31,8 @@ type DaprHostMember struct {
 	AppID string
 	// Entities is the list of Actor Types which this Dapr runtime supports.
 	Entities []string
+	// Topology is the failure domain of this Dapr runtime host.
+	Topology hashing.Topology
 
 	// UpdatedAt is the last time when this host member info is updated.
 	UpdatedAt int64
@@ -59,10 +61,15 @@ type DaprHostMemberState struct {
 	lock sync.RWMutex
 
 	data DaprHostMemberStateData
+
+	// tableOptions is the map of placement settings per actor type.
+	// This is configuration of the placement service and is not persisted.
+	tableOptions map[string]hashing.Options
 }
 
-func newDaprHostMemberState() *DaprHostMemberState {
+func newDaprHostMemberState(tableOptions map[string]hashing.Options) *DaprHostMemberState {
 	return &DaprHostMemberState{
+		tableOptions: tableOptions,
 		data: DaprHostMemberStateData{
 			Index:           0,
 			TableGeneration: 0,
@@ -109,6 +116,7 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 	defer s.lock.RUnlock()
 
 	newMembers := &DaprHostMemberState{
+		tableOptions: s.tableOptions,
 		data: DaprHostMemberStateData{
 			Index:           s.data.Index,
 			TableGeneration: s.data.TableGeneration,
@@ -121,6 +129,7 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 			Name:      v.Name,
 			AppID:     v.AppID,
 			Entities:  make([]string, len(v.Entities)),
+			Topology:  v.Topology,
 			UpdatedAt: v.UpdatedAt,
 		}
 		copy(m.Entities, v.Entities)
@@ -129,14 +138,20 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 	return newMembers
 }
 
+// TableOptions returns the placement settings for the given actor type.
+func (s *DaprHostMemberState) TableOptions(actorType string) hashing.Options {
+	return s.tableOptions[actorType]
+}
+
 // caller should holds lock.
 func (s *DaprHostMemberState) updateHashingTables(host *DaprHostMember) {
 	for _, e := range host.Entities {
 		if _, ok := s.data.hashingTableMap[e]; !ok {
-			s.data.hashingTableMap[e] = hashing.NewConsistentHash()
+			s.data.hashingTableMap[e] = hashing.NewConsistentHashWithOptions(s.tableOptions[e])
 		}
 
 		s.data.hashingTableMap[e].Add(host.Name, host.AppID, 0)
+		s.data.hashingTableMap[e].UpdateTopology(host.Name, host.Topology)
 	}
 }
 
@@ -167,7 +182,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 
 	if m, ok := s.data.Members[host.Name]; ok {
 		// No need to update consistent hashing table if the same dapr host member exists
-		if m.AppID == host.AppID && m.Name == host.Name && cmp.Equal(m.Entities, host.Entities) {
+		if m.AppID == host.AppID && m.Name == host.Name && cmp.Equal(m.Entities, host.Entities) && m.Topology == host.Topology {
 			m.UpdatedAt = host.UpdatedAt
 			return false
 		}
@@ -180,6 +195,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 	s.data.Members[host.Name] = &DaprHostMember{
 		Name:      host.Name,
 		AppID:     host.AppID,
+		Topology:  host.Topology,
 		UpdatedAt: host.UpdatedAt,
 	}
 
@@ -17,11 +17,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/placement/hashing"
 )
 
 func TestNewDaprHostMemberState(t *testing.T) {
 	// act
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 
 	// assert
 	assert.Equal(t, uint64(0), s.Index())
@@ -31,7 +33,7 @@ func TestNewDaprHostMemberState(t *testing.T) {
 
 func TestClone(t *testing.T) {
 	// arrange
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 	s.upsertMember(&DaprHostMember{
 		Name:     "127.0.0.1:8080",
 		AppID:    "FakeID",
@@ -50,7 +52,7 @@ func TestClone(t *testing.T) {
 
 func TestUpsertMember(t *testing.T) {
 	// arrange
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 
 	t.Run("add new actor member", func(t *testing.T) {
 		// act
@@ -129,9 +131,53 @@ func TestUpsertMember(t *testing.T) {
 	})
 }
 
+func TestUpsertMemberTableOptions(t *testing.T) {
+	// arrange
+	s := newDaprHostMemberState(map[string]hashing.Options{
+		"actorTypeOne": {LoadFactor: 1.25},
+	})
+	s.upsertMember(&DaprHostMember{
+		Name:     "127.0.0.1:8080",
+		AppID:    "FakeID",
+		Entities: []string{"actorTypeOne", "actorTypeTwo"},
+	})
+
+	// assert
+	assert.Equal(t, 1.25, s.hashingTableMap()["actorTypeOne"].LoadFactor())
+	assert.Equal(t, float64(0), s.hashingTableMap()["actorTypeTwo"].LoadFactor())
+}
+
+func TestUpsertMemberTopology(t *testing.T) {
+	// arrange
+	s := newDaprHostMemberState(map[string]hashing.Options{
+		"actorTypeOne": {ReplicationFactor: 10},
+	})
+	s.upsertMember(&DaprHostMember{
+		Name:     "127.0.0.1:8080",
+		AppID:    "FakeID",
+		Entities: []string{"actorTypeOne"},
+		Topology: hashing.Topology{Zone: "zone-a"},
+	})
+
+	// act
+	updated := s.upsertMember(&DaprHostMember{
+		Name:     "127.0.0.1:8080",
+		AppID:    "FakeID",
+		Entities: []string{"actorTypeOne"},
+		Topology: hashing.Topology{Zone: "zone-b"},
+	})
+
+	// assert
+	assert.True(t, updated)
+	assert.Equal(t, "zone-b", s.Members()["127.0.0.1:8080"].Topology.Zone)
+	host, err := s.hashingTableMap()["actorTypeOne"].GetHost("actor")
+	assert.NoError(t, err)
+	assert.Equal(t, "zone-b", host.Topology.Zone)
+}
+
 func TestRemoveMember(t *testing.T) {
 	// arrange
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 
 	t.Run("remove member and clean up consistent hashing table", func(t *testing.T) {
 		// act
@@ -174,7 +220,7 @@ func TestUpdateHashingTable(t *testing.T) {
 	// each subtest has dependency on the state
 
 	// arrange
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 
 	t.Run("add new hashing table per actor types", func(t *testing.T) {
 		testMember := &DaprHostMember{
@@ -227,7 +273,7 @@ func TestRemoveHashingTable(t *testing.T) {
 		{"127.0.0.1:8081", 0},
 	}
 
-	s := newDaprHostMemberState()
+	s := newDaprHostMemberState(nil)
 	for _, tc := range testcases {
 		testMember.Name = tc.name
 		s.updateHashingTables(testMember)
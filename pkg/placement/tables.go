@@ -13,15 +13,23 @@ limitations under the License.
 
 package placement
 
+import "github.com/dapr/dapr/pkg/placement/hashing"
+
 type PlacementTables struct {
 	HostList     []HostInfo `json:"hostList,omitempty"`
 	TableVersion uint64     `json:"tableVersion,omitempty"`
 }
 type HostInfo struct {
-	Name      string   `json:"name,omitempty"`
-	AppID     string   `json:"appId,omitempty"`
-	Entities  []string `json:"entities,omitempty"`
-	UpdatedAt int64    `json:"updatedAt,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	AppID     string        `json:"appId,omitempty"`
+	Entities  []string      `json:"entities,omitempty"`
+	UpdatedAt int64         `json:"updatedAt,omitempty"`
+	Topology  *HostTopology `json:"topology,omitempty"`
+}
+type HostTopology struct {
+	Region string `json:"region,omitempty"`
+	Zone   string `json:"zone,omitempty"`
+	Node   string `json:"node,omitempty"`
 }
 
 // GetPlacementTables returns the current placement host infos.
@@ -34,12 +42,20 @@ func (p *Service) GetPlacementTables() (*PlacementTables, error) {
 	members := make([]HostInfo, 0, len(m))
 	// the key of the member map is the host name, so we can just ignore it.
 	for _, v := range m {
-		members = append(members, HostInfo{
+		host := HostInfo{
 			Name:      v.Name,
 			AppID:     v.AppID,
 			Entities:  v.Entities,
 			UpdatedAt: v.UpdatedAt,
-		})
+		}
+		if v.Topology != (hashing.Topology{}) {
+			host.Topology = &HostTopology{
+				Region: v.Topology.Region,
+				Zone:   v.Topology.Zone,
+				Node:   v.Topology.Node,
+			}
+		}
+		members = append(members, host)
 	}
 	response.HostList = members
 	return response, nil
@@ -41,6 +41,10 @@ type OperatorClient interface {
 	ListHTTPEndpoints(ctx context.Context, in *ListHTTPEndpointsRequest, opts ...grpc.CallOption) (*ListHTTPEndpointsResponse, error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(ctx context.Context, in *HTTPEndpointUpdateRequest, opts ...grpc.CallOption) (Operator_HTTPEndpointUpdateClient, error)
+	// Sends events to Dapr sidecars upon pub/sub subscription changes.
+	SubscriptionUpdate(ctx context.Context, in *SubscriptionUpdateRequest, opts ...grpc.CallOption) (Operator_SubscriptionUpdateClient, error)
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(ctx context.Context, in *ResiliencyUpdateRequest, opts ...grpc.CallOption) (Operator_ResiliencyUpdateClient, error)
 }
 
 type operatorClient struct {
@@ -178,6 +182,70 @@ func (x *operatorHTTPEndpointUpdateClient) Recv() (*HTTPEndpointUpdateEvent, err
 	return m, nil
 }
 
+func (c *operatorClient) SubscriptionUpdate(ctx context.Context, in *SubscriptionUpdateRequest, opts ...grpc.CallOption) (Operator_SubscriptionUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[2], "/dapr.proto.operator.v1.Operator/SubscriptionUpdate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorSubscriptionUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_SubscriptionUpdateClient interface {
+	Recv() (*SubscriptionUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type operatorSubscriptionUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorSubscriptionUpdateClient) Recv() (*SubscriptionUpdateEvent, error) {
+	m := new(SubscriptionUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorClient) ResiliencyUpdate(ctx context.Context, in *ResiliencyUpdateRequest, opts ...grpc.CallOption) (Operator_ResiliencyUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[3], "/dapr.proto.operator.v1.Operator/ResiliencyUpdate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorResiliencyUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_ResiliencyUpdateClient interface {
+	Recv() (*ResiliencyUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type operatorResiliencyUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorResiliencyUpdateClient) Recv() (*ResiliencyUpdateEvent, error) {
+	m := new(ResiliencyUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OperatorServer is the server API for Operator service.
 // All implementations should embed UnimplementedOperatorServer
 // for forward compatibility
@@ -200,6 +268,10 @@ type OperatorServer interface {
 	ListHTTPEndpoints(context.Context, *ListHTTPEndpointsRequest) (*ListHTTPEndpointsResponse, error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(*HTTPEndpointUpdateRequest, Operator_HTTPEndpointUpdateServer) error
+	// Sends events to Dapr sidecars upon pub/sub subscription changes.
+	SubscriptionUpdate(*SubscriptionUpdateRequest, Operator_SubscriptionUpdateServer) error
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(*ResiliencyUpdateRequest, Operator_ResiliencyUpdateServer) error
 }
 
 // UnimplementedOperatorServer should be embedded to have forward compatible implementations.
@@ -233,6 +305,12 @@ func (UnimplementedOperatorServer) ListHTTPEndpoints(context.Context, *ListHTTPE
 func (UnimplementedOperatorServer) HTTPEndpointUpdate(*HTTPEndpointUpdateRequest, Operator_HTTPEndpointUpdateServer) error {
 	return status.Errorf(codes.Unimplemented, "method HTTPEndpointUpdate not implemented")
 }
+func (UnimplementedOperatorServer) SubscriptionUpdate(*SubscriptionUpdateRequest, Operator_SubscriptionUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscriptionUpdate not implemented")
+}
+func (UnimplementedOperatorServer) ResiliencyUpdate(*ResiliencyUpdateRequest, Operator_ResiliencyUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResiliencyUpdate not implemented")
+}
 
 // UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OperatorServer will
@@ -413,6 +491,48 @@ func (x *operatorHTTPEndpointUpdateServer) Send(m *HTTPEndpointUpdateEvent) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Operator_SubscriptionUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscriptionUpdateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).SubscriptionUpdate(m, &operatorSubscriptionUpdateServer{stream})
+}
+
+type Operator_SubscriptionUpdateServer interface {
+	Send(*SubscriptionUpdateEvent) error
+	grpc.ServerStream
+}
+
+type operatorSubscriptionUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorSubscriptionUpdateServer) Send(m *SubscriptionUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Operator_ResiliencyUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResiliencyUpdateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).ResiliencyUpdate(m, &operatorResiliencyUpdateServer{stream})
+}
+
+type Operator_ResiliencyUpdateServer interface {
+	Send(*ResiliencyUpdateEvent) error
+	grpc.ServerStream
+}
+
+type operatorResiliencyUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorResiliencyUpdateServer) Send(m *ResiliencyUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Operator_ServiceDesc is the grpc.ServiceDesc for Operator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -460,6 +580,16 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Operator_HTTPEndpointUpdate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscriptionUpdate",
+			Handler:       _Operator_SubscriptionUpdate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResiliencyUpdate",
+			Handler:       _Operator_ResiliencyUpdate_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/operator/v1/operator.proto",
 }
@@ -45,6 +45,12 @@ type DaprClient interface {
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error)
+	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic events from it.
+	SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error)
+	// CancelDelayedMessageAlpha1 cancels a message published with a delay which has not been published yet.
+	CancelDelayedMessageAlpha1(ctx context.Context, in *CancelDelayedMessageRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReplayDeadLettersAlpha1 republishes the messages of a dead-letter topic to the topic they were originally published to.
+	ReplayDeadLettersAlpha1(ctx context.Context, in *ReplayDeadLettersRequestAlpha1, opts ...grpc.CallOption) (*ReplayDeadLettersResponseAlpha1, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -91,6 +97,22 @@ type DaprClient interface {
 	GetMetadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PauseSubscriptionAlpha1 stops consuming messages from the topic of a subscription until it is resumed.
+	PauseSubscriptionAlpha1(ctx context.Context, in *PauseSubscriptionRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResumeSubscriptionAlpha1 resumes consuming messages from the topic of a paused subscription.
+	ResumeSubscriptionAlpha1(ctx context.Context, in *ResumeSubscriptionRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PauseInputBindingAlpha1 stops reading from an input binding until it is resumed.
+	PauseInputBindingAlpha1(ctx context.Context, in *PauseInputBindingRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResumeInputBindingAlpha1 resumes reading from a paused input binding.
+	ResumeInputBindingAlpha1(ctx context.Context, in *ResumeInputBindingRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetResiliencyStatusAlpha1 returns the live state of the circuit breakers and the resiliency policies resolved for a target.
+	GetResiliencyStatusAlpha1(ctx context.Context, in *GetResiliencyStatusRequestAlpha1, opts ...grpc.CallOption) (*GetResiliencyStatusResponseAlpha1, error)
+	// ResetCircuitBreakerAlpha1 closes a circuit breaker and clears its counts.
+	// It requires the ResiliencyCircuitBreakerControl feature to be enabled.
+	ResetCircuitBreakerAlpha1(ctx context.Context, in *CircuitBreakerRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// OpenCircuitBreakerAlpha1 forces a circuit breaker open until it is reset.
+	// It requires the ResiliencyCircuitBreakerControl feature to be enabled.
+	OpenCircuitBreakerAlpha1(ctx context.Context, in *CircuitBreakerRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -221,6 +243,55 @@ func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, in *BulkPublish
 	return out, nil
 }
 
+func (c *daprClient) SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprSubscribeTopicEventsAlpha1Client{stream}
+	return x, nil
+}
+
+type Dapr_SubscribeTopicEventsAlpha1Client interface {
+	Send(*SubscribeTopicEventsRequestAlpha1) error
+	Recv() (*TopicEventRequest, error)
+	grpc.ClientStream
+}
+
+type daprSubscribeTopicEventsAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprSubscribeTopicEventsAlpha1Client) Send(m *SubscribeTopicEventsRequestAlpha1) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprSubscribeTopicEventsAlpha1Client) Recv() (*TopicEventRequest, error) {
+	m := new(TopicEventRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) CancelDelayedMessageAlpha1(ctx context.Context, in *CancelDelayedMessageRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/CancelDelayedMessageAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ReplayDeadLettersAlpha1(ctx context.Context, in *ReplayDeadLettersRequestAlpha1, opts ...grpc.CallOption) (*ReplayDeadLettersResponseAlpha1, error) {
+	out := new(ReplayDeadLettersResponseAlpha1)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ReplayDeadLettersAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/InvokeBinding", in, out, opts...)
@@ -339,7 +410,7 @@ func (c *daprClient) GetConfiguration(ctx context.Context, in *GetConfigurationR
 }
 
 func (c *daprClient) SubscribeConfigurationAlpha1(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1", opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +442,7 @@ func (x *daprSubscribeConfigurationAlpha1Client) Recv() (*SubscribeConfiguration
 }
 
 func (c *daprClient) SubscribeConfiguration(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration", opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -439,7 +510,7 @@ func (c *daprClient) UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts .
 }
 
 func (c *daprClient) EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1", opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -470,7 +541,7 @@ func (x *daprEncryptAlpha1Client) Recv() (*EncryptResponse, error) {
 }
 
 func (c *daprClient) DecryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_DecryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1", opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -518,6 +589,69 @@ func (c *daprClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, op
 	return out, nil
 }
 
+func (c *daprClient) PauseSubscriptionAlpha1(ctx context.Context, in *PauseSubscriptionRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/PauseSubscriptionAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResumeSubscriptionAlpha1(ctx context.Context, in *ResumeSubscriptionRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ResumeSubscriptionAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) PauseInputBindingAlpha1(ctx context.Context, in *PauseInputBindingRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/PauseInputBindingAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResumeInputBindingAlpha1(ctx context.Context, in *ResumeInputBindingRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ResumeInputBindingAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetResiliencyStatusAlpha1(ctx context.Context, in *GetResiliencyStatusRequestAlpha1, opts ...grpc.CallOption) (*GetResiliencyStatusResponseAlpha1, error) {
+	out := new(GetResiliencyStatusResponseAlpha1)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetResiliencyStatusAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResetCircuitBreakerAlpha1(ctx context.Context, in *CircuitBreakerRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ResetCircuitBreakerAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) OpenCircuitBreakerAlpha1(ctx context.Context, in *CircuitBreakerRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/OpenCircuitBreakerAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) SubtleGetKeyAlpha1(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error) {
 	out := new(SubtleGetKeyResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1", in, out, opts...)
@@ -678,6 +812,12 @@ type DaprServer interface {
 	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
 	// Bulk Publishes multiple events to the specified topic.
 	BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error)
+	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic events from it.
+	SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error
+	// CancelDelayedMessageAlpha1 cancels a message published with a delay which has not been published yet.
+	CancelDelayedMessageAlpha1(context.Context, *CancelDelayedMessageRequestAlpha1) (*emptypb.Empty, error)
+	// ReplayDeadLettersAlpha1 republishes the messages of a dead-letter topic to the topic they were originally published to.
+	ReplayDeadLettersAlpha1(context.Context, *ReplayDeadLettersRequestAlpha1) (*ReplayDeadLettersResponseAlpha1, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -724,6 +864,22 @@ type DaprServer interface {
 	GetMetadata(context.Context, *emptypb.Empty) (*GetMetadataResponse, error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(context.Context, *SetMetadataRequest) (*emptypb.Empty, error)
+	// PauseSubscriptionAlpha1 stops consuming messages from the topic of a subscription until it is resumed.
+	PauseSubscriptionAlpha1(context.Context, *PauseSubscriptionRequestAlpha1) (*emptypb.Empty, error)
+	// ResumeSubscriptionAlpha1 resumes consuming messages from the topic of a paused subscription.
+	ResumeSubscriptionAlpha1(context.Context, *ResumeSubscriptionRequestAlpha1) (*emptypb.Empty, error)
+	// PauseInputBindingAlpha1 stops reading from an input binding until it is resumed.
+	PauseInputBindingAlpha1(context.Context, *PauseInputBindingRequestAlpha1) (*emptypb.Empty, error)
+	// ResumeInputBindingAlpha1 resumes reading from a paused input binding.
+	ResumeInputBindingAlpha1(context.Context, *ResumeInputBindingRequestAlpha1) (*emptypb.Empty, error)
+	// GetResiliencyStatusAlpha1 returns the live state of the circuit breakers and the resiliency policies resolved for a target.
+	GetResiliencyStatusAlpha1(context.Context, *GetResiliencyStatusRequestAlpha1) (*GetResiliencyStatusResponseAlpha1, error)
+	// ResetCircuitBreakerAlpha1 closes a circuit breaker and clears its counts.
+	// It requires the ResiliencyCircuitBreakerControl feature to be enabled.
+	ResetCircuitBreakerAlpha1(context.Context, *CircuitBreakerRequestAlpha1) (*emptypb.Empty, error)
+	// OpenCircuitBreakerAlpha1 forces a circuit breaker open until it is reset.
+	// It requires the ResiliencyCircuitBreakerControl feature to be enabled.
+	OpenCircuitBreakerAlpha1(context.Context, *CircuitBreakerRequestAlpha1) (*emptypb.Empty, error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -790,6 +946,15 @@ func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventReques
 func (UnimplementedDaprServer) BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkPublishEventAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTopicEventsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) CancelDelayedMessageAlpha1(context.Context, *CancelDelayedMessageRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedMessageAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ReplayDeadLettersAlpha1(context.Context, *ReplayDeadLettersRequestAlpha1) (*ReplayDeadLettersResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLettersAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -859,6 +1024,27 @@ func (UnimplementedDaprServer) GetMetadata(context.Context, *emptypb.Empty) (*Ge
 func (UnimplementedDaprServer) SetMetadata(context.Context, *SetMetadataRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
 }
+func (UnimplementedDaprServer) PauseSubscriptionAlpha1(context.Context, *PauseSubscriptionRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscriptionAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResumeSubscriptionAlpha1(context.Context, *ResumeSubscriptionRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscriptionAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) PauseInputBindingAlpha1(context.Context, *PauseInputBindingRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseInputBindingAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResumeInputBindingAlpha1(context.Context, *ResumeInputBindingRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInputBindingAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) GetResiliencyStatusAlpha1(context.Context, *GetResiliencyStatusRequestAlpha1) (*GetResiliencyStatusResponseAlpha1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResiliencyStatusAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResetCircuitBreakerAlpha1(context.Context, *CircuitBreakerRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreakerAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) OpenCircuitBreakerAlpha1(context.Context, *CircuitBreakerRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenCircuitBreakerAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) SubtleGetKeyAlpha1(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubtleGetKeyAlpha1 not implemented")
 }
@@ -1096,6 +1282,68 @@ func _Dapr_BulkPublishEventAlpha1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_SubscribeTopicEventsAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprServer).SubscribeTopicEventsAlpha1(&daprSubscribeTopicEventsAlpha1Server{stream})
+}
+
+type Dapr_SubscribeTopicEventsAlpha1Server interface {
+	Send(*TopicEventRequest) error
+	Recv() (*SubscribeTopicEventsRequestAlpha1, error)
+	grpc.ServerStream
+}
+
+type daprSubscribeTopicEventsAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprSubscribeTopicEventsAlpha1Server) Send(m *TopicEventRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprSubscribeTopicEventsAlpha1Server) Recv() (*SubscribeTopicEventsRequestAlpha1, error) {
+	m := new(SubscribeTopicEventsRequestAlpha1)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Dapr_CancelDelayedMessageAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDelayedMessageRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).CancelDelayedMessageAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/CancelDelayedMessageAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).CancelDelayedMessageAlpha1(ctx, req.(*CancelDelayedMessageRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ReplayDeadLettersAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReplayDeadLettersAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ReplayDeadLettersAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReplayDeadLettersAlpha1(ctx, req.(*ReplayDeadLettersRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -1532,6 +1780,132 @@ func _Dapr_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_PauseSubscriptionAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSubscriptionRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PauseSubscriptionAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/PauseSubscriptionAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PauseSubscriptionAlpha1(ctx, req.(*PauseSubscriptionRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ResumeSubscriptionAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSubscriptionRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ResumeSubscriptionAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ResumeSubscriptionAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ResumeSubscriptionAlpha1(ctx, req.(*ResumeSubscriptionRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_PauseInputBindingAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseInputBindingRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PauseInputBindingAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/PauseInputBindingAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PauseInputBindingAlpha1(ctx, req.(*PauseInputBindingRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ResumeInputBindingAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInputBindingRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ResumeInputBindingAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ResumeInputBindingAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ResumeInputBindingAlpha1(ctx, req.(*ResumeInputBindingRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetResiliencyStatusAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResiliencyStatusRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetResiliencyStatusAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/GetResiliencyStatusAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetResiliencyStatusAlpha1(ctx, req.(*GetResiliencyStatusRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ResetCircuitBreakerAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitBreakerRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ResetCircuitBreakerAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ResetCircuitBreakerAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ResetCircuitBreakerAlpha1(ctx, req.(*CircuitBreakerRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_OpenCircuitBreakerAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CircuitBreakerRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).OpenCircuitBreakerAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/OpenCircuitBreakerAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).OpenCircuitBreakerAlpha1(ctx, req.(*CircuitBreakerRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_SubtleGetKeyAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubtleGetKeyRequest)
 	if err := dec(in); err != nil {
@@ -1849,6 +2223,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkPublishEventAlpha1",
 			Handler:    _Dapr_BulkPublishEventAlpha1_Handler,
 		},
+		{
+			MethodName: "CancelDelayedMessageAlpha1",
+			Handler:    _Dapr_CancelDelayedMessageAlpha1_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLettersAlpha1",
+			Handler:    _Dapr_ReplayDeadLettersAlpha1_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Dapr_InvokeBinding_Handler,
@@ -1925,6 +2307,34 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMetadata",
 			Handler:    _Dapr_SetMetadata_Handler,
 		},
+		{
+			MethodName: "PauseSubscriptionAlpha1",
+			Handler:    _Dapr_PauseSubscriptionAlpha1_Handler,
+		},
+		{
+			MethodName: "ResumeSubscriptionAlpha1",
+			Handler:    _Dapr_ResumeSubscriptionAlpha1_Handler,
+		},
+		{
+			MethodName: "PauseInputBindingAlpha1",
+			Handler:    _Dapr_PauseInputBindingAlpha1_Handler,
+		},
+		{
+			MethodName: "ResumeInputBindingAlpha1",
+			Handler:    _Dapr_ResumeInputBindingAlpha1_Handler,
+		},
+		{
+			MethodName: "GetResiliencyStatusAlpha1",
+			Handler:    _Dapr_GetResiliencyStatusAlpha1_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreakerAlpha1",
+			Handler:    _Dapr_ResetCircuitBreakerAlpha1_Handler,
+		},
+		{
+			MethodName: "OpenCircuitBreakerAlpha1",
+			Handler:    _Dapr_OpenCircuitBreakerAlpha1_Handler,
+		},
 		{
 			MethodName: "SubtleGetKeyAlpha1",
 			Handler:    _Dapr_SubtleGetKeyAlpha1_Handler,
@@ -1987,6 +2397,12 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTopicEventsAlpha1",
+			Handler:       _Dapr_SubscribeTopicEventsAlpha1_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "SubscribeConfigurationAlpha1",
 			Handler:       _Dapr_SubscribeConfigurationAlpha1_Handler,
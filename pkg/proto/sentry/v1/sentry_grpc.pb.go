@@ -27,6 +27,11 @@ type CAClient interface {
 	// The requesting side must provide an id for both loosely based
 	// And strong based identities.
 	SignCertificate(ctx context.Context, in *SignCertificateRequest, opts ...grpc.CallOption) (*SignCertificateResponse, error)
+	// Returns the list of revoked identities and certificates.
+	//
+	// Sidecars poll this list and refuse mTLS connections from
+	// peers presenting a revoked certificate.
+	GetRevocationList(ctx context.Context, in *GetRevocationListRequest, opts ...grpc.CallOption) (*GetRevocationListResponse, error)
 }
 
 type cAClient struct {
@@ -46,6 +51,15 @@ func (c *cAClient) SignCertificate(ctx context.Context, in *SignCertificateReque
 	return out, nil
 }
 
+func (c *cAClient) GetRevocationList(ctx context.Context, in *GetRevocationListRequest, opts ...grpc.CallOption) (*GetRevocationListResponse, error) {
+	out := new(GetRevocationListResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.sentry.v1.CA/GetRevocationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CAServer is the server API for CA service.
 // All implementations should embed UnimplementedCAServer
 // for forward compatibility
@@ -55,6 +69,11 @@ type CAServer interface {
 	// The requesting side must provide an id for both loosely based
 	// And strong based identities.
 	SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error)
+	// Returns the list of revoked identities and certificates.
+	//
+	// Sidecars poll this list and refuse mTLS connections from
+	// peers presenting a revoked certificate.
+	GetRevocationList(context.Context, *GetRevocationListRequest) (*GetRevocationListResponse, error)
 }
 
 // UnimplementedCAServer should be embedded to have forward compatible implementations.
@@ -64,6 +83,9 @@ type UnimplementedCAServer struct {
 func (UnimplementedCAServer) SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignCertificate not implemented")
 }
+func (UnimplementedCAServer) GetRevocationList(context.Context, *GetRevocationListRequest) (*GetRevocationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevocationList not implemented")
+}
 
 // UnsafeCAServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CAServer will
@@ -94,6 +116,24 @@ func _CA_SignCertificate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CA_GetRevocationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevocationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAServer).GetRevocationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.sentry.v1.CA/GetRevocationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAServer).GetRevocationList(ctx, req.(*GetRevocationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CA_ServiceDesc is the grpc.ServiceDesc for CA service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +145,10 @@ var CA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignCertificate",
 			Handler:    _CA_SignCertificate_Handler,
 		},
+		{
+			MethodName: "GetRevocationList",
+			Handler:    _CA_GetRevocationList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dapr/proto/sentry/v1/sentry.proto",
@@ -16,6 +16,7 @@ package breaker
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/sony/gobreaker"
@@ -49,7 +50,32 @@ type CircuitBreaker struct {
 	// Default is consecutiveFailures > 5.
 	Trip *expr.Expr `mapstructure:"trip"`
 
-	breaker *gobreaker.CircuitBreaker
+	log        logger.Logger
+	breaker    atomic.Pointer[gobreaker.CircuitBreaker]
+	openedAt   atomic.Int64
+	forcedOpen atomic.Bool
+}
+
+// Counts are the number of requests and their outcomes counted by a circuit breaker.
+type Counts struct {
+	Requests             uint32
+	TotalSuccesses       uint32
+	TotalFailures        uint32
+	ConsecutiveSuccesses uint32
+	ConsecutiveFailures  uint32
+}
+
+// Status is a snapshot of the state of a circuit breaker.
+type Status struct {
+	Name  string
+	State CircuitBreakerState
+	// Counts are reset when the state changes, and at every interval in the closed state.
+	Counts Counts
+	// TimeToHalfOpen is the time left before an open circuit breaker becomes half-open.
+	// It is 0 if the circuit breaker isn't open or was forced open.
+	TimeToHalfOpen time.Duration
+	// ForcedOpen is true if the circuit breaker was forced open and stays open until it is reset.
+	ForcedOpen bool
 }
 
 var (
@@ -57,6 +83,9 @@ var (
 	ErrTooManyRequests = gobreaker.ErrTooManyRequests
 )
 
+// Default period of the open state used by gobreaker when Timeout is 0.
+const defaultTimeout = 60 * time.Second
+
 type CircuitBreakerState string
 
 const (
@@ -75,6 +104,11 @@ func IsErrorPermanent(err error) bool {
 // Initialize creates the underlying circuit breaker using the
 // configuration fields.
 func (c *CircuitBreaker) Initialize(log logger.Logger) {
+	c.log = log
+	c.breaker.Store(c.newBreaker())
+}
+
+func (c *CircuitBreaker) newBreaker() *gobreaker.CircuitBreaker {
 	var tripFn func(counts gobreaker.Counts) bool
 
 	if c.Trip != nil {
@@ -99,14 +133,17 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 		}
 	}
 
-	c.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{ //nolint:exhaustivestruct
+	return gobreaker.NewCircuitBreaker(gobreaker.Settings{ //nolint:exhaustivestruct
 		Name:        c.Name,
 		MaxRequests: c.MaxRequests,
 		Interval:    c.Interval,
 		Timeout:     c.Timeout,
 		ReadyToTrip: tripFn,
 		OnStateChange: func(name string, from, to gobreaker.State) {
-			log.Infof("Circuit breaker %q changed state from %s to %s", name, from, to)
+			if to == gobreaker.StateOpen {
+				c.openedAt.Store(time.Now().UnixNano())
+			}
+			c.log.Infof("Circuit breaker %q changed state from %s to %s", name, from, to)
 		},
 	})
 }
@@ -121,7 +158,10 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 // In all other scenarios the result, error returned is the result, error returned by the
 // operation.
 func (c *CircuitBreaker) Execute(oper func() (any, error)) (any, error) {
-	res, err := c.breaker.Execute(oper)
+	if c.forcedOpen.Load() {
+		return nil, ErrOpenState
+	}
+	res, err := c.breaker.Load().Execute(oper)
 
 	// Wrap the error so we don't have to reference the external package in other places.
 	switch {
@@ -146,10 +186,14 @@ func (c *CircuitBreaker) String() string {
 
 // State returns the current state of the circuit breaker.
 func (c *CircuitBreaker) State() CircuitBreakerState {
-	if c.breaker == nil {
+	b := c.breaker.Load()
+	if b == nil {
 		return StateUnknown
 	}
-	switch c.breaker.State() {
+	if c.forcedOpen.Load() {
+		return StateOpen
+	}
+	switch b.State() {
 	case gobreaker.StateClosed:
 		return StateClosed
 	case gobreaker.StateOpen:
@@ -160,3 +204,50 @@ func (c *CircuitBreaker) State() CircuitBreakerState {
 		return StateUnknown
 	}
 }
+
+// Status returns a snapshot of the state and counts of the circuit breaker.
+func (c *CircuitBreaker) Status() Status {
+	status := Status{
+		Name:       c.Name,
+		State:      c.State(),
+		ForcedOpen: c.forcedOpen.Load(),
+	}
+	if b := c.breaker.Load(); b != nil {
+		counts := b.Counts()
+		status.Counts = Counts{
+			Requests:             counts.Requests,
+			TotalSuccesses:       counts.TotalSuccesses,
+			TotalFailures:        counts.TotalFailures,
+			ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+			ConsecutiveFailures:  counts.ConsecutiveFailures,
+		}
+	}
+	if status.State == StateOpen && !status.ForcedOpen {
+		timeout := c.Timeout
+		if timeout <= 0 {
+			timeout = defaultTimeout
+		}
+		openedAt := time.Unix(0, c.openedAt.Load())
+		if left := timeout - time.Since(openedAt); left > 0 {
+			status.TimeToHalfOpen = left
+		}
+	}
+	return status
+}
+
+// ForceOpen opens the circuit breaker, rejecting all calls until it is reset.
+func (c *CircuitBreaker) ForceOpen() {
+	if !c.forcedOpen.Swap(true) && c.log != nil {
+		c.log.Warnf("Circuit breaker %q was forced open", c.Name)
+	}
+}
+
+// Reset closes the circuit breaker and clears its counts, including if it was forced open.
+func (c *CircuitBreaker) Reset() {
+	if c.breaker.Load() == nil {
+		return
+	}
+	c.breaker.Store(c.newBreaker())
+	c.forcedOpen.Store(false)
+	c.log.Infof("Circuit breaker %q was reset", c.Name)
+}
@@ -64,3 +64,71 @@ func TestCircuitBreaker(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 42, res)
 }
+
+func TestCircuitBreakerStatus(t *testing.T) {
+	t.Parallel()
+	log := logger.NewLogger("test")
+
+	var trip expr.Expr
+	err := trip.DecodeString("consecutiveFailures > 1")
+	require.NoError(t, err)
+
+	cb := breaker.CircuitBreaker{
+		Name:    "test",
+		Trip:    &trip,
+		Timeout: time.Minute,
+	}
+	cb.Initialize(log)
+
+	cb.Execute(func() (any, error) {
+		return nil, nil
+	})
+	cb.Execute(func() (any, error) {
+		return nil, errors.New("test")
+	})
+	status := cb.Status()
+	assert.Equal(t, "test", status.Name)
+	assert.Equal(t, breaker.StateClosed, status.State)
+	assert.Equal(t, breaker.Counts{Requests: 2, TotalSuccesses: 1, TotalFailures: 1, ConsecutiveFailures: 1}, status.Counts)
+	assert.Zero(t, status.TimeToHalfOpen)
+
+	t.Run("open", func(t *testing.T) {
+		cb.Execute(func() (any, error) {
+			return nil, errors.New("test")
+		})
+		status := cb.Status()
+		assert.Equal(t, breaker.StateOpen, status.State)
+		assert.Greater(t, status.TimeToHalfOpen, 50*time.Second)
+		assert.LessOrEqual(t, status.TimeToHalfOpen, time.Minute)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		cb.Reset()
+		status := cb.Status()
+		assert.Equal(t, breaker.StateClosed, status.State)
+		assert.Equal(t, breaker.Counts{}, status.Counts)
+
+		res, err := cb.Execute(func() (any, error) {
+			return 42, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 42, res)
+	})
+
+	t.Run("force open", func(t *testing.T) {
+		cb.ForceOpen()
+		status := cb.Status()
+		assert.Equal(t, breaker.StateOpen, status.State)
+		assert.True(t, status.ForcedOpen)
+		assert.Zero(t, status.TimeToHalfOpen)
+
+		_, err := cb.Execute(func() (any, error) {
+			return 42, nil
+		})
+		assert.ErrorIs(t, err, breaker.ErrOpenState)
+
+		cb.Reset()
+		assert.Equal(t, breaker.StateClosed, cb.State())
+		assert.False(t, cb.Status().ForcedOpen)
+	})
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ErrBulkheadFull is returned when a call is rejected because the bulkhead of the target is full.
+	ErrBulkheadFull = errors.New("bulkhead is full")
+	// ErrRateLimitExceeded is returned when a call is rejected because the rate limit of the target is exceeded.
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+)
+
+// Bulkhead limits the number of calls executed concurrently.
+// Calls beyond the limit wait in a queue, and are rejected once the queue is full.
+type Bulkhead struct {
+	Name               string
+	MaxConcurrentCalls int
+	MaxQueueSize       int
+
+	sem    chan struct{}
+	queued atomic.Int64
+}
+
+// newBulkhead returns a new Bulkhead with the limits of the template.
+func newBulkhead(name string, template *Bulkhead) *Bulkhead {
+	return &Bulkhead{
+		Name:               name,
+		MaxConcurrentCalls: template.MaxConcurrentCalls,
+		MaxQueueSize:       template.MaxQueueSize,
+		sem:                make(chan struct{}, template.MaxConcurrentCalls),
+	}
+}
+
+// Acquire waits until the call can be executed, and returns a function that must be invoked once the call has completed.
+// It returns ErrBulkheadFull if the queue is full.
+func (b *Bulkhead) Acquire(ctx context.Context) (func(), error) {
+	select {
+	case b.sem <- struct{}{}:
+		return b.release, nil
+	default:
+	}
+
+	if b.queued.Add(1) > int64(b.MaxQueueSize) {
+		b.queued.Add(-1)
+		return nil, ErrBulkheadFull
+	}
+	defer b.queued.Add(-1)
+
+	select {
+	case b.sem <- struct{}{}:
+		return b.release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *Bulkhead) release() {
+	<-b.sem
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (b *Bulkhead) String() string {
+	return fmt.Sprintf("name='%s' maxConcurrentCalls='%d' maxQueueSize='%d'", b.Name, b.MaxConcurrentCalls, b.MaxQueueSize)
+}
+
+// RateLimiter limits the rate of calls using a token bucket.
+// Calls are rejected when there are no tokens left.
+type RateLimiter struct {
+	Name              string
+	RequestsPerSecond int
+	Burst             int
+
+	limiter *rate.Limiter
+}
+
+// newRateLimiter returns a new RateLimiter with the limits of the template.
+func newRateLimiter(name string, template *RateLimiter) *RateLimiter {
+	return &RateLimiter{
+		Name:              name,
+		RequestsPerSecond: template.RequestsPerSecond,
+		Burst:             template.Burst,
+		limiter:           rate.NewLimiter(rate.Limit(template.RequestsPerSecond), template.Burst),
+	}
+}
+
+// Allow returns true if a call can be executed now.
+func (l *RateLimiter) Allow() bool {
+	return l.limiter.Allow()
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (l *RateLimiter) String() string {
+	return fmt.Sprintf("name='%s' requestsPerSecond='%d' burst='%d'", l.Name, l.RequestsPerSecond, l.Burst)
+}
+
+// policyInstances stores the state of policies for each target, such as bulkheads and rate limiters, as it's shared by all calls to the target.
+type policyInstances[T any] struct {
+	lock      sync.RWMutex
+	instances map[policyInstanceKey]T
+}
+
+// policyInstanceKey identifies the instance of a policy for a target.
+type policyInstanceKey struct {
+	policy string
+	target string
+}
+
+// Get returns the instance of the policy for the target if one exists.
+// Otherwise, it stores and returns the instance returned by create.
+func (l *policyInstances[T]) Get(policy, target string, create func() T) T {
+	key := policyInstanceKey{policy: policy, target: target}
+	l.lock.RLock()
+	instance, ok := l.instances[key]
+	l.lock.RUnlock()
+	if ok {
+		return instance
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Check again in case another goroutine created the object while we were waiting for the lock
+	instance, ok = l.instances[key]
+	if ok {
+		return instance
+	}
+
+	instance = create()
+	l.instances[key] = instance
+	return instance
+}
+
+// inherit copies the instances stored by prev for the policies for which unchanged returns true.
+// It must be called before l is used.
+func (l *policyInstances[T]) inherit(prev *policyInstances[T], unchanged func(policy string) bool) {
+	prev.lock.RLock()
+	defer prev.lock.RUnlock()
+	for key, instance := range prev.instances {
+		if unchanged(key.policy) {
+			l.instances[key] = instance
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkhead(t *testing.T) {
+	t.Run("rejects calls when the queue is full", func(t *testing.T) {
+		b := newBulkhead("test", &Bulkhead{MaxConcurrentCalls: 1, MaxQueueSize: 1})
+
+		release, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+
+		queued := make(chan error)
+		go func() {
+			queuedRelease, qErr := b.Acquire(context.Background())
+			if qErr == nil {
+				queuedRelease()
+			}
+			queued <- qErr
+		}()
+		assert.Eventually(t, func() bool {
+			return b.queued.Load() == 1
+		}, time.Second, 5*time.Millisecond)
+
+		_, err = b.Acquire(context.Background())
+		require.ErrorIs(t, err, ErrBulkheadFull)
+
+		release()
+		require.NoError(t, <-queued)
+		assert.Equal(t, int64(0), b.queued.Load())
+	})
+
+	t.Run("without a queue", func(t *testing.T) {
+		b := newBulkhead("test", &Bulkhead{MaxConcurrentCalls: 2})
+
+		release1, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+		release2, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+		_, err = b.Acquire(context.Background())
+		require.ErrorIs(t, err, ErrBulkheadFull)
+
+		release1()
+		release2()
+		release, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("context canceled while queued", func(t *testing.T) {
+		b := newBulkhead("test", &Bulkhead{MaxConcurrentCalls: 1, MaxQueueSize: 1})
+		release, err := b.Acquire(context.Background())
+		require.NoError(t, err)
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = b.Acquire(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int64(0), b.queued.Load())
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter("test", &RateLimiter{RequestsPerSecond: 1, Burst: 2})
+	assert.True(t, l.Allow())
+	assert.True(t, l.Allow())
+	assert.False(t, l.Allow())
+}
+
+func TestPolicyLimiters(t *testing.T) {
+	t.Run("rate limit rejects calls without retrying", func(t *testing.T) {
+		called := atomic.Int32{}
+		rejected := atomic.Int32{}
+		policyDef := &PolicyDefinition{
+			log:  testLog,
+			name: "ratelimit",
+			rl:   newRateLimiter("test", &RateLimiter{RequestsPerSecond: 1, Burst: 1}),
+			addRateLimitRejectedMetric: func() {
+				rejected.Add(1)
+			},
+		}
+		fn := func(ctx context.Context) (any, error) {
+			called.Add(1)
+			return nil, nil
+		}
+
+		_, err := NewRunner[any](context.Background(), policyDef)(fn)
+		require.NoError(t, err)
+		_, err = NewRunner[any](context.Background(), policyDef)(fn)
+		require.ErrorIs(t, err, ErrRateLimitExceeded)
+		assert.Equal(t, int32(1), called.Load())
+		assert.Equal(t, int32(1), rejected.Load())
+	})
+
+	t.Run("bulkhead rejects concurrent calls", func(t *testing.T) {
+		rejected := atomic.Int32{}
+		policyDef := &PolicyDefinition{
+			log:  testLog,
+			name: "bulkhead",
+			bh:   newBulkhead("test", &Bulkhead{MaxConcurrentCalls: 1}),
+			addBulkheadRejectedMetric: func() {
+				rejected.Add(1)
+			},
+		}
+
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+				close(started)
+				<-unblock
+				return nil, nil
+			})
+			done <- err
+		}()
+		<-started
+
+		_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, nil
+		})
+		require.ErrorIs(t, err, ErrBulkheadFull)
+		assert.Equal(t, int32(1), rejected.Load())
+
+		close(unblock)
+		require.NoError(t, <-done)
+
+		// The slot is released once the call completes
+		_, err = NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+	})
+	t.Run("bulkhead slot is held until operations which timed out return", func(t *testing.T) {
+		policyDef := &PolicyDefinition{
+			log:  testLog,
+			name: "bulkhead",
+			t:    10 * time.Millisecond,
+			bh:   newBulkhead("test", &Bulkhead{MaxConcurrentCalls: 1}),
+		}
+
+		unblock := make(chan struct{})
+		returned := make(chan struct{})
+		_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			defer close(returned)
+			<-unblock
+			return nil, nil
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// The operation is still running in the background
+		_, err = NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, nil
+		})
+		require.ErrorIs(t, err, ErrBulkheadFull)
+
+		close(unblock)
+		<-returned
+		assert.Eventually(t, func() bool {
+			_, err = NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+				return nil, nil
+			})
+			return err == nil
+		}, time.Second, time.Millisecond)
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+// FallbackPolicy is used when a call to a target fails, to return a static response or call an alternate app or component instead.
+type FallbackPolicy struct {
+	Name string
+	// Response is a static response returned by service invocation calls.
+	Response *FallbackResponse
+	// AppID and Method are the alternate app and method invoked by service invocation calls.
+	AppID  string
+	Method string
+	// Component is the alternate component used by calls to a component.
+	Component string
+	// OnCircuitBreakerOpen restricts the fallback to calls rejected by an open circuit breaker.
+	OnCircuitBreakerOpen bool
+}
+
+// FallbackResponse is a static response returned by a fallback policy.
+type FallbackResponse struct {
+	StatusCode  int
+	Headers     map[string]string
+	ContentType string
+	Body        []byte
+}
+
+// newFallbackPolicy parses the configuration of a fallback policy.
+func newFallbackPolicy(name string, f resiliencyV1alpha.Fallback) (*FallbackPolicy, error) {
+	set := 0
+	if f.Response != nil {
+		set++
+	}
+	if f.AppID != "" {
+		set++
+	}
+	if f.Component != "" {
+		set++
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of response, appId, or component must be set")
+	}
+	if f.Method != "" && f.AppID == "" {
+		return nil, fmt.Errorf("method can only be set together with appId")
+	}
+
+	p := &FallbackPolicy{
+		Name:                 name,
+		AppID:                f.AppID,
+		Method:               f.Method,
+		Component:            f.Component,
+		OnCircuitBreakerOpen: f.OnCircuitBreakerOpen,
+	}
+	if f.Response != nil {
+		statusCode := f.Response.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		} else if statusCode < 100 || statusCode > 599 {
+			return nil, fmt.Errorf("invalid response status code %d", statusCode)
+		}
+		p.Response = &FallbackResponse{
+			StatusCode:  statusCode,
+			Headers:     f.Response.Headers,
+			ContentType: f.Response.ContentType,
+			Body:        []byte(f.Response.Body),
+		}
+	}
+	return p, nil
+}
+
+// ShouldFallback returns true if the fallback applies to a call which failed with the error.
+// Fallbacks apply to calls rejected by an open circuit breaker, calls which timed out or couldn't reach the target,
+// and calls which received a response with a 5xx HTTP status code. Other errors, like invalid requests, are returned as-is.
+// It is safe to call on a nil policy.
+func (p *FallbackPolicy) ShouldFallback(err error) bool {
+	if p == nil || err == nil {
+		return false
+	}
+	if IsCircuitBreakerError(err) {
+		return true
+	}
+	if p.OnCircuitBreakerOpen {
+		return false
+	}
+
+	var httpErr HTTPStatusError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	switch classifyError(err) {
+	case ErrorClassTimeout, ErrorClassConnection:
+		return true
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+	return false
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (p *FallbackPolicy) String() string {
+	switch {
+	case p.Response != nil:
+		return fmt.Sprintf("name='%s' response=(statusCode='%d') onCircuitBreakerOpen='%t'", p.Name, p.Response.StatusCode, p.OnCircuitBreakerOpen)
+	case p.AppID != "":
+		return fmt.Sprintf("name='%s' appId='%s' method='%s' onCircuitBreakerOpen='%t'", p.Name, p.AppID, p.Method, p.OnCircuitBreakerOpen)
+	default:
+		return fmt.Sprintf("name='%s' component='%s' onCircuitBreakerOpen='%t'", p.Name, p.Component, p.OnCircuitBreakerOpen)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+const (
+	defaultHedgingMaxAttempts = 2
+
+	// Number of latencies of recent requests kept to compute the percentile-based delay.
+	hedgingLatencyWindow = 100
+	// Minimum number of latencies needed before the percentile-based delay is used.
+	hedgingMinLatencies = 10
+)
+
+// HedgingPolicy sends additional requests to other instances of an app when a request doesn't receive a response in time.
+type HedgingPolicy struct {
+	Name        string
+	Delay       time.Duration
+	Percentile  int
+	MaxAttempts int
+
+	verbs   map[string]struct{}
+	methods map[string]struct{}
+
+	lock      sync.Mutex
+	latencies []time.Duration
+	next      int
+}
+
+// newHedgingPolicyTemplate parses the configuration of a hedging policy.
+func newHedgingPolicyTemplate(name string, h resiliencyV1alpha.Hedging) (*HedgingPolicy, error) {
+	delay, err := parseDuration(h.Delay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delay %q: %w", h.Delay, err)
+	}
+	if delay <= 0 {
+		return nil, fmt.Errorf("delay must be greater than 0")
+	}
+	if h.Percentile < 0 || h.Percentile > 99 {
+		return nil, fmt.Errorf("percentile must be between 1 and 99")
+	}
+	if h.MaxAttempts == 0 {
+		h.MaxAttempts = defaultHedgingMaxAttempts
+	} else if h.MaxAttempts < 2 {
+		return nil, fmt.Errorf("maxAttempts must be at least 2")
+	}
+	if len(h.Verbs) == 0 && len(h.Methods) == 0 {
+		return nil, fmt.Errorf("at least one idempotent verb or method is required")
+	}
+
+	p := &HedgingPolicy{
+		Name:        name,
+		Delay:       delay,
+		Percentile:  h.Percentile,
+		MaxAttempts: h.MaxAttempts,
+		verbs:       make(map[string]struct{}, len(h.Verbs)),
+		methods:     make(map[string]struct{}, len(h.Methods)),
+	}
+	for _, v := range h.Verbs {
+		p.verbs[strings.ToUpper(v)] = struct{}{}
+	}
+	for _, m := range h.Methods {
+		p.methods[strings.TrimPrefix(m, "/")] = struct{}{}
+	}
+	return p, nil
+}
+
+// newHedgingPolicy returns a new HedgingPolicy with the configuration of the template.
+func newHedgingPolicy(name string, template *HedgingPolicy) *HedgingPolicy {
+	return &HedgingPolicy{
+		Name:        name,
+		Delay:       template.Delay,
+		Percentile:  template.Percentile,
+		MaxAttempts: template.MaxAttempts,
+		verbs:       template.verbs,
+		methods:     template.methods,
+	}
+}
+
+// CanHedge returns true if requests with the HTTP verb or method are idempotent and can be hedged.
+func (p *HedgingPolicy) CanHedge(verb, method string) bool {
+	if _, ok := p.verbs[strings.ToUpper(verb)]; ok {
+		return true
+	}
+	_, ok := p.methods[strings.TrimPrefix(method, "/")]
+	return ok
+}
+
+// HedgeDelay returns the time to wait for a response before sending another request.
+func (p *HedgingPolicy) HedgeDelay() time.Duration {
+	if p.Percentile == 0 {
+		return p.Delay
+	}
+
+	p.lock.Lock()
+	if len(p.latencies) < hedgingMinLatencies {
+		p.lock.Unlock()
+		return p.Delay
+	}
+	sorted := slices.Clone(p.latencies)
+	p.lock.Unlock()
+
+	slices.Sort(sorted)
+	return sorted[(len(sorted)-1)*p.Percentile/100]
+}
+
+// RecordLatency records the latency of a request which completed successfully.
+func (p *HedgingPolicy) RecordLatency(d time.Duration) {
+	if p.Percentile == 0 {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.latencies) < hedgingLatencyWindow {
+		p.latencies = append(p.latencies, d)
+		return
+	}
+	p.latencies[p.next] = d
+	p.next = (p.next + 1) % hedgingLatencyWindow
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (p *HedgingPolicy) String() string {
+	return fmt.Sprintf("name='%s' delay='%v' percentile='%d' maxAttempts='%d'", p.Name, p.Delay, p.Percentile, p.MaxAttempts)
+}
@@ -46,6 +46,21 @@ func (NoOp) ComponentOutboundPolicy(name string, componentName ComponentType) *P
 	return nil
 }
 
+// EndpointHedgingPolicy returns a NoOp hedging policy for an app.
+func (NoOp) EndpointHedgingPolicy(app string) *HedgingPolicy {
+	return nil
+}
+
+// EndpointFallbackPolicy returns a NoOp fallback policy for an app.
+func (NoOp) EndpointFallbackPolicy(app string) *FallbackPolicy {
+	return nil
+}
+
+// ComponentFallbackPolicy returns a NoOp fallback policy for a component.
+func (NoOp) ComponentFallbackPolicy(name string, componentType ComponentType) *FallbackPolicy {
+	return nil
+}
+
 // BuildInPolicy returns a NoOp policy definition for a built-in policy.
 func (NoOp) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 	return nil
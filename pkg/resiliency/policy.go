@@ -44,14 +44,21 @@ type doneCh[T any] struct {
 
 // PolicyDefinition contains a definition for a policy, used to create a Runner.
 type PolicyDefinition struct {
-	log                       logger.Logger
-	name                      string
-	t                         time.Duration
-	r                         *retry.Config
-	cb                        *breaker.CircuitBreaker
-	addTimeoutActivatedMetric func()
-	addRetryActivatedMetric   func()
-	addCBStateChangedMetric   func()
+	log                           logger.Logger
+	name                          string
+	t                             time.Duration
+	r                             *retry.Config
+	rm                            *RetryMatching
+	rb                            *RetryBudget
+	cb                            *breaker.CircuitBreaker
+	bh                            *Bulkhead
+	rl                            *RateLimiter
+	addTimeoutActivatedMetric     func()
+	addRetryActivatedMetric       func()
+	addCBStateChangedMetric       func()
+	addBulkheadRejectedMetric     func()
+	addRateLimitRejectedMetric    func()
+	addRetryBudgetExhaustedMetric func()
 }
 
 // NewPolicyDefinition returns a PolicyDefinition object with the given parameters.
@@ -68,8 +75,8 @@ func NewPolicyDefinition(log logger.Logger, name string, t time.Duration, r *ret
 // String implements fmt.Stringer and is used for debugging.
 func (p PolicyDefinition) String() string {
 	return fmt.Sprintf(
-		"Policy: name='%s' timeout='%v' retry=(%v) circuitBreaker=(%v)",
-		p.name, p.t, p.r, p.cb,
+		"Policy: name='%s' timeout='%v' retry=(%v) retryMatching=(%v) retryBudget=(%v) circuitBreaker=(%v) bulkhead=(%v) rateLimit=(%v)",
+		p.name, p.t, p.r, p.rm, p.rb, p.cb, p.bh, p.rl,
 	)
 }
 
@@ -81,6 +88,7 @@ func (p PolicyDefinition) HasRetries() bool {
 type RunnerOpts[T any] struct {
 	// The disposer is a function which is invoked when the operation fails, including due to timing out in a background goroutine. It receives the value returned by the operation function as long as it's non-zero (e.g. non-nil for pointer types).
 	// The disposer can be used to perform cleanup tasks on values returned by the operation function that would otherwise leak (because they're not returned by the result of the runner).
+	// The disposer is not invoked for errors which are not retried because they don't match the retry policy, as the value is returned by the runner together with the error.
 	Disposer func(T)
 
 	// The accumulator is a function that is invoked synchronously when an operation completes without timing out, whether successfully or not. It receives the value returned by the operation function as long as it's non-zero (e.g. non-nil for pointer types).
@@ -109,6 +117,34 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 	var zero T
 	timeoutMetricsActivated := atomic.Bool{}
 	return func(oper Operation[T]) (T, error) {
+		// Rate limits and bulkheads apply to the whole call including retries, and reject calls without retrying them
+		if def.rl != nil && !def.rl.Allow() {
+			if def.addRateLimitRejectedMetric != nil {
+				def.addRateLimitRejectedMetric()
+			}
+			return zero, ErrRateLimitExceeded
+		}
+		// The bulkhead slot is held by the call and by the operations which timed out but are still running in the background,
+		// and is released when the last of them returns.
+		var bhRefs atomic.Int32
+		bhRelease := func() {}
+		if def.bh != nil {
+			release, err := def.bh.Acquire(ctx)
+			if err != nil {
+				if def.addBulkheadRejectedMetric != nil && errors.Is(err, ErrBulkheadFull) {
+					def.addBulkheadRejectedMetric()
+				}
+				return zero, err
+			}
+			bhRefs.Store(1)
+			bhRelease = func() {
+				if bhRefs.Add(-1) == 0 {
+					release()
+				}
+			}
+			defer bhRelease()
+		}
+
 		operation := oper
 		if def.t > 0 {
 			// Handle timeout
@@ -117,9 +153,11 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 				ctx, cancel := context.WithTimeout(ctx, def.t)
 				defer cancel()
 
-				done := make(chan doneCh[T])
+				done := make(chan doneCh[T], 1)
 				timedOut := atomic.Bool{}
+				bhRefs.Add(1)
 				go func() {
+					defer bhRelease()
 					rRes, rErr := operCopy(ctx)
 					if !timedOut.Load() {
 						done <- doneCh[T]{rRes, rErr}
@@ -184,16 +222,39 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 			return operation(ctx)
 		}
 
+		// The retry budget is shared by all calls to the target, and each call increases the number of retries allowed
+		if def.rb != nil {
+			def.rb.RecordCall()
+		}
+
 		// Use retry/back off
 		b := def.r.NewBackOffWithContext(ctx)
+		var retries int64
 		return retry.NotifyRecoverWithData(
 			func() (T, error) {
 				rRes, rErr := operation(ctx)
+				// If the error doesn't match the retry policy, break out of retry and return the value as-is
+				var permanent *backoff.PermanentError
+				if rErr != nil && def.rm != nil && !errors.As(rErr, &permanent) && !def.rm.ShouldRetry(rErr) {
+					return rRes, backoff.Permanent(rErr)
+				}
 				// In case of an error, if we have a disposer we invoke it with the return value, then reset the return value
 				if rErr != nil && opts.Disposer != nil && !isZero(rRes) {
 					opts.Disposer(rRes)
 					rRes = zero
 				}
+				// If the operation is going to be retried but the retry budget of the target is exhausted, fail fast with the error
+				if rErr != nil && def.rb != nil && !errors.As(rErr, &permanent) && ctx.Err() == nil &&
+					(def.r.MaxRetries < 0 || retries < def.r.MaxRetries) {
+					if !def.rb.TryRetry() {
+						if def.addRetryBudgetExhaustedMetric != nil {
+							def.addRetryBudgetExhaustedMetric()
+						}
+						def.log.Debugf("Retry budget exhausted for operation %s, not retrying", def.name)
+						return rRes, backoff.Permanent(rErr)
+					}
+					retries++
+				}
 				return rRes, rErr
 			},
 			b,
@@ -22,8 +22,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -258,6 +260,62 @@ func TestPolicyRetry(t *testing.T) {
 	}
 }
 
+func TestPolicyRetryMatching(t *testing.T) {
+	rm, err := NewRetryMatching(&resiliencyV1alpha.RetryMatching{HTTPStatusCodes: "500-599"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		expected    int32
+		expectedRes int
+		disposed    int32
+	}{
+		{
+			name:        "Matching status code is retried",
+			statusCode:  503,
+			expected:    4,
+			expectedRes: 0,
+			disposed:    4,
+		},
+		{
+			name:        "Other status code is not retried",
+			statusCode:  404,
+			expected:    1,
+			expectedRes: 404,
+			disposed:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := atomic.Int32{}
+			disposerCalled := atomic.Int32{}
+			fn := func(ctx context.Context) (int, error) {
+				called.Add(1)
+				return test.statusCode, HTTPStatusError{StatusCode: test.statusCode}
+			}
+
+			policy := NewRunnerWithOptions(context.Background(), &PolicyDefinition{
+				log:  testLog,
+				name: "retry",
+				r:    &retry.Config{MaxRetries: 3},
+				rm:   rm,
+			}, RunnerOpts[int]{
+				Disposer: func(int) {
+					disposerCalled.Add(1)
+				},
+			})
+			res, err := policy(fn)
+			assert.Equal(t, HTTPStatusError{StatusCode: test.statusCode}, err)
+			assert.Equal(t, test.expectedRes, res)
+			assert.Equal(t, test.expected, called.Load())
+			// Responses of errors which are not retried are returned to the caller instead of being disposed
+			assert.Equal(t, test.disposed, disposerCalled.Load())
+		})
+	}
+}
+
 func TestPolicyAccumulator(t *testing.T) {
 	val := atomic.Int32{}
 	fnCalled := atomic.Int32{}
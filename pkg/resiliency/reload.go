@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/exp/maps"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+	"github.com/dapr/kit/logger"
+)
+
+// Ensure `*Reloadable` satisfies the `Provider` and `StatusProvider` interfaces.
+var (
+	_ = (Provider)((*Reloadable)(nil))
+	_ = (StatusProvider)((*Reloadable)(nil))
+)
+
+// Reloadable is a Provider whose resiliency configurations can be replaced at runtime.
+// Calls are delegated to the current `*Resiliency`, which is swapped atomically on reload.
+// Policies returned before a reload keep using the configuration they were created with.
+type Reloadable struct {
+	log     logger.Logger
+	current atomic.Pointer[Resiliency]
+}
+
+// NewReloadable creates a Reloadable provider which initially delegates to r.
+func NewReloadable(log logger.Logger, r *Resiliency) *Reloadable {
+	rl := &Reloadable{log: log}
+	rl.current.Store(r)
+	return rl
+}
+
+// Current returns the resiliency the calls are currently delegated to.
+func (rl *Reloadable) Current() *Resiliency {
+	return rl.current.Load()
+}
+
+// Reload decodes the configurations and swaps them in place of the current ones.
+// Circuit breakers whose definition and targets are unchanged keep their state, and so do the
+// bulkheads, rate limiters, hedging policies and retry budgets whose definition is unchanged.
+func (rl *Reloadable) Reload(c ...*resiliencyV1alpha.Resiliency) {
+	next := FromConfigurations(rl.log, c...)
+	prev := rl.current.Load()
+	next.inheritCircuitBreakers(prev)
+	next.inheritPolicyInstances(prev)
+	rl.current.Store(next)
+	rl.log.Infof("Reloaded %d resiliency configurations", len(c))
+}
+
+// EndpointPolicy returns the policy for a service endpoint.
+func (rl *Reloadable) EndpointPolicy(service string, endpoint string) *PolicyDefinition {
+	return rl.current.Load().EndpointPolicy(service, endpoint)
+}
+
+// ActorPreLockPolicy returns the policy for an actor instance to be used before an actor lock is acquired.
+func (rl *Reloadable) ActorPreLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPreLockPolicy(actorType, id)
+}
+
+// ActorPostLockPolicy returns the policy for an actor instance to be used after an actor lock is acquired.
+func (rl *Reloadable) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPostLockPolicy(actorType, id)
+}
+
+// ComponentOutboundPolicy returns the outbound policy for a component.
+func (rl *Reloadable) ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentOutboundPolicy(name, componentType)
+}
+
+// ComponentInboundPolicy returns the inbound policy for a component.
+func (rl *Reloadable) ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentInboundPolicy(name, componentType)
+}
+
+// EndpointHedgingPolicy returns the hedging policy for an app.
+func (rl *Reloadable) EndpointHedgingPolicy(app string) *HedgingPolicy {
+	return rl.current.Load().EndpointHedgingPolicy(app)
+}
+
+// EndpointFallbackPolicy returns the fallback policy for an app.
+func (rl *Reloadable) EndpointFallbackPolicy(app string) *FallbackPolicy {
+	return rl.current.Load().EndpointFallbackPolicy(app)
+}
+
+// ComponentFallbackPolicy returns the fallback policy for outbound calls to a component.
+func (rl *Reloadable) ComponentFallbackPolicy(name string, componentType ComponentType) *FallbackPolicy {
+	return rl.current.Load().ComponentFallbackPolicy(name, componentType)
+}
+
+// BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
+func (rl *Reloadable) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
+	return rl.current.Load().BuiltInPolicy(name)
+}
+
+// PolicyDefined returns true if there's policy that applies to the target.
+func (rl *Reloadable) PolicyDefined(target string, policyType PolicyType) bool {
+	return rl.current.Load().PolicyDefined(target, policyType)
+}
+
+// CircuitBreakerStatuses returns the status of the circuit breakers instantiated for targets.
+func (rl *Reloadable) CircuitBreakerStatuses(targetType, target string) []CircuitBreakerStatus {
+	return rl.current.Load().CircuitBreakerStatuses(targetType, target)
+}
+
+// ResolvedPolicies returns the names of the policies applied to a target.
+func (rl *Reloadable) ResolvedPolicies(targetType, target string, componentType ComponentType) (ResolvedPolicies, error) {
+	return rl.current.Load().ResolvedPolicies(targetType, target, componentType)
+}
+
+// ResetCircuitBreaker closes a circuit breaker and clears its counts.
+func (rl *Reloadable) ResetCircuitBreaker(targetType, target, name string) error {
+	return rl.current.Load().ResetCircuitBreaker(targetType, target, name)
+}
+
+// ForceOpenCircuitBreaker opens a circuit breaker until it is reset.
+func (rl *Reloadable) ForceOpenCircuitBreaker(targetType, target, name string) error {
+	return rl.current.Load().ForceOpenCircuitBreaker(targetType, target, name)
+}
+
+// inheritCircuitBreakers moves the circuit breakers instantiated by prev into r when they would be
+// created from the same, unchanged, circuit breaker policy.
+// It must be called before r is used.
+func (r *Resiliency) inheritCircuitBreakers(prev *Resiliency) {
+	if prev == nil {
+		return
+	}
+
+	prev.serviceCBsMu.RLock()
+	for app, cache := range prev.serviceCBs {
+		prevName, nextName := prev.endpointCircuitBreakerPolicy(app), r.endpointCircuitBreakerPolicy(app)
+		if prevName != "" && prevName == nextName && r.circuitBreakerUnchanged(prev, prevName) {
+			r.serviceCBs[app] = cache
+		}
+	}
+	prev.serviceCBsMu.RUnlock()
+
+	prev.actorCBsCachesMu.RLock()
+	for actorType, cache := range prev.actorCBCaches {
+		prevName, prevScope := prev.actorCircuitBreakerPolicy(actorType)
+		nextName, nextScope := r.actorCircuitBreakerPolicy(actorType)
+		if prevName != "" && prevName == nextName && prevScope == nextScope && r.circuitBreakerUnchanged(prev, prevName) {
+			r.actorCBCaches[actorType] = cache
+		}
+	}
+	prev.actorCBsCachesMu.RUnlock()
+
+	// Components on default policies resolve their circuit breaker policy from their type, which isn't known here,
+	// so their circuit breakers are kept only if the default circuit breaker policies are all unchanged.
+	defaultsUnchanged := r.defaultCircuitBreakersUnchanged(prev)
+	prev.componentCBs.RLock()
+	for name, cb := range prev.componentCBs.cbs {
+		policyName := strings.TrimSuffix(cb.Name, "-"+name)
+		if !r.circuitBreakerUnchanged(prev, policyName) {
+			continue
+		}
+		prevPolicies, prevOk := prev.components[name]
+		nextPolicies, nextOk := r.components[name]
+		switch {
+		case prevOk && nextOk:
+			if prevPolicies.Inbound.CircuitBreaker != nextPolicies.Inbound.CircuitBreaker ||
+				prevPolicies.Outbound.CircuitBreaker != nextPolicies.Outbound.CircuitBreaker {
+				continue
+			}
+		case !prevOk && !nextOk:
+			if !defaultsUnchanged {
+				continue
+			}
+		default:
+			continue
+		}
+		r.componentCBs.cbs[name] = cb
+	}
+	prev.componentCBs.RUnlock()
+}
+
+// inheritPolicyInstances moves the bulkheads, rate limiters, hedging policies and retry budgets instantiated
+// by prev into r when they would be created from the same, unchanged, policy.
+// In-flight calls hold on to the instances they acquired, so keeping them keeps the limits enforced across reloads.
+// It must be called before r is used.
+func (r *Resiliency) inheritPolicyInstances(prev *Resiliency) {
+	if prev == nil {
+		return
+	}
+
+	r.bulkheadInstances.inherit(prev.bulkheadInstances, func(name string) bool {
+		a, okA := prev.bulkheads[name]
+		b, okB := r.bulkheads[name]
+		return okA && okB && a.MaxConcurrentCalls == b.MaxConcurrentCalls && a.MaxQueueSize == b.MaxQueueSize
+	})
+	r.rateLimiterInstances.inherit(prev.rateLimiterInstances, func(name string) bool {
+		a, okA := prev.rateLimits[name]
+		b, okB := r.rateLimits[name]
+		return okA && okB && a.RequestsPerSecond == b.RequestsPerSecond && a.Burst == b.Burst
+	})
+	r.hedgingInstances.inherit(prev.hedgingInstances, func(name string) bool {
+		a, okA := prev.hedging[name]
+		b, okB := r.hedging[name]
+		return okA && okB && a.Delay == b.Delay && a.Percentile == b.Percentile && a.MaxAttempts == b.MaxAttempts &&
+			maps.Equal(a.verbs, b.verbs) && maps.Equal(a.methods, b.methods)
+	})
+	r.retryBudgetInstances.inherit(prev.retryBudgetInstances, func(name string) bool {
+		a, okA := prev.retryBudgets[name]
+		b, okB := r.retryBudgets[name]
+		return okA && okB && a.MaxRetryPercent == b.MaxRetryPercent && a.Window == b.Window && a.MinRetriesPerSecond == b.MinRetriesPerSecond
+	})
+}
+
+// endpointCircuitBreakerPolicy returns the name of the circuit breaker policy applied to an app.
+func (r *Resiliency) endpointCircuitBreakerPolicy(app string) string {
+	if policyNames, ok := r.apps[app]; ok {
+		return policyNames.CircuitBreaker
+	}
+	return r.getDefaultCircuitBreakerPolicy(EndpointPolicy{})
+}
+
+// actorCircuitBreakerPolicy returns the name and scope of the circuit breaker policy applied to an actor type.
+func (r *Resiliency) actorCircuitBreakerPolicy(actorType string) (string, ActorCircuitBreakerScope) {
+	if actorPolicies, ok := r.actors[actorType]; ok {
+		return actorPolicies.PreLockPolicies.CircuitBreaker, actorPolicies.PreLockPolicies.CircuitBreakerScope
+	}
+	// Circuit breakers from default policies are scoped to the actor type.
+	return r.getDefaultCircuitBreakerPolicy(ActorPolicy{}), ActorCircuitBreakerScopeType
+}
+
+// circuitBreakerUnchanged returns true if the circuit breaker policy has the same definition in r and prev.
+func (r *Resiliency) circuitBreakerUnchanged(prev *Resiliency, name string) bool {
+	a, okA := prev.circuitBreakers[name]
+	b, okB := r.circuitBreakers[name]
+	return okA && okB && sameCircuitBreaker(a, b)
+}
+
+// defaultCircuitBreakersUnchanged returns true if r and prev define the same default circuit breaker policies.
+func (r *Resiliency) defaultCircuitBreakersUnchanged(prev *Resiliency) bool {
+	isDefault := func(name string) bool {
+		return strings.HasPrefix(name, "Default") && strings.HasSuffix(name, "CircuitBreakerPolicy")
+	}
+	count := 0
+	for name := range prev.circuitBreakers {
+		if !isDefault(name) {
+			continue
+		}
+		if !r.circuitBreakerUnchanged(prev, name) {
+			return false
+		}
+		count++
+	}
+	for name := range r.circuitBreakers {
+		if isDefault(name) {
+			count--
+		}
+	}
+	return count == 0
+}
+
+func sameCircuitBreaker(a, b *breaker.CircuitBreaker) bool {
+	if a.MaxRequests != b.MaxRequests || a.Interval != b.Interval || a.Timeout != b.Timeout {
+		return false
+	}
+	if a.Trip == nil || b.Trip == nil {
+		return a.Trip == b.Trip
+	}
+	return a.Trip.Expr() == b.Trip.Expr()
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+func testReloadConfig(timeout, cbTimeout string) *resiliencyV1alpha.Resiliency {
+	return &resiliencyV1alpha.Resiliency{
+		TypeMeta: metav1.TypeMeta{Kind: "Resiliency", APIVersion: "dapr.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "resiliency",
+		},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{"fast": timeout},
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"cb": {Trip: "consecutiveFailures > 0", Timeout: cbTimeout},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Timeout: "fast", CircuitBreaker: "cb"},
+				},
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"actorA": {CircuitBreaker: "cb", CircuitBreakerScope: "type"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "cb"}},
+				},
+			},
+		},
+	}
+}
+
+func TestReloadable(t *testing.T) {
+	openAll := func(p Provider) {
+		for _, def := range []*PolicyDefinition{
+			p.EndpointPolicy("appA", "appA:method"),
+			p.ActorPreLockPolicy("actorA", "id"),
+			p.ComponentOutboundPolicy("statestore1", Statestore),
+		} {
+			require.NotNil(t, def.cb)
+			def.cb.ForceOpen()
+		}
+	}
+	states := func(p *Reloadable) []breaker.CircuitBreakerState {
+		res := []breaker.CircuitBreakerState{}
+		for _, s := range p.CircuitBreakerStatuses("", "") {
+			res = append(res, s.State)
+		}
+		return res
+	}
+
+	t.Run("policies are swapped", func(t *testing.T) {
+		p := NewReloadable(log, FromConfigurations(log, testReloadConfig("1s", "1m")))
+		assert.Equal(t, time.Second, p.EndpointPolicy("appA", "appA:method").t)
+
+		p.Reload(testReloadConfig("2s", "1m"))
+		assert.Equal(t, 2*time.Second, p.EndpointPolicy("appA", "appA:method").t)
+
+		p.Reload()
+		assert.Zero(t, p.EndpointPolicy("appA", "appA:method").t)
+	})
+
+	t.Run("circuit breakers keep their state when unchanged", func(t *testing.T) {
+		p := NewReloadable(log, FromConfigurations(log, testReloadConfig("1s", "1m")))
+		openAll(p)
+
+		p.Reload(testReloadConfig("2s", "1m"))
+		assert.Equal(t, []breaker.CircuitBreakerState{breaker.StateOpen, breaker.StateOpen, breaker.StateOpen}, states(p))
+		assert.Equal(t, breaker.StateOpen, p.EndpointPolicy("appA", "appA:method").cb.State())
+		assert.Equal(t, breaker.StateOpen, p.ComponentOutboundPolicy("statestore1", Statestore).cb.State())
+	})
+
+	t.Run("circuit breakers are reset when changed", func(t *testing.T) {
+		p := NewReloadable(log, FromConfigurations(log, testReloadConfig("1s", "1m")))
+		openAll(p)
+
+		p.Reload(testReloadConfig("1s", "2m"))
+		assert.Empty(t, states(p))
+		assert.Equal(t, breaker.StateClosed, p.EndpointPolicy("appA", "appA:method").cb.State())
+		assert.Equal(t, breaker.StateClosed, p.ActorPreLockPolicy("actorA", "id").cb.State())
+		assert.Equal(t, breaker.StateClosed, p.ComponentOutboundPolicy("statestore1", Statestore).cb.State())
+	})
+
+	t.Run("circuit breakers are reset when the target changes", func(t *testing.T) {
+		p := NewReloadable(log, FromConfigurations(log, testReloadConfig("1s", "1m")))
+		openAll(p)
+
+		config := testReloadConfig("1s", "1m")
+		config.Spec.Policies.CircuitBreakers["cb2"] = config.Spec.Policies.CircuitBreakers["cb"]
+		config.Spec.Targets.Apps["appA"] = resiliencyV1alpha.EndpointPolicyNames{CircuitBreaker: "cb2"}
+		p.Reload(config)
+		assert.Equal(t, breaker.StateClosed, p.EndpointPolicy("appA", "appA:method").cb.State())
+		assert.Equal(t, breaker.StateOpen, p.ActorPreLockPolicy("actorA", "id").cb.State())
+	})
+
+	t.Run("bulkheads, rate limiters, hedging policies and retry budgets keep their state when unchanged", func(t *testing.T) {
+		limitedConfig := func(maxConcurrentCalls int) *resiliencyV1alpha.Resiliency {
+			config := testReloadConfig("1s", "1m")
+			config.Spec.Policies.Retries = map[string]resiliencyV1alpha.Retry{
+				"retry": {Policy: "constant", Duration: "10ms", Budget: &resiliencyV1alpha.RetryBudget{MaxRetryPercent: 20}},
+			}
+			config.Spec.Policies.Bulkheads = map[string]resiliencyV1alpha.Bulkhead{"bh": {MaxConcurrentCalls: maxConcurrentCalls}}
+			config.Spec.Policies.RateLimits = map[string]resiliencyV1alpha.RateLimit{"rl": {RequestsPerSecond: 10}}
+			config.Spec.Policies.Hedging = map[string]resiliencyV1alpha.Hedging{"hedge": {Delay: "10ms", Verbs: []string{"GET"}}}
+			config.Spec.Targets.Apps["appA"] = resiliencyV1alpha.EndpointPolicyNames{
+				Retry: "retry", Bulkhead: "bh", RateLimit: "rl", Hedging: "hedge",
+			}
+			return config
+		}
+		p := NewReloadable(log, FromConfigurations(log, limitedConfig(1)))
+		def := p.EndpointPolicy("appA", "appA:method")
+		require.NotNil(t, def.bh)
+		require.NotNil(t, def.rl)
+		require.NotNil(t, def.rb)
+		hedging := p.EndpointHedgingPolicy("appA")
+		require.NotNil(t, hedging)
+
+		p.Reload(limitedConfig(1))
+		reloaded := p.EndpointPolicy("appA", "appA:method")
+		assert.Same(t, def.bh, reloaded.bh)
+		assert.Same(t, def.rl, reloaded.rl)
+		assert.Same(t, def.rb, reloaded.rb)
+		assert.Same(t, hedging, p.EndpointHedgingPolicy("appA"))
+
+		p.Reload(limitedConfig(2))
+		reloaded = p.EndpointPolicy("appA", "appA:method")
+		assert.NotSame(t, def.bh, reloaded.bh)
+		assert.Equal(t, 2, reloaded.bh.MaxConcurrentCalls)
+		assert.Same(t, def.rl, reloaded.rl)
+		assert.Same(t, def.rb, reloaded.rb)
+		assert.Same(t, hedging, p.EndpointHedgingPolicy("appA"))
+	})
+}
+
+func TestWatchLocalResiliency(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	changes := make(chan []*resiliencyV1alpha.Resiliency, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- WatchLocalResiliency(ctx, log, "app1", []string{dir, filepath.Join(dir, "missing")}, func(c []*resiliencyV1alpha.Resiliency) {
+			changes <- c
+		})
+	}()
+	// Wait for the watcher to start.
+	time.Sleep(100 * time.Millisecond)
+
+	waitChange := func(t *testing.T) []*resiliencyV1alpha.Resiliency {
+		t.Helper()
+		select {
+		case c := <-changes:
+			return c
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "resiliency configurations were not reloaded")
+			return nil
+		}
+	}
+
+	filePath := filepath.Join(dir, "resiliency.yaml")
+
+	t.Run("resiliency added", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filePath, []byte(`
+apiVersion: dapr.io/v1alpha1
+kind: Resiliency
+metadata:
+  name: resiliency
+spec:
+  policies:
+    timeouts:
+      fast: 1s
+  targets: {}
+`), 0o600))
+		c := waitChange(t)
+		require.Len(t, c, 1)
+		assert.Equal(t, "1s", c[0].Spec.Policies.Timeouts["fast"])
+	})
+
+	t.Run("resiliency removed", func(t *testing.T) {
+		require.NoError(t, os.Remove(filePath))
+		assert.Empty(t, waitChange(t))
+	})
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+// fakeResiliencyOperator is an operator client which sends the update events of its channel
+// and fails to list the resiliency configurations while listErr is set.
+type fakeResiliencyOperator struct {
+	operatorv1pb.OperatorClient
+	events    chan *operatorv1pb.ResiliencyUpdateEvent
+	listErr   atomic.Pointer[error]
+	listCalls atomic.Int64
+}
+
+func (o *fakeResiliencyOperator) ListResiliency(context.Context, *operatorv1pb.ListResiliencyRequest, ...grpc.CallOption) (*operatorv1pb.ListResiliencyResponse, error) {
+	defer o.listCalls.Add(1)
+	if err := o.listErr.Load(); err != nil {
+		return nil, *err
+	}
+	b, err := json.Marshal(testReloadConfig("1s", "1m"))
+	if err != nil {
+		return nil, err
+	}
+	return &operatorv1pb.ListResiliencyResponse{Resiliencies: [][]byte{b}}, nil
+}
+
+func (o *fakeResiliencyOperator) ResiliencyUpdate(ctx context.Context, _ *operatorv1pb.ResiliencyUpdateRequest, _ ...grpc.CallOption) (operatorv1pb.Operator_ResiliencyUpdateClient, error) { //nolint:nosnakecase
+	return &fakeResiliencyUpdateStream{ctx: ctx, events: o.events}, nil
+}
+
+type fakeResiliencyUpdateStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events chan *operatorv1pb.ResiliencyUpdateEvent
+}
+
+func (s *fakeResiliencyUpdateStream) Recv() (*operatorv1pb.ResiliencyUpdateEvent, error) {
+	select {
+	case e := <-s.events:
+		return e, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestWatchKubernetesResiliency(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &fakeResiliencyOperator{events: make(chan *operatorv1pb.ResiliencyUpdateEvent)}
+
+	p := NewReloadable(log, FromConfigurations(log))
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- WatchKubernetesResiliency(ctx, log, "app1", "default", "pod1", client, func(c []*resiliencyV1alpha.Resiliency) {
+			p.Reload(c...)
+		})
+	}()
+
+	t.Run("configurations are reloaded on updates", func(t *testing.T) {
+		client.events <- &operatorv1pb.ResiliencyUpdateEvent{}
+		assert.Eventually(t, func() bool {
+			return p.EndpointPolicy("appA", "appA:method").t == time.Second
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("configurations are kept when they can't be listed", func(t *testing.T) {
+		listErr := errors.New("operator unavailable")
+		client.listErr.Store(&listErr)
+		calls := client.listCalls.Load()
+		client.events <- &operatorv1pb.ResiliencyUpdateEvent{}
+		assert.Eventually(t, func() bool {
+			return client.listCalls.Load() > calls
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// Sending the next event guarantees that the previous one was processed.
+		client.events <- &operatorv1pb.ResiliencyUpdateEvent{}
+		assert.Equal(t, time.Second, p.EndpointPolicy("appA", "appA:method").t)
+	})
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
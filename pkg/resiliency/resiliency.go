@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -54,6 +55,8 @@ const (
 	DefaultRetryTemplate          DefaultPolicyTemplate = "Default%sRetryPolicy"
 	DefaultTimeoutTemplate        DefaultPolicyTemplate = "Default%sTimeoutPolicy"
 	DefaultCircuitBreakerTemplate DefaultPolicyTemplate = "Default%sCircuitBreakerPolicy"
+	DefaultBulkheadTemplate       DefaultPolicyTemplate = "Default%sBulkheadPolicy"
+	DefaultRateLimitTemplate      DefaultPolicyTemplate = "Default%sRateLimitPolicy"
 	Endpoint                      PolicyTypeName        = "App"
 	Component                     PolicyTypeName        = "Component"
 	Actor                         PolicyTypeName        = "Actor"
@@ -96,13 +99,19 @@ type (
 		ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition
 		// ComponentInboundPolicy returns the inbound policy for a component.
 		ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition
+		// EndpointHedgingPolicy returns the hedging policy for an app, or nil if requests to the app are not hedged.
+		EndpointHedgingPolicy(app string) *HedgingPolicy
+		// EndpointFallbackPolicy returns the fallback policy for an app, or nil if the app has no fallback.
+		EndpointFallbackPolicy(app string) *FallbackPolicy
+		// ComponentFallbackPolicy returns the fallback policy for outbound calls to a component, or nil if the component has no fallback.
+		ComponentFallbackPolicy(name string, componentType ComponentType) *FallbackPolicy
 		// BuiltInPolicy are used to replace existing retries in Dapr which may not bind specifically to one of the above categories.
 		BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition
 		// PolicyDefined returns true if there's policy that applies to the target.
 		PolicyDefined(target string, policyType PolicyType) (exists bool)
 	}
 
-	// Resiliency encapsulates configuration for timeouts, retries, and circuit breakers.
+	// Resiliency encapsulates configuration for timeouts, retries, circuit breakers, bulkheads, and rate limits.
 	// It maps services, actors, components, and routes to each of these configurations.
 	// Lastly, it maintains circuit breaker state across invocations.
 	Resiliency struct {
@@ -112,7 +121,13 @@ type (
 
 		timeouts        map[string]time.Duration
 		retries         map[string]*retry.Config
+		retryMatching   map[string]*RetryMatching
+		retryBudgets    map[string]*RetryBudget
 		circuitBreakers map[string]*breaker.CircuitBreaker
+		bulkheads       map[string]*Bulkhead
+		rateLimits      map[string]*RateLimiter
+		hedging         map[string]*HedgingPolicy
+		fallbacks       map[string]*FallbackPolicy
 
 		actorCBCaches    map[string]*lru.Cache[string, *breaker.CircuitBreaker]
 		actorCBsCachesMu sync.RWMutex
@@ -121,6 +136,11 @@ type (
 
 		componentCBs *circuitBreakerInstances
 
+		bulkheadInstances    *policyInstances[*Bulkhead]
+		rateLimiterInstances *policyInstances[*RateLimiter]
+		hedgingInstances     *policyInstances[*HedgingPolicy]
+		retryBudgetInstances *policyInstances[*RetryBudget]
+
 		apps       map[string]PolicyNames
 		actors     map[string]ActorPolicies
 		components map[string]ComponentPolicyNames
@@ -139,12 +159,16 @@ type (
 		Outbound PolicyNames
 	}
 
-	// PolicyNames contains the policy names for a timeout, retry, and circuit breaker.
-	// Empty values mean that no policy is configured.
+	// PolicyNames contains the policy names for a timeout, retry, circuit breaker, bulkhead, rate limit, hedging, and fallback.
+	// Empty values mean that no policy is configured. Hedging is only supported for apps, and fallbacks for apps and outbound component calls.
 	PolicyNames struct {
 		Timeout        string
 		Retry          string
 		CircuitBreaker string
+		Bulkhead       string
+		RateLimit      string
+		Hedging        string
+		Fallback       string
 	}
 
 	// Actors have different behavior before and after locking.
@@ -158,6 +182,8 @@ type (
 		Retry               string
 		CircuitBreaker      string
 		CircuitBreakerScope ActorCircuitBreakerScope
+		Bulkhead            string
+		RateLimit           string
 	}
 
 	// Policy used after an actor is locked. It only uses timeout as retry/circuit breaker is handled before locking.
@@ -252,18 +278,18 @@ func loadLocalResiliencyPath(log logger.Logger, runtimeID string, path string) [
 }
 
 // LoadKubernetesResiliency loads resiliency configurations from the Kubernetes operator.
-func LoadKubernetesResiliency(log logger.Logger, runtimeID, namespace string, operatorClient operatorv1pb.OperatorClient) []*resiliencyV1alpha.Resiliency {
+// It returns an error if the configurations couldn't be listed.
+func LoadKubernetesResiliency(log logger.Logger, runtimeID, namespace string, operatorClient operatorv1pb.OperatorClient) ([]*resiliencyV1alpha.Resiliency, error) {
 	resp, err := operatorClient.ListResiliency(context.Background(), &operatorv1pb.ListResiliencyRequest{
 		Namespace: namespace,
 	}, grpcRetry.WithMax(operatorRetryCount), grpcRetry.WithPerRetryTimeout(operatorTimePerRetry))
 	if err != nil {
-		log.Errorf("Error listing resiliency policies: %v", err)
-		return nil
+		return nil, fmt.Errorf("error listing resiliency policies: %w", err)
 	}
 
 	if resp.GetResiliencies() == nil {
 		log.Debug("No resiliency policies found")
-		return nil
+		return nil, nil
 	}
 
 	configs := make([]*resiliencyV1alpha.Resiliency, 0, len(resp.GetResiliencies()))
@@ -278,7 +304,7 @@ func LoadKubernetesResiliency(log logger.Logger, runtimeID, namespace string, op
 		configs = append(configs, &resiliency)
 	}
 
-	return filterResiliencyConfigs(configs, runtimeID)
+	return filterResiliencyConfigs(configs, runtimeID), nil
 }
 
 // FromConfigurations creates a resiliency provider and decodes the configurations from `c`.
@@ -306,12 +332,30 @@ func New(log logger.Logger) *Resiliency {
 		log:             log,
 		timeouts:        make(map[string]time.Duration),
 		retries:         make(map[string]*retry.Config),
+		retryMatching:   make(map[string]*RetryMatching),
+		retryBudgets:    make(map[string]*RetryBudget),
 		circuitBreakers: make(map[string]*breaker.CircuitBreaker),
+		bulkheads:       make(map[string]*Bulkhead),
+		rateLimits:      make(map[string]*RateLimiter),
+		hedging:         make(map[string]*HedgingPolicy),
+		fallbacks:       make(map[string]*FallbackPolicy),
 		actorCBCaches:   make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		serviceCBs:      make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		componentCBs: &circuitBreakerInstances{
 			cbs: make(map[string]*breaker.CircuitBreaker, 10),
 		},
+		bulkheadInstances: &policyInstances[*Bulkhead]{
+			instances: make(map[policyInstanceKey]*Bulkhead),
+		},
+		rateLimiterInstances: &policyInstances[*RateLimiter]{
+			instances: make(map[policyInstanceKey]*RateLimiter),
+		},
+		hedgingInstances: &policyInstances[*HedgingPolicy]{
+			instances: make(map[policyInstanceKey]*HedgingPolicy),
+		},
+		retryBudgetInstances: &policyInstances[*RetryBudget]{
+			instances: make(map[policyInstanceKey]*RetryBudget),
+		},
 		apps:       make(map[string]PolicyNames),
 		actors:     make(map[string]ActorPolicies),
 		components: make(map[string]ComponentPolicyNames),
@@ -406,6 +450,14 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 		if err = retry.DecodeConfig(&rc, m); err != nil {
 			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
 		}
+		rm, err := NewRetryMatching(t.Matching)
+		if err != nil {
+			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
+		}
+		rb, err := decodeRetryBudget(name, t.Budget)
+		if err != nil {
+			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
+		}
 
 		if !r.isProtectedPolicy(name) {
 			if r.isBuiltInPolicy(name) && rc.MaxRetries < 3 {
@@ -414,6 +466,12 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 			}
 
 			r.retries[name] = &rc
+			r.retryMatching[name] = rm
+			if rb != nil {
+				r.retryBudgets[name] = rb
+			} else {
+				delete(r.retryBudgets, name)
+			}
 		} else {
 			r.log.Warnf("Attempted to override protected policy %s which is not allowed. Ignoring provided policy and using default.", name)
 		}
@@ -433,6 +491,49 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 		r.circuitBreakers[name] = &cb
 	}
 
+	for name, b := range policies.Bulkheads {
+		if b.MaxConcurrentCalls <= 0 {
+			return fmt.Errorf("invalid bulkhead configuration %q: maxConcurrentCalls must be greater than 0", name)
+		}
+		if b.MaxQueueSize < 0 {
+			return fmt.Errorf("invalid bulkhead configuration %q: maxQueueSize must not be negative", name)
+		}
+		r.bulkheads[name] = &Bulkhead{
+			Name:               name,
+			MaxConcurrentCalls: b.MaxConcurrentCalls,
+			MaxQueueSize:       b.MaxQueueSize,
+		}
+	}
+
+	for name, l := range policies.RateLimits {
+		if l.RequestsPerSecond <= 0 {
+			return fmt.Errorf("invalid rate limit configuration %q: requestsPerSecond must be greater than 0", name)
+		}
+		if l.Burst < 0 {
+			return fmt.Errorf("invalid rate limit configuration %q: burst must not be negative", name)
+		}
+		if l.Burst == 0 {
+			l.Burst = l.RequestsPerSecond
+		}
+		r.rateLimits[name] = &RateLimiter{
+			Name:              name,
+			RequestsPerSecond: l.RequestsPerSecond,
+			Burst:             l.Burst,
+		}
+	}
+
+	for name, h := range policies.Hedging {
+		if r.hedging[name], err = newHedgingPolicyTemplate(name, h); err != nil {
+			return fmt.Errorf("invalid hedging configuration %q: %w", name, err)
+		}
+	}
+
+	for name, f := range policies.Fallbacks {
+		if r.fallbacks[name], err = newFallbackPolicy(name, f); err != nil {
+			return fmt.Errorf("invalid fallback configuration %q: %w", name, err)
+		}
+	}
+
 	return nil
 }
 
@@ -444,6 +545,13 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 			Timeout:        t.Timeout,
 			Retry:          t.Retry,
 			CircuitBreaker: t.CircuitBreaker,
+			Bulkhead:       t.Bulkhead,
+			RateLimit:      t.RateLimit,
+			Hedging:        t.Hedging,
+			Fallback:       t.Fallback,
+		}
+		if fp, ok := r.fallbacks[t.Fallback]; ok && fp.Component != "" {
+			return fmt.Errorf("fallback %q of app %q cannot use a component", t.Fallback, name)
 		}
 		if t.CircuitBreakerCacheSize == 0 {
 			t.CircuitBreakerCacheSize = defaultEndpointCacheSize
@@ -470,6 +578,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 					Retry:               t.Retry,
 					CircuitBreaker:      t.CircuitBreaker,
 					CircuitBreakerScope: scope,
+					Bulkhead:            t.Bulkhead,
+					RateLimit:           t.RateLimit,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -480,6 +590,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 				PreLockPolicies: ActorPreLockPolicyNames{
 					Retry:          t.Retry,
 					CircuitBreaker: "",
+					Bulkhead:       t.Bulkhead,
+					RateLimit:      t.RateLimit,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -497,20 +609,78 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 	}
 
 	for name, t := range targets.Components {
+		if t.Inbound.Fallback != "" {
+			return fmt.Errorf("fallbacks are not supported for inbound calls of component %q", name)
+		}
+		if fp, ok := r.fallbacks[t.Outbound.Fallback]; ok && fp.Component == "" {
+			return fmt.Errorf("fallback %q of component %q must use a component", t.Outbound.Fallback, name)
+		}
 		r.components[name] = ComponentPolicyNames{
 			Inbound: PolicyNames{
 				Timeout:        t.Inbound.Timeout,
 				Retry:          t.Inbound.Retry,
 				CircuitBreaker: t.Inbound.CircuitBreaker,
+				Bulkhead:       t.Inbound.Bulkhead,
+				RateLimit:      t.Inbound.RateLimit,
 			},
 			Outbound: PolicyNames{
 				Timeout:        t.Outbound.Timeout,
 				Retry:          t.Outbound.Retry,
 				CircuitBreaker: t.Outbound.CircuitBreaker,
+				Bulkhead:       t.Outbound.Bulkhead,
+				RateLimit:      t.Outbound.RateLimit,
+				Fallback:       t.Outbound.Fallback,
 			},
 		}
 	}
 
+	return r.checkFallbackLoops()
+}
+
+// checkFallbackLoops returns an error if the fallback of an app or component leads back to it, like app A falling back
+// to app B which falls back to app A, so failing calls can't be sent back and forth between the targets.
+func (r *Resiliency) checkFallbackLoops() error {
+	nextApp := func(app string) string {
+		if fp := r.EndpointFallbackPolicy(app); fp != nil {
+			return fp.AppID
+		}
+		return ""
+	}
+	for app := range r.apps {
+		if loop := findFallbackLoop(app, nextApp); loop != nil {
+			return fmt.Errorf("fallbacks of apps form a loop: %s", strings.Join(loop, " -> "))
+		}
+	}
+
+	nextComponent := func(name string) string {
+		if fp := r.ComponentFallbackPolicy(name, ""); fp != nil {
+			return fp.Component
+		}
+		return ""
+	}
+	for name := range r.components {
+		if loop := findFallbackLoop(name, nextComponent); loop != nil {
+			return fmt.Errorf("fallbacks of components form a loop: %s", strings.Join(loop, " -> "))
+		}
+	}
+	return nil
+}
+
+// findFallbackLoop follows the fallbacks from start and returns the targets on the way if they lead back to start.
+func findFallbackLoop(start string, next func(string) string) []string {
+	path := []string{start}
+	visited := map[string]struct{}{start: {}}
+	for target := next(start); target != ""; target = next(target) {
+		path = append(path, target)
+		if target == start {
+			return path
+		}
+		if _, ok := visited[target]; ok {
+			// The loop doesn't include start, and is found from one of its targets
+			return nil
+		}
+		visited[target] = struct{}{}
+	}
 	return nil
 }
 
@@ -554,12 +724,30 @@ func (r *Resiliency) addMetricsToPolicy(policyDef *PolicyDefinition, target stri
 			diag.DefaultResiliencyMonitoring.PolicyActivated(r.name, r.namespace, diag.RetryPolicy, direction, target)
 		}
 	}
+	if policyDef.rb != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.RetryBudgetPolicy, direction, target)
+		policyDef.addRetryBudgetExhaustedMetric = func() {
+			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.RetryBudgetPolicy, direction, target, "exhausted")
+		}
+	}
 	if policyDef.cb != nil {
 		diag.DefaultResiliencyMonitoring.PolicyWithStatusExecuted(r.name, r.namespace, diag.CircuitBreakerPolicy, direction, target, string(policyDef.cb.State()))
 		policyDef.addCBStateChangedMetric = func() {
 			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.CircuitBreakerPolicy, direction, target, string(policyDef.cb.State()))
 		}
 	}
+	if policyDef.bh != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.BulkheadPolicy, direction, target)
+		policyDef.addBulkheadRejectedMetric = func() {
+			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.BulkheadPolicy, direction, target, "rejected")
+		}
+	}
+	if policyDef.rl != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.RateLimitPolicy, direction, target)
+		policyDef.addRateLimitRejectedMetric = func() {
+			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.RateLimitPolicy, direction, target, "rejected")
+		}
+	}
 }
 
 // EndpointPolicy returns the policy for a service endpoint.
@@ -575,7 +763,7 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 			policyDef.t = r.timeouts[policyNames.Timeout]
 		}
 		if policyNames.Retry != "" {
-			policyDef.r = r.retries[policyNames.Retry]
+			r.setRetryPolicy(policyDef, policyNames.Retry, diag.ResiliencyAppTarget(app))
 		}
 		if policyNames.CircuitBreaker != "" {
 			template, ok := r.circuitBreakers[policyNames.CircuitBreaker]
@@ -590,11 +778,12 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 				}
 			}
 		}
+		r.setLimiterPolicies(policyDef, policyNames.Bulkhead, policyNames.RateLimit, diag.ResiliencyAppTarget(app))
 	} else {
 		if defaultNames, ok := r.getDefaultPolicy(EndpointPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Endpoint %s: %+v", app, defaultNames)
 			if defaultNames.Retry != "" {
-				policyDef.r = r.retries[defaultNames.Retry]
+				r.setRetryPolicy(policyDef, defaultNames.Retry, diag.ResiliencyAppTarget(app))
 			}
 			if defaultNames.Timeout != "" {
 				policyDef.t = r.timeouts[defaultNames.Timeout]
@@ -610,6 +799,7 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 					policyDef.cb = r.getCBFromCache(serviceCBCache, endpoint, template)
 				}
 			}
+			r.setLimiterPolicies(policyDef, defaultNames.Bulkhead, defaultNames.RateLimit, diag.ResiliencyAppTarget(app))
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyAppTarget(app), diag.OutboundPolicyFlowDirection)
@@ -709,7 +899,7 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 	if policyNames := actorPolicies.PreLockPolicies; ok {
 		r.log.Debugf("Found Actor Policy for type %s: %+v", actorType, policyNames)
 		if policyNames.Retry != "" {
-			policyDef.r = r.retries[policyNames.Retry]
+			r.setRetryPolicy(policyDef, policyNames.Retry, diag.ResiliencyActorTarget(actorType))
 		}
 		if policyNames.CircuitBreaker != "" {
 			template, ok := r.circuitBreakers[policyNames.CircuitBreaker]
@@ -731,11 +921,12 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 				}
 			}
 		}
+		r.setLimiterPolicies(policyDef, policyNames.Bulkhead, policyNames.RateLimit, diag.ResiliencyActorTarget(actorType))
 	} else {
 		if defaultNames, ok := r.getDefaultPolicy(ActorPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Actor type %s: %+v", actorType, defaultNames)
 			if defaultNames.Retry != "" {
-				policyDef.r = r.retries[defaultNames.Retry]
+				r.setRetryPolicy(policyDef, defaultNames.Retry, diag.ResiliencyActorTarget(actorType))
 			}
 			if defaultNames.CircuitBreaker != "" {
 				template, ok := r.circuitBreakers[defaultNames.CircuitBreaker]
@@ -747,6 +938,7 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 					policyDef.cb = r.getCBFromCache(actorCBCache, actorType, template)
 				}
 			}
+			r.setLimiterPolicies(policyDef, defaultNames.Bulkhead, defaultNames.RateLimit, diag.ResiliencyActorTarget(actorType))
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
@@ -792,12 +984,13 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 			policyDef.t = r.timeouts[componentPolicies.Outbound.Timeout]
 		}
 		if componentPolicies.Outbound.Retry != "" {
-			policyDef.r = r.retries[componentPolicies.Outbound.Retry]
+			r.setRetryPolicy(policyDef, componentPolicies.Outbound.Retry, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Outbound))
 		}
 		if componentPolicies.Outbound.CircuitBreaker != "" {
 			template := r.circuitBreakers[componentPolicies.Outbound.CircuitBreaker]
 			policyDef.cb = r.componentCBs.Get(r.log, name, template)
 		}
+		r.setLimiterPolicies(policyDef, componentPolicies.Outbound.Bulkhead, componentPolicies.Outbound.RateLimit, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Outbound))
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: "Outbound"}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
@@ -805,12 +998,13 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 				policyDef.t = r.timeouts[defaultPolicies.Timeout]
 			}
 			if defaultPolicies.Retry != "" {
-				policyDef.r = r.retries[defaultPolicies.Retry]
+				r.setRetryPolicy(policyDef, defaultPolicies.Retry, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Outbound))
 			}
 			if defaultPolicies.CircuitBreaker != "" {
 				template := r.circuitBreakers[defaultPolicies.CircuitBreaker]
 				policyDef.cb = r.componentCBs.Get(r.log, name, template)
 			}
+			r.setLimiterPolicies(policyDef, defaultPolicies.Bulkhead, defaultPolicies.RateLimit, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Outbound))
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.OutboundPolicyFlowDirection)
@@ -831,12 +1025,13 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 			policyDef.t = r.timeouts[componentPolicies.Inbound.Timeout]
 		}
 		if componentPolicies.Inbound.Retry != "" {
-			policyDef.r = r.retries[componentPolicies.Inbound.Retry]
+			r.setRetryPolicy(policyDef, componentPolicies.Inbound.Retry, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Inbound))
 		}
 		if componentPolicies.Inbound.CircuitBreaker != "" {
 			template := r.circuitBreakers[componentPolicies.Inbound.CircuitBreaker]
 			policyDef.cb = r.componentCBs.Get(r.log, name, template)
 		}
+		r.setLimiterPolicies(policyDef, componentPolicies.Inbound.Bulkhead, componentPolicies.Inbound.RateLimit, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Inbound))
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: Inbound}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
@@ -844,12 +1039,13 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 				policyDef.t = r.timeouts[defaultPolicies.Timeout]
 			}
 			if defaultPolicies.Retry != "" {
-				policyDef.r = r.retries[defaultPolicies.Retry]
+				r.setRetryPolicy(policyDef, defaultPolicies.Retry, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Inbound))
 			}
 			if defaultPolicies.CircuitBreaker != "" {
 				template := r.circuitBreakers[defaultPolicies.CircuitBreaker]
 				policyDef.cb = r.componentCBs.Get(r.log, name, template)
 			}
+			r.setLimiterPolicies(policyDef, defaultPolicies.Bulkhead, defaultPolicies.RateLimit, diag.ResiliencyComponentTarget(name, string(componentType))+"-"+string(Inbound))
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.InboundPolicyFlowDirection)
@@ -857,6 +1053,40 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 	return policyDef
 }
 
+// EndpointHedgingPolicy returns the hedging policy for an app, or nil if requests to the app are not hedged.
+// The latencies used to compute percentile-based delays are shared by all requests to the app.
+func (r *Resiliency) EndpointHedgingPolicy(app string) *HedgingPolicy {
+	policyNames, ok := r.apps[app]
+	if !ok || policyNames.Hedging == "" {
+		return nil
+	}
+	template, ok := r.hedging[policyNames.Hedging]
+	if !ok {
+		return nil
+	}
+	return r.hedgingInstances.Get(policyNames.Hedging, diag.ResiliencyAppTarget(app), func() *HedgingPolicy {
+		return newHedgingPolicy(policyNames.Hedging+"-"+app, template)
+	})
+}
+
+// EndpointFallbackPolicy returns the fallback policy for an app, or nil if the app has no fallback.
+func (r *Resiliency) EndpointFallbackPolicy(app string) *FallbackPolicy {
+	policyNames, ok := r.apps[app]
+	if !ok || policyNames.Fallback == "" {
+		return nil
+	}
+	return r.fallbacks[policyNames.Fallback]
+}
+
+// ComponentFallbackPolicy returns the fallback policy for outbound calls to a component, or nil if the component has no fallback.
+func (r *Resiliency) ComponentFallbackPolicy(name string, componentType ComponentType) *FallbackPolicy {
+	componentPolicies, ok := r.components[name]
+	if !ok || componentPolicies.Outbound.Fallback == "" {
+		return nil
+	}
+	return r.fallbacks[componentPolicies.Outbound.Fallback]
+}
+
 // BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
 func (r *Resiliency) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 	nameStr := string(name)
@@ -864,6 +1094,42 @@ func (r *Resiliency) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 		log:  r.log,
 		name: nameStr,
 		r:    r.retries[nameStr],
+		rm:   r.retryMatching[nameStr],
+	}
+}
+
+// setLimiterPolicies sets the bulkhead and rate limiter of the target on the policy definition.
+// Their state is shared by all calls to the same target.
+func (r *Resiliency) setLimiterPolicies(policyDef *PolicyDefinition, bulkheadName, rateLimitName, target string) {
+	if bulkheadName != "" {
+		if template, ok := r.bulkheads[bulkheadName]; ok {
+			policyDef.bh = r.bulkheadInstances.Get(bulkheadName, target, func() *Bulkhead {
+				return newBulkhead(bulkheadName+"-"+target, template)
+			})
+		}
+	}
+	if rateLimitName != "" {
+		if template, ok := r.rateLimits[rateLimitName]; ok {
+			policyDef.rl = r.rateLimiterInstances.Get(rateLimitName, target, func() *RateLimiter {
+				return newRateLimiter(rateLimitName+"-"+target, template)
+			})
+		}
+	}
+}
+
+// retryPolicy returns the retry configuration and matching rules of the retry policy with the given name.
+func (r *Resiliency) retryPolicy(name string) (*retry.Config, *RetryMatching) {
+	return r.retries[name], r.retryMatching[name]
+}
+
+// setRetryPolicy sets the retry policy with the given name on the policy definition, together with the retry budget of the target if the policy has one.
+// The state of the retry budget is shared by all calls to the same target.
+func (r *Resiliency) setRetryPolicy(policyDef *PolicyDefinition, name, target string) {
+	policyDef.r, policyDef.rm = r.retryPolicy(name)
+	if template, ok := r.retryBudgets[name]; ok && policyDef.r != nil {
+		policyDef.rb = r.retryBudgetInstances.Get(name, target, func() *RetryBudget {
+			return newRetryBudget(name+"-"+target, template)
+		})
 	}
 }
 
@@ -885,9 +1151,12 @@ func (r *Resiliency) getDefaultPolicy(policyType PolicyType) (PolicyNames, bool)
 		Retry:          r.getDefaultRetryPolicy(policyType),
 		Timeout:        r.getDefaultTimeoutPolicy(policyType),
 		CircuitBreaker: r.getDefaultCircuitBreakerPolicy(policyType),
+		Bulkhead:       r.getDefaultBulkheadPolicy(policyType),
+		RateLimit:      r.getDefaultRateLimitPolicy(policyType),
 	}
 
-	return policyNames, (policyNames.Retry != "" || policyNames.Timeout != "" || policyNames.CircuitBreaker != "")
+	return policyNames, (policyNames.Retry != "" || policyNames.Timeout != "" || policyNames.CircuitBreaker != "" ||
+		policyNames.Bulkhead != "" || policyNames.RateLimit != "")
 }
 
 func (r *Resiliency) getDefaultRetryPolicy(policyType PolicyType) string {
@@ -932,6 +1201,34 @@ func (r *Resiliency) getDefaultCircuitBreakerPolicy(policyType PolicyType) strin
 	return ""
 }
 
+func (r *Resiliency) getDefaultBulkheadPolicy(policyType PolicyType) string {
+	typeTemplates, topLevelTemplate := r.expandPolicyTemplate(policyType, DefaultBulkheadTemplate)
+	for _, typeTemplate := range typeTemplates {
+		if _, ok := r.bulkheads[typeTemplate]; ok {
+			return typeTemplate
+		}
+	}
+
+	if _, ok := r.bulkheads[topLevelTemplate]; ok {
+		return topLevelTemplate
+	}
+	return ""
+}
+
+func (r *Resiliency) getDefaultRateLimitPolicy(policyType PolicyType) string {
+	typeTemplates, topLevelTemplate := r.expandPolicyTemplate(policyType, DefaultRateLimitTemplate)
+	for _, typeTemplate := range typeTemplates {
+		if _, ok := r.rateLimits[typeTemplate]; ok {
+			return typeTemplate
+		}
+	}
+
+	if _, ok := r.rateLimits[topLevelTemplate]; ok {
+		return topLevelTemplate
+	}
+	return ""
+}
+
 func (r *Resiliency) expandPolicyTemplate(policyType PolicyType, template DefaultPolicyTemplate) ([]string, string) {
 	policyLevels := policyType.getPolicyLevels()
 	typeTemplates := make([]string, len(policyLevels))
@@ -21,6 +21,7 @@ import (
 	"net"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -28,11 +29,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/ptr"
 )
@@ -237,8 +241,9 @@ func TestLoadKubernetesResiliency(t *testing.T) {
 
 	time.Sleep(time.Second * 1)
 
-	resiliency := LoadKubernetesResiliency(log, "default", "default",
+	resiliency, err := LoadKubernetesResiliency(log, "default", "default",
 		getOperatorClient(fmt.Sprintf("localhost:%d", port)))
+	require.NoError(t, err)
 	assert.NotNil(t, resiliency)
 	assert.Len(t, resiliency, 1)
 	assert.Equal(t, "Resiliency", resiliency[0].TypeMeta.Kind)
@@ -340,7 +345,8 @@ func TestResiliencyScopeIsRespected(t *testing.T) {
 	resiliencies := LoadLocalResiliency(log, "app1", "./testdata")
 	assert.Len(t, resiliencies, 2)
 
-	resiliencies = LoadKubernetesResiliency(log, "app2", "default", getOperatorClient(fmt.Sprintf("localhost:%d", port)))
+	resiliencies, err = LoadKubernetesResiliency(log, "app2", "default", getOperatorClient(fmt.Sprintf("localhost:%d", port)))
+	require.NoError(t, err)
 	assert.Len(t, resiliencies, 2)
 
 	resiliencies = LoadLocalResiliency(log, "app2", "./testdata")
@@ -703,3 +709,409 @@ func concurrentPolicyExec(t *testing.T, policyDefFn func(idx int) *PolicyDefinit
 	}
 	wg.Wait()
 }
+
+func TestLimiterPoliciesForTargets(t *testing.T) {
+	config := &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Bulkheads: map[string]resiliencyV1alpha.Bulkhead{
+					"bh": {MaxConcurrentCalls: 2, MaxQueueSize: 5},
+				},
+				RateLimits: map[string]resiliencyV1alpha.RateLimit{
+					"rl": {RequestsPerSecond: 10},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Bulkhead: "bh", RateLimit: "rl"},
+				},
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"myActorType": {Bulkhead: "bh"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {
+						Outbound: resiliencyV1alpha.PolicyNames{RateLimit: "rl"},
+					},
+				},
+			},
+		},
+	}
+	r := FromConfigurations(log, config)
+
+	t.Run("decoded policies", func(t *testing.T) {
+		require.Contains(t, r.bulkheads, "bh")
+		assert.Equal(t, 2, r.bulkheads["bh"].MaxConcurrentCalls)
+		assert.Equal(t, 5, r.bulkheads["bh"].MaxQueueSize)
+		require.Contains(t, r.rateLimits, "rl")
+		// Burst defaults to the rate
+		assert.Equal(t, 10, r.rateLimits["rl"].Burst)
+	})
+
+	t.Run("endpoint policies share the state of the app", func(t *testing.T) {
+		p1 := r.EndpointPolicy("appA", "a")
+		p2 := r.EndpointPolicy("appA", "b")
+		require.NotNil(t, p1.bh)
+		require.NotNil(t, p1.rl)
+		assert.Same(t, p1.bh, p2.bh)
+		assert.Same(t, p1.rl, p2.rl)
+	})
+
+	t.Run("actor policies", func(t *testing.T) {
+		p := r.ActorPreLockPolicy("myActorType", "id")
+		require.NotNil(t, p.bh)
+		assert.Nil(t, p.rl)
+		assert.NotSame(t, r.EndpointPolicy("appA", "a").bh, p.bh)
+	})
+
+	t.Run("component policies", func(t *testing.T) {
+		p := r.ComponentOutboundPolicy("statestore1", Statestore)
+		require.NotNil(t, p.rl)
+		assert.Nil(t, p.bh)
+		assert.Nil(t, r.ComponentInboundPolicy("statestore1", Statestore).rl)
+	})
+
+	t.Run("default policies", func(t *testing.T) {
+		r := FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+			Spec: resiliencyV1alpha.ResiliencySpec{
+				Policies: resiliencyV1alpha.Policies{
+					Bulkheads: map[string]resiliencyV1alpha.Bulkhead{
+						fmt.Sprintf(string(DefaultBulkheadTemplate), ""):      {MaxConcurrentCalls: 2},
+						fmt.Sprintf(string(DefaultBulkheadTemplate), "Actor"): {MaxConcurrentCalls: 1},
+					},
+					RateLimits: map[string]resiliencyV1alpha.RateLimit{
+						fmt.Sprintf(string(DefaultRateLimitTemplate), "ComponentOutbound"): {RequestsPerSecond: 10},
+					},
+				},
+			},
+		})
+
+		p1 := r.EndpointPolicy("appA", "a")
+		require.NotNil(t, p1.bh)
+		assert.Equal(t, 2, p1.bh.MaxConcurrentCalls)
+		assert.Nil(t, p1.rl)
+		assert.Same(t, p1.bh, r.EndpointPolicy("appA", "b").bh)
+		assert.NotSame(t, p1.bh, r.EndpointPolicy("appB", "a").bh, "each target has its own bulkhead")
+
+		actor := r.ActorPreLockPolicy("myActorType", "id")
+		require.NotNil(t, actor.bh)
+		assert.Equal(t, 1, actor.bh.MaxConcurrentCalls)
+
+		outbound := r.ComponentOutboundPolicy("statestore1", Statestore)
+		require.NotNil(t, outbound.rl)
+		require.NotNil(t, outbound.bh)
+		assert.Nil(t, r.ComponentInboundPolicy("statestore1", Statestore).rl)
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		invalid := []resiliencyV1alpha.Policies{
+			{Bulkheads: map[string]resiliencyV1alpha.Bulkhead{"bh": {MaxConcurrentCalls: 0}}},
+			{Bulkheads: map[string]resiliencyV1alpha.Bulkhead{"bh": {MaxConcurrentCalls: 1, MaxQueueSize: -1}}},
+			{RateLimits: map[string]resiliencyV1alpha.RateLimit{"rl": {RequestsPerSecond: 0}}},
+			{RateLimits: map[string]resiliencyV1alpha.RateLimit{"rl": {RequestsPerSecond: 1, Burst: -1}}},
+		}
+		for _, policies := range invalid {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{Policies: policies},
+			})
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestRetryBudgetPoliciesForTargets(t *testing.T) {
+	minRetries := 0
+	config := &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"budgeted": {
+						Policy: "constant",
+						Budget: &resiliencyV1alpha.RetryBudget{MaxRetryPercent: 20},
+					},
+					"budgetedMin": {
+						Policy: "constant",
+						Budget: &resiliencyV1alpha.RetryBudget{MaxRetryPercent: 10, Window: "1m", MinRetriesPerSecond: &minRetries},
+					},
+					"unbudgeted": {
+						Policy: "constant",
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Retry: "budgeted"},
+					"appB": {Retry: "budgeted"},
+					"appC": {Retry: "unbudgeted"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {
+						Outbound: resiliencyV1alpha.PolicyNames{Retry: "budgetedMin"},
+						Inbound:  resiliencyV1alpha.PolicyNames{Retry: "budgetedMin"},
+					},
+				},
+			},
+		},
+	}
+	r := FromConfigurations(log, config)
+
+	t.Run("decoded policies", func(t *testing.T) {
+		require.Contains(t, r.retryBudgets, "budgeted")
+		assert.Equal(t, 20, r.retryBudgets["budgeted"].MaxRetryPercent)
+		assert.Equal(t, defaultRetryBudgetWindow, r.retryBudgets["budgeted"].Window)
+		assert.Equal(t, defaultRetryBudgetMinRetriesPerSecond, r.retryBudgets["budgeted"].MinRetriesPerSecond)
+		require.Contains(t, r.retryBudgets, "budgetedMin")
+		assert.Equal(t, time.Minute, r.retryBudgets["budgetedMin"].Window)
+		assert.Equal(t, 0, r.retryBudgets["budgetedMin"].MinRetriesPerSecond)
+		assert.NotContains(t, r.retryBudgets, "unbudgeted")
+	})
+
+	t.Run("budgets are shared by the calls to a target", func(t *testing.T) {
+		p1 := r.EndpointPolicy("appA", "a")
+		p2 := r.EndpointPolicy("appA", "b")
+		require.NotNil(t, p1.rb)
+		assert.Same(t, p1.rb, p2.rb)
+		assert.NotSame(t, p1.rb, r.EndpointPolicy("appB", "a").rb)
+		assert.Nil(t, r.EndpointPolicy("appC", "a").rb)
+	})
+
+	t.Run("component directions have separate budgets", func(t *testing.T) {
+		out := r.ComponentOutboundPolicy("statestore1", Statestore)
+		in := r.ComponentInboundPolicy("statestore1", Statestore)
+		require.NotNil(t, out.rb)
+		require.NotNil(t, in.rb)
+		assert.NotSame(t, out.rb, in.rb)
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		negative := -1
+		invalid := []*resiliencyV1alpha.RetryBudget{
+			{MaxRetryPercent: -1},
+			{MaxRetryPercent: 20, Window: "foo"},
+			{MaxRetryPercent: 20, Window: "-1s"},
+			{MaxRetryPercent: 20, MinRetriesPerSecond: &negative},
+		}
+		for _, budget := range invalid {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{Policies: resiliencyV1alpha.Policies{
+					Retries: map[string]resiliencyV1alpha.Retry{"retry": {Budget: budget}},
+				}},
+			})
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestHedgingPolicy(t *testing.T) {
+	config := &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Hedging: map[string]resiliencyV1alpha.Hedging{
+					"fixed":      {Delay: "100ms", Verbs: []string{"get"}, Methods: []string{"/lookup"}},
+					"percentile": {Delay: "100ms", Percentile: 90, MaxAttempts: 3, Verbs: []string{"GET"}},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Hedging: "fixed"},
+					"appB": {Hedging: "percentile"},
+					"appC": {Retry: "missing"},
+				},
+			},
+		},
+	}
+	r := FromConfigurations(log, config)
+
+	t.Run("fixed delay", func(t *testing.T) {
+		hp := r.EndpointHedgingPolicy("appA")
+		require.NotNil(t, hp)
+		assert.Equal(t, 2, hp.MaxAttempts)
+		assert.Equal(t, 100*time.Millisecond, hp.HedgeDelay())
+		hp.RecordLatency(time.Second)
+		assert.Equal(t, 100*time.Millisecond, hp.HedgeDelay())
+
+		assert.True(t, hp.CanHedge("GET", "other"))
+		assert.True(t, hp.CanHedge("NONE", "lookup"))
+		assert.False(t, hp.CanHedge("POST", "other"))
+	})
+
+	t.Run("percentile delay", func(t *testing.T) {
+		hp := r.EndpointHedgingPolicy("appB")
+		require.NotNil(t, hp)
+		assert.Same(t, hp, r.EndpointHedgingPolicy("appB"))
+
+		// Uses the fixed delay until enough latencies are recorded
+		for i := 1; i < hedgingMinLatencies; i++ {
+			hp.RecordLatency(time.Duration(i) * time.Millisecond)
+		}
+		assert.Equal(t, 100*time.Millisecond, hp.HedgeDelay())
+
+		for i := hedgingMinLatencies; i <= hedgingLatencyWindow; i++ {
+			hp.RecordLatency(time.Duration(i) * time.Millisecond)
+		}
+		assert.Equal(t, 90*time.Millisecond, hp.HedgeDelay())
+
+		// Older latencies are replaced
+		for i := 0; i < hedgingLatencyWindow; i++ {
+			hp.RecordLatency(time.Millisecond)
+		}
+		assert.Equal(t, time.Millisecond, hp.HedgeDelay())
+	})
+
+	t.Run("no hedging policy", func(t *testing.T) {
+		assert.Nil(t, r.EndpointHedgingPolicy("appC"))
+		assert.Nil(t, r.EndpointHedgingPolicy("appD"))
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		invalid := []resiliencyV1alpha.Hedging{
+			{Verbs: []string{"GET"}},
+			{Delay: "0s", Verbs: []string{"GET"}},
+			{Delay: "10ms"},
+			{Delay: "10ms", Verbs: []string{"GET"}, Percentile: 100},
+			{Delay: "10ms", Verbs: []string{"GET"}, MaxAttempts: 1},
+		}
+		for _, h := range invalid {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{
+					Policies: resiliencyV1alpha.Policies{
+						Hedging: map[string]resiliencyV1alpha.Hedging{"hedge": h},
+					},
+				},
+			})
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestFallbackPolicy(t *testing.T) {
+	config := &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Fallbacks: map[string]resiliencyV1alpha.Fallback{
+					"static": {Response: &resiliencyV1alpha.FallbackResponse{
+						Headers: map[string]string{"x-fallback": "true"},
+						Body:    `{"status":"degraded"}`,
+					}},
+					"app":       {AppID: "appB", Method: "fallback", OnCircuitBreakerOpen: true},
+					"component": {Component: "statestore2"},
+					"toAppA":    {AppID: "appA"},
+					"toAppB":    {AppID: "appB"},
+					"toStore1":  {Component: "statestore1"},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Fallback: "static"},
+					"appC": {Fallback: "app"},
+					"appD": {Retry: "missing"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {Outbound: resiliencyV1alpha.PolicyNames{Fallback: "component"}},
+				},
+			},
+		},
+	}
+	r := FromConfigurations(log, config)
+
+	t.Run("static response", func(t *testing.T) {
+		fp := r.EndpointFallbackPolicy("appA")
+		require.NotNil(t, fp)
+		require.NotNil(t, fp.Response)
+		assert.Equal(t, 200, fp.Response.StatusCode)
+		assert.Equal(t, `{"status":"degraded"}`, string(fp.Response.Body))
+		assert.False(t, fp.ShouldFallback(nil))
+	})
+
+	t.Run("errors which fall back", func(t *testing.T) {
+		fp := r.EndpointFallbackPolicy("appA")
+		require.NotNil(t, fp)
+		tests := []struct {
+			name     string
+			err      error
+			expected bool
+		}{
+			{"circuit breaker open", fmt.Errorf("failed: %w", breaker.ErrOpenState), true},
+			{"timeout", fmt.Errorf("failed: %w", context.DeadlineExceeded), true},
+			{"connection", fmt.Errorf("failed: %w", syscall.ECONNREFUSED), true},
+			{"grpc unavailable", status.Error(codes.Unavailable, "simulated"), true},
+			{"grpc deadline exceeded", status.Error(codes.DeadlineExceeded, "simulated"), true},
+			{"http 5xx", HTTPStatusError{StatusCode: 503}, true},
+			{"http 4xx", HTTPStatusError{StatusCode: 404}, false},
+			{"grpc invalid argument", status.Error(codes.InvalidArgument, "simulated"), false},
+			{"grpc permission denied", status.Error(codes.PermissionDenied, "simulated"), false},
+			{"other error", errors.New("failed"), false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, fp.ShouldFallback(tt.err))
+			})
+		}
+	})
+
+	t.Run("alternate app on circuit breaker open", func(t *testing.T) {
+		fp := r.EndpointFallbackPolicy("appC")
+		require.NotNil(t, fp)
+		assert.Equal(t, "appB", fp.AppID)
+		assert.Equal(t, "fallback", fp.Method)
+		assert.False(t, fp.ShouldFallback(errors.New("failed")))
+		assert.True(t, fp.ShouldFallback(fmt.Errorf("failed: %w", breaker.ErrOpenState)))
+	})
+
+	t.Run("alternate component", func(t *testing.T) {
+		fp := r.ComponentFallbackPolicy("statestore1", Statestore)
+		require.NotNil(t, fp)
+		assert.Equal(t, "statestore2", fp.Component)
+	})
+
+	t.Run("no fallback policy", func(t *testing.T) {
+		var fp *FallbackPolicy
+		assert.False(t, fp.ShouldFallback(errors.New("failed")))
+		assert.Nil(t, r.EndpointFallbackPolicy("appD"))
+		assert.Nil(t, r.EndpointFallbackPolicy("appE"))
+		assert.Nil(t, r.ComponentFallbackPolicy("statestore2", Statestore))
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		invalid := []resiliencyV1alpha.Fallback{
+			{},
+			{AppID: "appB", Component: "statestore2"},
+			{Method: "fallback"},
+			{Component: "statestore2", Method: "fallback"},
+			{Response: &resiliencyV1alpha.FallbackResponse{StatusCode: 1000}},
+		}
+		for _, f := range invalid {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{
+					Policies: resiliencyV1alpha.Policies{
+						Fallbacks: map[string]resiliencyV1alpha.Fallback{"fallback": f},
+					},
+				},
+			})
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		invalid := []resiliencyV1alpha.Targets{
+			{Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{"appA": {Fallback: "component"}}},
+			{Components: map[string]resiliencyV1alpha.ComponentPolicyNames{"statestore1": {Outbound: resiliencyV1alpha.PolicyNames{Fallback: "static"}}}},
+			{Components: map[string]resiliencyV1alpha.ComponentPolicyNames{"statestore1": {Inbound: resiliencyV1alpha.PolicyNames{Fallback: "component"}}}},
+			{Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{"appA": {Fallback: "toAppA"}}},
+			{Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{"appA": {Fallback: "toAppB"}, "appB": {Fallback: "toAppA"}}},
+			{Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{"appA": {Fallback: "toAppB"}, "appB": {Fallback: "app"}}},
+			{Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+				"statestore1": {Outbound: resiliencyV1alpha.PolicyNames{Fallback: "component"}},
+				"statestore2": {Outbound: resiliencyV1alpha.PolicyNames{Fallback: "toStore1"}},
+			}},
+		}
+		for _, targets := range invalid {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{
+					Policies: config.Spec.Policies,
+					Targets:  targets,
+				},
+			})
+			assert.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	kclock "k8s.io/utils/clock"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+const (
+	defaultRetryBudgetWindow              = 10 * time.Second
+	defaultRetryBudgetMinRetriesPerSecond = 10
+
+	// Number of buckets the sliding window of a retry budget is divided into.
+	retryBudgetBuckets = 10
+)
+
+// RetryBudget limits the retries to a target to a percentage of the calls made to it over a sliding window,
+// so that a failing dependency doesn't multiply the traffic it receives by the maximum number of retries at every hop.
+// A minimum number of retries per second is always allowed, so targets receiving few calls can still be retried.
+type RetryBudget struct {
+	Name                string
+	MaxRetryPercent     int
+	Window              time.Duration
+	MinRetriesPerSecond int
+
+	clock          kclock.Clock
+	lock           sync.Mutex
+	bucketDuration time.Duration
+	buckets        [retryBudgetBuckets]retryBudgetBucket
+}
+
+// retryBudgetBucket counts the calls and retries made during a slice of the window.
+type retryBudgetBucket struct {
+	// Index of the slice of time since the epoch the counts are for.
+	slot    int64
+	calls   int
+	retries int
+}
+
+// newRetryBudget returns a new RetryBudget with the limits of the template.
+func newRetryBudget(name string, template *RetryBudget) *RetryBudget {
+	bucketDuration := template.Window / retryBudgetBuckets
+	if bucketDuration <= 0 {
+		bucketDuration = 1
+	}
+	return &RetryBudget{
+		Name:                name,
+		MaxRetryPercent:     template.MaxRetryPercent,
+		Window:              template.Window,
+		MinRetriesPerSecond: template.MinRetriesPerSecond,
+		clock:               &kclock.RealClock{},
+		bucketDuration:      bucketDuration,
+	}
+}
+
+// decodeRetryBudget returns the template of the retry budget configured for a retry policy, or nil if there's none.
+func decodeRetryBudget(name string, c *resiliencyV1alpha.RetryBudget) (*RetryBudget, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	if c.MaxRetryPercent < 0 {
+		return nil, errors.New("budget maxRetryPercent must not be negative")
+	}
+	rb := &RetryBudget{
+		Name:                name,
+		MaxRetryPercent:     c.MaxRetryPercent,
+		Window:              defaultRetryBudgetWindow,
+		MinRetriesPerSecond: defaultRetryBudgetMinRetriesPerSecond,
+	}
+	if c.Window != "" {
+		window, err := parseDuration(c.Window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid budget window %q: %w", c.Window, err)
+		}
+		if window <= 0 {
+			return nil, errors.New("budget window must be greater than 0")
+		}
+		rb.Window = window
+	}
+	if c.MinRetriesPerSecond != nil {
+		if *c.MinRetriesPerSecond < 0 {
+			return nil, errors.New("budget minRetriesPerSecond must not be negative")
+		}
+		rb.MinRetriesPerSecond = *c.MinRetriesPerSecond
+	}
+	return rb, nil
+}
+
+// RecordCall records a call to the target, which increases the number of retries allowed.
+func (b *RetryBudget) RecordCall() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.currentBucket().calls++
+}
+
+// TryRetry returns true, and records the retry, if retrying a call to the target is within the budget.
+func (b *RetryBudget) TryRetry() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	current := b.currentBucket()
+	var calls, retries int
+	for i := range b.buckets {
+		// Buckets which haven't been used since the beginning of the window are ignored.
+		if b.buckets[i].slot > current.slot-retryBudgetBuckets {
+			calls += b.buckets[i].calls
+			retries += b.buckets[i].retries
+		}
+	}
+
+	allowed := calls * b.MaxRetryPercent / 100
+	if minAllowed := int(float64(b.MinRetriesPerSecond) * b.Window.Seconds()); minAllowed > allowed {
+		allowed = minAllowed
+	}
+	if retries >= allowed {
+		return false
+	}
+	current.retries++
+	return true
+}
+
+// currentBucket returns the bucket for the current time, resetting it if it was last used in a previous window.
+// It must be invoked while holding the lock.
+func (b *RetryBudget) currentBucket() *retryBudgetBucket {
+	slot := b.clock.Now().UnixNano() / int64(b.bucketDuration)
+	bucket := &b.buckets[slot%retryBudgetBuckets]
+	if bucket.slot != slot {
+		*bucket = retryBudgetBucket{slot: slot}
+	}
+	return bucket
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (b *RetryBudget) String() string {
+	return fmt.Sprintf("name='%s' maxRetryPercent='%d' window='%v' minRetriesPerSecond='%d'", b.Name, b.MaxRetryPercent, b.Window, b.MinRetriesPerSecond)
+}
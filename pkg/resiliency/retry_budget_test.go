@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/kit/retry"
+)
+
+func TestRetryBudget(t *testing.T) {
+	newBudget := func(minRetriesPerSecond int) (*RetryBudget, *clocktesting.FakeClock) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		b := newRetryBudget("test", &RetryBudget{
+			MaxRetryPercent:     20,
+			Window:              10 * time.Second,
+			MinRetriesPerSecond: minRetriesPerSecond,
+		})
+		b.clock = clock
+		return b, clock
+	}
+
+	t.Run("retries are limited to a percentage of the calls", func(t *testing.T) {
+		b, _ := newBudget(0)
+		assert.False(t, b.TryRetry())
+
+		for i := 0; i < 10; i++ {
+			b.RecordCall()
+		}
+		assert.True(t, b.TryRetry())
+		assert.True(t, b.TryRetry())
+		assert.False(t, b.TryRetry())
+	})
+
+	t.Run("minimum retries are allowed without calls", func(t *testing.T) {
+		b, _ := newBudget(1)
+		for i := 0; i < 10; i++ {
+			assert.True(t, b.TryRetry())
+		}
+		assert.False(t, b.TryRetry())
+	})
+
+	t.Run("calls and retries expire after the window", func(t *testing.T) {
+		b, clock := newBudget(0)
+		for i := 0; i < 5; i++ {
+			b.RecordCall()
+		}
+		assert.True(t, b.TryRetry())
+		assert.False(t, b.TryRetry())
+
+		clock.Step(5 * time.Second)
+		for i := 0; i < 5; i++ {
+			b.RecordCall()
+		}
+		// The retry made earlier is still in the window
+		assert.True(t, b.TryRetry())
+		assert.False(t, b.TryRetry())
+
+		// Only the second batch of calls and the second retry are in the window
+		clock.Step(6 * time.Second)
+		assert.False(t, b.TryRetry())
+
+		clock.Step(5 * time.Second)
+		b.RecordCall()
+		assert.False(t, b.TryRetry())
+	})
+}
+
+func TestPolicyRetryBudget(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	newPolicyDef := func(maxRetries int64, budget *RetryBudget) (*PolicyDefinition, *atomic.Int32) {
+		exhausted := &atomic.Int32{}
+		return &PolicyDefinition{
+			log:  testLog,
+			name: "retrybudget",
+			r: &retry.Config{
+				Policy:     retry.PolicyConstant,
+				Duration:   time.Millisecond,
+				MaxRetries: maxRetries,
+			},
+			rb: budget,
+			addRetryBudgetExhaustedMetric: func() {
+				exhausted.Add(1)
+			},
+		}, exhausted
+	}
+
+	t.Run("retries beyond the budget fail fast", func(t *testing.T) {
+		policyDef, exhausted := newPolicyDef(10, newRetryBudget("test", &RetryBudget{
+			MaxRetryPercent: 100,
+			Window:          time.Minute,
+		}))
+
+		called := atomic.Int32{}
+		_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			called.Add(1)
+			return nil, errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+		// The call adds one retry to the budget
+		assert.Equal(t, int32(2), called.Load())
+		assert.Equal(t, int32(1), exhausted.Load())
+	})
+
+	t.Run("retries within the budget", func(t *testing.T) {
+		policyDef, exhausted := newPolicyDef(2, newRetryBudget("test", &RetryBudget{
+			MaxRetryPercent:     0,
+			Window:              time.Minute,
+			MinRetriesPerSecond: 1,
+		}))
+
+		called := atomic.Int32{}
+		_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			if called.Add(1) < 3 {
+				return nil, errFailed
+			}
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), called.Load())
+		assert.Zero(t, exhausted.Load())
+	})
+
+	t.Run("last attempt doesn't use the budget", func(t *testing.T) {
+		budget := newRetryBudget("test", &RetryBudget{
+			MaxRetryPercent:     0,
+			Window:              time.Minute,
+			MinRetriesPerSecond: 1,
+		})
+		policyDef, exhausted := newPolicyDef(1, budget)
+
+		_, err := NewRunner[any](context.Background(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+		assert.Zero(t, exhausted.Load())
+		retries := 0
+		for _, bucket := range budget.buckets {
+			retries += bucket.retries
+		}
+		// Only the first failure is retried
+		assert.Equal(t, 1, retries)
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+// ErrorClass is a class of errors returned by components and transports that retry policies can match.
+type ErrorClass string
+
+const (
+	// ErrorClassTimeout matches operations which timed out.
+	ErrorClassTimeout ErrorClass = "timeout"
+	// ErrorClassConnection matches network errors other than timeouts.
+	ErrorClassConnection ErrorClass = "connection"
+	// ErrorClassETagMismatch matches state store operations which failed because of an ETag mismatch.
+	ErrorClassETagMismatch ErrorClass = "etagMismatch"
+	// ErrorClassETagInvalid matches state store operations which failed because of an invalid ETag.
+	ErrorClassETagInvalid ErrorClass = "etagInvalid"
+)
+
+// HTTPStatusError is returned by operations which received a response with a non-successful HTTP status code.
+// Err is the error describing the failure, if any.
+type HTTPStatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e HTTPStatusError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("received non-successful status code: %d", e.StatusCode)
+}
+
+func (e HTTPStatusError) Unwrap() error {
+	return e.Err
+}
+
+// RetryMatching determines which errors are retried by a retry policy.
+// A nil RetryMatching retries all errors.
+type RetryMatching struct {
+	httpStatusCodes codeRanges
+	grpcStatusCodes codeRanges
+	errorClasses    map[ErrorClass]struct{}
+}
+
+// NewRetryMatching parses the matching rules of a retry policy.
+// It returns nil if the rules don't restrict which errors are retried.
+func NewRetryMatching(m *resiliencyV1alpha.RetryMatching) (*RetryMatching, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var (
+		rm  RetryMatching
+		err error
+	)
+	rm.httpStatusCodes, err = parseCodeRanges(m.HTTPStatusCodes, 100, 599, strconv.Atoi)
+	if err != nil {
+		return nil, fmt.Errorf("invalid httpStatusCodes %q: %w", m.HTTPStatusCodes, err)
+	}
+	rm.grpcStatusCodes, err = parseCodeRanges(m.GRPCStatusCodes, 0, 16, parseGRPCCode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gRPCStatusCodes %q: %w", m.GRPCStatusCodes, err)
+	}
+	if len(m.ErrorClasses) > 0 {
+		rm.errorClasses = make(map[ErrorClass]struct{}, len(m.ErrorClasses))
+		for _, c := range m.ErrorClasses {
+			switch ErrorClass(c) {
+			case ErrorClassTimeout, ErrorClassConnection, ErrorClassETagMismatch, ErrorClassETagInvalid:
+				rm.errorClasses[ErrorClass(c)] = struct{}{}
+			default:
+				return nil, fmt.Errorf("invalid error class %q", c)
+			}
+		}
+	}
+
+	if rm.httpStatusCodes == nil && rm.grpcStatusCodes == nil && rm.errorClasses == nil {
+		return nil, nil
+	}
+	return &rm, nil
+}
+
+// ShouldRetry returns true if the error can be retried.
+// Errors with an HTTP status code are matched against the HTTP status codes, errors with a gRPC
+// status against the gRPC status codes and all other errors against the error classes.
+func (m *RetryMatching) ShouldRetry(err error) bool {
+	if m == nil || err == nil {
+		return true
+	}
+
+	var httpErr HTTPStatusError
+	if errors.As(err, &httpErr) {
+		return m.httpStatusCodes.contains(httpErr.StatusCode)
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return m.grpcStatusCodes.contains(int(grpcErr.GRPCStatus().Code()))
+	}
+
+	if m.errorClasses == nil {
+		return true
+	}
+	_, ok := m.errorClasses[classifyError(err)]
+	return ok
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (m *RetryMatching) String() string {
+	if m == nil {
+		return "all"
+	}
+	classes := make([]string, 0, len(m.errorClasses))
+	for c := range m.errorClasses {
+		classes = append(classes, string(c))
+	}
+	return fmt.Sprintf("http=%v grpc=%v errorClasses=%v", m.httpStatusCodes, m.grpcStatusCodes, classes)
+}
+
+// classifyError returns the class of an error, or an empty string if it doesn't belong to any.
+func classifyError(err error) ErrorClass {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTimeout
+	}
+
+	var etagErr *state.ETagError
+	if errors.As(err, &etagErr) {
+		switch etagErr.Kind() {
+		case state.ETagMismatch:
+			return ErrorClassETagMismatch
+		case state.ETagInvalid:
+			return ErrorClassETagInvalid
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ErrorClassTimeout
+		}
+		return ErrorClassConnection
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return ErrorClassConnection
+	}
+
+	return ""
+}
+
+// codeRange is an inclusive range of status codes.
+type codeRange struct {
+	from, to int
+}
+
+// codeRanges is a list of status code ranges. A nil list contains all codes.
+type codeRanges []codeRange
+
+func (r codeRanges) contains(code int) bool {
+	if r == nil {
+		return true
+	}
+	for _, cr := range r {
+		if code >= cr.from && code <= cr.to {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCodeRanges parses a comma-separated list of codes or ranges of codes, like "429,500-599".
+func parseCodeRanges(val string, lowest, highest int, parse func(string) (int, error)) (codeRanges, error) {
+	val = strings.TrimSpace(val)
+	if val == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(val, ",")
+	res := make(codeRanges, 0, len(parts))
+	for _, part := range parts {
+		fromStr, toStr, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		from, err := parse(strings.TrimSpace(fromStr))
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			to, err = parse(strings.TrimSpace(toStr))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if from < lowest || to > highest || from > to {
+			return nil, fmt.Errorf("code range %q is not within %d and %d", part, lowest, highest)
+		}
+		res = append(res, codeRange{from: from, to: to})
+	}
+	return res, nil
+}
+
+// parseGRPCCode parses a gRPC status code from its number or its name, like "UNAVAILABLE".
+func parseGRPCCode(val string) (int, error) {
+	if n, err := strconv.Atoi(val); err == nil {
+		return n, nil
+	}
+	var c codes.Code
+	if err := c.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(val)))); err != nil {
+		return 0, err
+	}
+	return int(c), nil
+}
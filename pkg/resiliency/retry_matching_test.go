@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestNewRetryMatching(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		rm, err := NewRetryMatching(nil)
+		require.NoError(t, err)
+		assert.Nil(t, rm)
+	})
+
+	t.Run("empty config", func(t *testing.T) {
+		rm, err := NewRetryMatching(&resiliencyV1alpha.RetryMatching{})
+		require.NoError(t, err)
+		assert.Nil(t, rm)
+	})
+
+	t.Run("valid config", func(t *testing.T) {
+		rm, err := NewRetryMatching(&resiliencyV1alpha.RetryMatching{
+			HTTPStatusCodes: "429, 500-504",
+			GRPCStatusCodes: "UNAVAILABLE,deadline_exceeded,8",
+			ErrorClasses:    []string{"timeout", "etagMismatch"},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, rm)
+		assert.Equal(t, codeRanges{{429, 429}, {500, 504}}, rm.httpStatusCodes)
+		assert.Equal(t, codeRanges{
+			{int(codes.Unavailable), int(codes.Unavailable)},
+			{int(codes.DeadlineExceeded), int(codes.DeadlineExceeded)},
+			{int(codes.ResourceExhausted), int(codes.ResourceExhausted)},
+		}, rm.grpcStatusCodes)
+		assert.Len(t, rm.errorClasses, 2)
+	})
+
+	invalid := map[string]resiliencyV1alpha.RetryMatching{
+		"http code out of range":  {HTTPStatusCodes: "99"},
+		"http range reversed":     {HTTPStatusCodes: "599-500"},
+		"http code not a number":  {HTTPStatusCodes: "5xx"},
+		"grpc code out of range":  {GRPCStatusCodes: "17"},
+		"grpc code unknown name":  {GRPCStatusCodes: "NOT_A_CODE"},
+		"unknown error class":     {ErrorClasses: []string{"timeout", "other"}},
+		"empty item in code list": {HTTPStatusCodes: "500,"},
+	}
+	for name, m := range invalid {
+		m := m
+		t.Run(name, func(t *testing.T) {
+			_, err := NewRetryMatching(&m)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRetryMatchingShouldRetry(t *testing.T) {
+	t.Run("nil matching retries everything", func(t *testing.T) {
+		var rm *RetryMatching
+		assert.True(t, rm.ShouldRetry(errors.New("simulated")))
+		assert.True(t, rm.ShouldRetry(HTTPStatusError{StatusCode: 400}))
+	})
+
+	rm, err := NewRetryMatching(&resiliencyV1alpha.RetryMatching{
+		HTTPStatusCodes: "429,500-599",
+		GRPCStatusCodes: "UNAVAILABLE",
+		ErrorClasses:    []string{"timeout", "connection", "etagMismatch"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"http matching code", HTTPStatusError{StatusCode: 503}, true},
+		{"http wrapped matching code", fmt.Errorf("failed: %w", HTTPStatusError{StatusCode: 429}), true},
+		{"http matching code with cause", HTTPStatusError{StatusCode: 500, Err: errors.New("app failed")}, true},
+		{"http other code", HTTPStatusError{StatusCode: 404}, false},
+		{"grpc matching code", status.Error(codes.Unavailable, "simulated"), true},
+		{"grpc other code", status.Error(codes.InvalidArgument, "simulated"), false},
+		{"timeout", fmt.Errorf("failed: %w", context.DeadlineExceeded), true},
+		{"connection refused", fmt.Errorf("failed: %w", syscall.ECONNREFUSED), true},
+		{"etag mismatch", state.NewETagError(state.ETagMismatch, nil), true},
+		{"etag invalid", state.NewETagError(state.ETagInvalid, nil), false},
+		{"unclassified error", errors.New("simulated"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rm.ShouldRetry(tt.err))
+		})
+	}
+
+	t.Run("http status error with cause", func(t *testing.T) {
+		cause := errors.New("app failed")
+		err := fmt.Errorf("invoke: %w", HTTPStatusError{StatusCode: 500, Err: cause})
+		assert.Equal(t, "invoke: app failed", err.Error())
+		require.ErrorIs(t, err, cause)
+		assert.Equal(t, "received non-successful status code: 500", HTTPStatusError{StatusCode: 500}.Error())
+	})
+
+	t.Run("unset rules retry everything of that kind", func(t *testing.T) {
+		rm, err := NewRetryMatching(&resiliencyV1alpha.RetryMatching{HTTPStatusCodes: "503"})
+		require.NoError(t, err)
+		assert.False(t, rm.ShouldRetry(HTTPStatusError{StatusCode: 500}))
+		assert.True(t, rm.ShouldRetry(status.Error(codes.Internal, "simulated")))
+		assert.True(t, rm.ShouldRetry(errors.New("simulated")))
+	})
+}
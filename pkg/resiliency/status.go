@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+// Types of targets of resiliency policies.
+const (
+	TargetTypeApp       = "app"
+	TargetTypeActor     = "actor"
+	TargetTypeComponent = "component"
+)
+
+// ErrCircuitBreakerNotFound is returned when no circuit breaker was instantiated for a target with the given name.
+var ErrCircuitBreakerNotFound = errors.New("circuit breaker not found")
+
+// StatusProvider exposes the live state of the circuit breakers and the policies resolved for targets.
+type StatusProvider interface {
+	// CircuitBreakerStatuses returns the status of the circuit breakers instantiated for targets.
+	// If targetType or target are set, only the circuit breakers of matching targets are returned.
+	CircuitBreakerStatuses(targetType, target string) []CircuitBreakerStatus
+	// ResolvedPolicies returns the names of the policies applied to a target.
+	// componentType is used to resolve the default policies of components.
+	ResolvedPolicies(targetType, target string, componentType ComponentType) (ResolvedPolicies, error)
+	// ResetCircuitBreaker closes a circuit breaker and clears its counts.
+	ResetCircuitBreaker(targetType, target, name string) error
+	// ForceOpenCircuitBreaker opens a circuit breaker until it is reset.
+	ForceOpenCircuitBreaker(targetType, target, name string) error
+}
+
+// CircuitBreakerStatus is the status of a circuit breaker instantiated for a target.
+type CircuitBreakerStatus struct {
+	TargetType string
+	Target     string
+	breaker.Status
+}
+
+// ResolvedPolicies contains the names of the policies applied to a target.
+type ResolvedPolicies struct {
+	// Default is true if the target has no policies of its own and the default policies are applied.
+	Default bool
+	// Outbound contains the policies of apps, actors, and outbound calls to components.
+	Outbound PolicyNames
+	// Inbound contains the policies of inbound calls from components.
+	Inbound PolicyNames
+}
+
+// CircuitBreakerStatuses returns the status of the circuit breakers instantiated for targets, sorted by target and name.
+func (r *Resiliency) CircuitBreakerStatuses(targetType, target string) []CircuitBreakerStatus {
+	res := []CircuitBreakerStatus{}
+	matches := func(tt, t string) bool {
+		return (targetType == "" || targetType == tt) && (target == "" || target == t)
+	}
+	addFromCache := func(tt, t string, cache *lru.Cache[string, *breaker.CircuitBreaker]) {
+		if cache == nil || !matches(tt, t) {
+			return
+		}
+		for _, key := range cache.Keys() {
+			if cb, ok := cache.Peek(key); ok && cb != nil {
+				res = append(res, CircuitBreakerStatus{TargetType: tt, Target: t, Status: cb.Status()})
+			}
+		}
+	}
+
+	r.serviceCBsMu.RLock()
+	for app, cache := range r.serviceCBs {
+		addFromCache(TargetTypeApp, app, cache)
+	}
+	r.serviceCBsMu.RUnlock()
+
+	r.actorCBsCachesMu.RLock()
+	for actorType, cache := range r.actorCBCaches {
+		addFromCache(TargetTypeActor, actorType, cache)
+	}
+	r.actorCBsCachesMu.RUnlock()
+
+	r.componentCBs.RLock()
+	for name, cb := range r.componentCBs.cbs {
+		if matches(TargetTypeComponent, name) {
+			res = append(res, CircuitBreakerStatus{TargetType: TargetTypeComponent, Target: name, Status: cb.Status()})
+		}
+	}
+	r.componentCBs.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].TargetType != res[j].TargetType {
+			return res[i].TargetType < res[j].TargetType
+		}
+		if res[i].Target != res[j].Target {
+			return res[i].Target < res[j].Target
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// ResolvedPolicies returns the names of the policies applied to a target.
+// Targets without policies of their own resolve to the default policies, if any.
+func (r *Resiliency) ResolvedPolicies(targetType, target string, componentType ComponentType) (ResolvedPolicies, error) {
+	var res ResolvedPolicies
+	switch targetType {
+	case TargetTypeApp:
+		if policyNames, ok := r.apps[target]; ok {
+			res.Outbound = policyNames
+		} else {
+			res.Outbound, res.Default = r.getDefaultPolicy(EndpointPolicy{})
+		}
+	case TargetTypeActor:
+		if actorPolicies, ok := r.actors[target]; ok {
+			res.Outbound = PolicyNames{
+				Timeout:        actorPolicies.PostLockPolicies.Timeout,
+				Retry:          actorPolicies.PreLockPolicies.Retry,
+				CircuitBreaker: actorPolicies.PreLockPolicies.CircuitBreaker,
+				Bulkhead:       actorPolicies.PreLockPolicies.Bulkhead,
+				RateLimit:      actorPolicies.PreLockPolicies.RateLimit,
+			}
+		} else {
+			res.Outbound, res.Default = r.getDefaultPolicy(ActorPolicy{})
+		}
+	case TargetTypeComponent:
+		if componentPolicies, ok := r.components[target]; ok {
+			res.Outbound = componentPolicies.Outbound
+			res.Inbound = componentPolicies.Inbound
+		} else {
+			var outbound, inbound bool
+			res.Outbound, outbound = r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: Outbound})
+			res.Inbound, inbound = r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: Inbound})
+			res.Default = outbound || inbound
+		}
+	default:
+		return res, fmt.Errorf("unknown target type %q", targetType)
+	}
+	return res, nil
+}
+
+// ResetCircuitBreaker closes a circuit breaker and clears its counts.
+func (r *Resiliency) ResetCircuitBreaker(targetType, target, name string) error {
+	cb, err := r.findCircuitBreaker(targetType, target, name)
+	if err != nil {
+		return err
+	}
+	cb.Reset()
+	return nil
+}
+
+// ForceOpenCircuitBreaker opens a circuit breaker until it is reset.
+func (r *Resiliency) ForceOpenCircuitBreaker(targetType, target, name string) error {
+	cb, err := r.findCircuitBreaker(targetType, target, name)
+	if err != nil {
+		return err
+	}
+	cb.ForceOpen()
+	return nil
+}
+
+// findCircuitBreaker returns the circuit breaker instantiated for a target with the given name.
+// Components have a single circuit breaker, so the name is optional for them.
+func (r *Resiliency) findCircuitBreaker(targetType, target, name string) (*breaker.CircuitBreaker, error) {
+	var (
+		cb *breaker.CircuitBreaker
+		ok bool
+	)
+	switch targetType {
+	case TargetTypeApp:
+		r.serviceCBsMu.RLock()
+		cache := r.serviceCBs[target]
+		r.serviceCBsMu.RUnlock()
+		if cache != nil {
+			cb, ok = cache.Peek(name)
+		}
+	case TargetTypeActor:
+		r.actorCBsCachesMu.RLock()
+		cache := r.actorCBCaches[target]
+		r.actorCBsCachesMu.RUnlock()
+		if cache != nil {
+			cb, ok = cache.Peek(name)
+		}
+	case TargetTypeComponent:
+		r.componentCBs.RLock()
+		cb, ok = r.componentCBs.cbs[target]
+		r.componentCBs.RUnlock()
+		ok = ok && (name == "" || name == cb.Name)
+	default:
+		return nil, fmt.Errorf("unknown target type %q", targetType)
+	}
+	if !ok || cb == nil {
+		return nil, ErrCircuitBreakerNotFound
+	}
+	return cb, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+func TestCircuitBreakerStatuses(t *testing.T) {
+	config := &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{
+					"fast":                      "1s",
+					"DefaultActorTimeoutPolicy": "5s",
+				},
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"cb": {Trip: "consecutiveFailures > 0", Timeout: "1m"},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appA": {Timeout: "fast", CircuitBreaker: "cb"},
+				},
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"actorA": {CircuitBreaker: "cb", CircuitBreakerScope: "type"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore1": {Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "cb"}},
+				},
+			},
+		},
+	}
+	r := FromConfigurations(log, config)
+
+	fail := func(def *PolicyDefinition) {
+		NewRunner[any](context.Background(), def)(func(ctx context.Context) (any, error) {
+			return nil, errors.New("failed")
+		})
+	}
+	fail(r.EndpointPolicy("appA", "appA:method"))
+	r.ActorPreLockPolicy("actorA", "id")
+	r.ComponentOutboundPolicy("statestore1", Statestore)
+
+	t.Run("list circuit breakers", func(t *testing.T) {
+		statuses := r.CircuitBreakerStatuses("", "")
+		require.Len(t, statuses, 3)
+		assert.Equal(t, TargetTypeActor, statuses[0].TargetType)
+		assert.Equal(t, "actorA", statuses[0].Target)
+		assert.Equal(t, breaker.StateClosed, statuses[0].State)
+		assert.Equal(t, TargetTypeApp, statuses[1].TargetType)
+		assert.Equal(t, "appA:method", statuses[1].Name)
+		assert.Equal(t, breaker.StateOpen, statuses[1].State)
+		assert.Positive(t, statuses[1].TimeToHalfOpen)
+		assert.Equal(t, TargetTypeComponent, statuses[2].TargetType)
+		assert.Equal(t, "statestore1", statuses[2].Target)
+
+		assert.Len(t, r.CircuitBreakerStatuses(TargetTypeApp, ""), 1)
+		assert.Len(t, r.CircuitBreakerStatuses(TargetTypeComponent, "statestore1"), 1)
+		assert.Empty(t, r.CircuitBreakerStatuses(TargetTypeApp, "appB"))
+	})
+
+	t.Run("reset and force open", func(t *testing.T) {
+		require.NoError(t, r.ResetCircuitBreaker(TargetTypeApp, "appA", "appA:method"))
+		assert.Equal(t, breaker.StateClosed, r.CircuitBreakerStatuses(TargetTypeApp, "appA")[0].State)
+
+		require.NoError(t, r.ForceOpenCircuitBreaker(TargetTypeComponent, "statestore1", ""))
+		status := r.CircuitBreakerStatuses(TargetTypeComponent, "statestore1")[0]
+		assert.Equal(t, breaker.StateOpen, status.State)
+		assert.True(t, status.ForcedOpen)
+
+		assert.ErrorIs(t, r.ResetCircuitBreaker(TargetTypeApp, "appA", "appA:other"), ErrCircuitBreakerNotFound)
+		assert.ErrorIs(t, r.ResetCircuitBreaker(TargetTypeComponent, "statestore1", "other"), ErrCircuitBreakerNotFound)
+		assert.Error(t, r.ForceOpenCircuitBreaker("route", "appA", "appA:method"))
+	})
+
+	t.Run("resolved policies", func(t *testing.T) {
+		policies, err := r.ResolvedPolicies(TargetTypeApp, "appA", "")
+		require.NoError(t, err)
+		assert.False(t, policies.Default)
+		assert.Equal(t, PolicyNames{Timeout: "fast", CircuitBreaker: "cb"}, policies.Outbound)
+
+		policies, err = r.ResolvedPolicies(TargetTypeActor, "actorB", "")
+		require.NoError(t, err)
+		assert.True(t, policies.Default)
+		assert.Equal(t, "DefaultActorTimeoutPolicy", policies.Outbound.Timeout)
+
+		policies, err = r.ResolvedPolicies(TargetTypeComponent, "statestore1", Statestore)
+		require.NoError(t, err)
+		assert.Equal(t, "cb", policies.Outbound.CircuitBreaker)
+		assert.Empty(t, policies.Inbound.CircuitBreaker)
+
+		_, err = r.ResolvedPolicies("route", "appA", "")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/internal/watch"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// WatchLocalResiliency watches the resources paths and calls onChange with all the resiliency
+// configurations they contain every time a file in them is created, modified or removed.
+// It blocks until the context is canceled.
+func WatchLocalResiliency(ctx context.Context, log logger.Logger, runtimeID string, paths []string, onChange func([]*resiliencyV1alpha.Resiliency)) error {
+	return watch.Local(ctx, log, "resiliency configurations", paths, func() {
+		onChange(LoadLocalResiliency(log, runtimeID, paths...))
+	})
+}
+
+// WatchKubernetesResiliency watches the resiliency configurations of the namespace through the operator
+// and calls onChange with all of them every time one is created, modified or deleted.
+// onChange isn't called when the configurations can't be listed, so the current ones are kept.
+// It blocks until the context is canceled.
+func WatchKubernetesResiliency(ctx context.Context, log logger.Logger, runtimeID, namespace, podName string, client operatorv1pb.OperatorClient, onChange func([]*resiliencyV1alpha.Resiliency)) error {
+	if client == nil {
+		return nil
+	}
+
+	connect := func(ctx context.Context) (watch.UpdateStream[*operatorv1pb.ResiliencyUpdateEvent], error) {
+		return client.ResiliencyUpdate(ctx, &operatorv1pb.ResiliencyUpdateRequest{
+			Namespace: namespace,
+			PodName:   podName,
+		})
+	}
+	return watch.Operator(ctx, log, "resiliency configurations", connect, func() {
+		configs, err := LoadKubernetesResiliency(log, runtimeID, namespace, client)
+		if err != nil {
+			log.Errorf("Failed to reload resiliency configurations, keeping the current ones: %v", err)
+			return
+		}
+		onChange(configs)
+	})
+}
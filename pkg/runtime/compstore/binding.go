@@ -65,6 +65,23 @@ func (c *ComponentStore) DeleteInputBindingRoute(name string) {
 	delete(c.inputBindingRoutes, name)
 }
 
+func (c *ComponentStore) SetInputBindingPaused(name string, paused bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if paused {
+		c.pausedInputBindings[name] = struct{}{}
+	} else {
+		delete(c.pausedInputBindings, name)
+	}
+}
+
+func (c *ComponentStore) IsInputBindingPaused(name string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	_, ok := c.pausedInputBindings[name]
+	return ok
+}
+
 func (c *ComponentStore) AddOutputBinding(name string, binding bindings.OutputBinding) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -42,10 +42,12 @@ type ComponentStore struct {
 	secrets                 map[string]secretstores.SecretStore
 	inputBindings           map[string]bindings.InputBinding
 	inputBindingRoutes      map[string]string
+	pausedInputBindings     map[string]struct{}
 	outputBindings          map[string]bindings.OutputBinding
 	locks                   map[string]lock.Store
 	pubSubs                 map[string]PubsubItem
 	topicRoutes             map[string]TopicRoutes
+	pausedSubscriptions     map[string]map[string]struct{}
 	workflowComponents      map[string]workflows.Workflow
 	cryptoProviders         map[string]crypto.SubtleCrypto
 	components              []compsv1alpha1.Component
@@ -62,11 +64,13 @@ func New() *ComponentStore {
 		secrets:                 make(map[string]secretstores.SecretStore),
 		inputBindings:           make(map[string]bindings.InputBinding),
 		inputBindingRoutes:      make(map[string]string),
+		pausedInputBindings:     make(map[string]struct{}),
 		outputBindings:          make(map[string]bindings.OutputBinding),
 		locks:                   make(map[string]lock.Store),
 		pubSubs:                 make(map[string]PubsubItem),
 		workflowComponents:      make(map[string]workflows.Workflow),
 		cryptoProviders:         make(map[string]crypto.SubtleCrypto),
 		topicRoutes:             make(map[string]TopicRoutes),
+		pausedSubscriptions:     make(map[string]map[string]struct{}),
 	}
 }
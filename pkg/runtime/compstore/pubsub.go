@@ -27,15 +27,21 @@ type PubsubItem struct {
 	AllowedTopics       []string
 	ProtectedTopics     []string
 	NamespaceScoped     bool
+	TopicSchemas        map[string]rtpubsub.SchemaValidator
 }
 
 type TopicRoutes map[string]TopicRouteElem
 
 type TopicRouteElem struct {
-	Metadata        map[string]string
-	Rules           []*rtpubsub.Rule
-	DeadLetterTopic string
-	BulkSubscribe   *rtpubsub.BulkSubscribe
+	Metadata             map[string]string
+	Rules                []*rtpubsub.Rule
+	DeadLetterTopic      string
+	BulkSubscribe        *rtpubsub.BulkSubscribe
+	ConcurrencyKey       string
+	Deduplication        *rtpubsub.Deduplication
+	Schema               *rtpubsub.Schema
+	MaxConcurrency       int32
+	MaxMessagesPerSecond int32
 }
 
 func (c *ComponentStore) AddPubSub(name string, item PubsubItem) {
@@ -98,3 +104,23 @@ func (c *ComponentStore) ListSubscriptions() []rtpubsub.Subscription {
 	defer c.lock.RUnlock()
 	return c.subscriptions
 }
+
+func (c *ComponentStore) SetSubscriptionPaused(pubsubName, topic string, paused bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !paused {
+		delete(c.pausedSubscriptions[pubsubName], topic)
+		return
+	}
+	if _, ok := c.pausedSubscriptions[pubsubName]; !ok {
+		c.pausedSubscriptions[pubsubName] = make(map[string]struct{})
+	}
+	c.pausedSubscriptions[pubsubName][topic] = struct{}{}
+}
+
+func (c *ComponentStore) IsSubscriptionPaused(pubsubName, topic string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	_, ok := c.pausedSubscriptions[pubsubName][topic]
+	return ok
+}
@@ -238,7 +238,10 @@ func FromConfig(ctx context.Context, cfg *Config) (*DaprRuntime, error) {
 	var resiliencyProvider *resiliencyConfig.Resiliency
 	switch intc.mode {
 	case modes.KubernetesMode:
-		resiliencyConfigs := resiliencyConfig.LoadKubernetesResiliency(log, intc.id, namespace, operatorClient)
+		resiliencyConfigs, rErr := resiliencyConfig.LoadKubernetesResiliency(log, intc.id, namespace, operatorClient)
+		if rErr != nil {
+			log.Errorf("Failed to load resiliency configurations: %v", rErr)
+		}
 		log.Debugf("Found %d resiliency configurations from Kubernetes", len(resiliencyConfigs))
 		resiliencyProvider = resiliencyConfig.FromConfigurations(log, resiliencyConfigs...)
 	case modes.StandaloneMode:
@@ -264,7 +267,8 @@ func FromConfig(ctx context.Context, cfg *Config) (*DaprRuntime, error) {
 		intc.enableAPILogging = ptr.Of(globalConfig.GetAPILoggingSpec().Enabled)
 	}
 
-	return newDaprRuntime(ctx, intc, globalConfig, accessControlList, resiliencyProvider)
+	// The resiliency configurations can be reloaded when hot reloading is enabled.
+	return newDaprRuntime(ctx, intc, globalConfig, accessControlList, resiliencyConfig.NewReloadable(log, resiliencyProvider))
 }
 
 func (c *Config) toInternal() (*internalConfig, error) {
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dedup detects messages delivered more than once to the app, by
+// recording the IDs of the messages the app processed successfully in a state
+// store for a limited time.
+// Messages are claimed before they are delivered, with a first-write to the
+// state store, so concurrent deliveries of the same message are detected on
+// state stores which support first-write concurrency.
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	contribmetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+const (
+	// DefaultTTL is how long the IDs of processed messages are kept when no TTL is configured.
+	DefaultTTL = 24 * time.Hour
+
+	// claimTTL is how long a message is claimed while it is being processed.
+	// It bounds how long redeliveries are held back if the sidecar stops during processing.
+	claimTTL = 5 * time.Minute
+
+	keyPrefix = "dedup-"
+
+	statusProcessing = "processing"
+	statusProcessed  = "processed"
+)
+
+// ErrInProgress is returned by Claim when the message is being processed by another delivery.
+// The message should be redelivered later, rather than dropped, as that delivery may fail.
+var ErrInProgress = errors.New("message is being processed by another delivery")
+
+// Options contains the options for New.
+type Options struct {
+	AppID          string
+	ComponentStore *compstore.ComponentStore
+	// StoreName is the name of the state store where processed IDs are recorded.
+	StoreName string
+	// Scope separates the IDs recorded for different subscriptions or bindings in the same state store.
+	Scope string
+	TTL   time.Duration
+}
+
+// Deduplicator records the IDs of processed messages and reports the ones already processed.
+type Deduplicator struct {
+	appID     string
+	compStore *compstore.ComponentStore
+	storeName string
+	scope     string
+	ttl       string
+	claimTTL  string
+}
+
+// New returns a new Deduplicator.
+func New(opts Options) *Deduplicator {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultTTL
+	}
+	return &Deduplicator{
+		appID:     opts.AppID,
+		compStore: opts.ComponentStore,
+		storeName: opts.StoreName,
+		scope:     opts.Scope,
+		ttl:       strconv.FormatInt(int64(opts.TTL.Seconds()), 10),
+		claimTTL:  strconv.FormatInt(int64(claimTTL.Seconds()), 10),
+	}
+}
+
+// Claim records that the message with the ID is being processed, and returns true if it must be delivered.
+// It returns false if the message was already processed successfully, and ErrInProgress if it is being processed.
+// Claimed messages must be recorded with Record once processed, or released with Release.
+func (d *Deduplicator) Claim(ctx context.Context, id string) (bool, error) {
+	store, key, err := d.storeAndKey(id)
+	if err != nil {
+		return false, err
+	}
+
+	// A first-write without an etag only succeeds if the key does not exist.
+	err = store.Set(ctx, &state.SetRequest{
+		Key:      key,
+		Value:    statusProcessing,
+		Metadata: map[string]string{contribmetadata.TTLMetadataKey: d.claimTTL},
+		Options: state.SetStateOption{
+			Concurrency: state.FirstWrite,
+		},
+	})
+	var etagErr *state.ETagError
+	if err == nil {
+		return true, nil
+	} else if !errors.As(err, &etagErr) {
+		return false, fmt.Errorf("failed to claim message %s in state store %s: %w", id, d.storeName, err)
+	}
+
+	res, err := store.Get(ctx, &state.GetRequest{Key: key})
+	if err != nil {
+		return false, fmt.Errorf("failed to get processed message %s from state store %s: %w", id, d.storeName, err)
+	}
+	if res == nil || len(res.Data) == 0 || status(res.Data) == statusProcessing {
+		// The claim of the other delivery expired, or was released, in the meantime: it's retried on redelivery.
+		return false, ErrInProgress
+	}
+	return false, nil
+}
+
+// Record records that the message with the ID was processed successfully.
+func (d *Deduplicator) Record(ctx context.Context, id string) error {
+	store, key, err := d.storeAndKey(id)
+	if err != nil {
+		return err
+	}
+
+	err = store.Set(ctx, &state.SetRequest{
+		Key:      key,
+		Value:    statusProcessed,
+		Metadata: map[string]string{contribmetadata.TTLMetadataKey: d.ttl},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record processed message %s in state store %s: %w", id, d.storeName, err)
+	}
+	return nil
+}
+
+// Release removes the claim on a message which was not processed successfully, so it can be redelivered.
+func (d *Deduplicator) Release(ctx context.Context, id string) error {
+	store, key, err := d.storeAndKey(id)
+	if err != nil {
+		return err
+	}
+
+	err = store.Delete(ctx, &state.DeleteRequest{Key: key})
+	if err != nil {
+		return fmt.Errorf("failed to release message %s in state store %s: %w", id, d.storeName, err)
+	}
+	return nil
+}
+
+func (d *Deduplicator) storeAndKey(id string) (state.Store, string, error) {
+	store, ok := d.compStore.GetStateStore(d.storeName)
+	if !ok {
+		return nil, "", fmt.Errorf("state store %s for duplicate detection not found", d.storeName)
+	}
+	key, err := stateLoader.GetModifiedStateKey(keyPrefix+d.scope+"-"+id, d.storeName, d.appID)
+	if err != nil {
+		return nil, "", err
+	}
+	return store, key, nil
+}
+
+// status returns the status saved for a message.
+// Values other than the processing status, including the ones saved by older versions, mean the message was processed.
+func status(data []byte) string {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return string(data)
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestDeduplicator(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("store", daprt.NewFakeStateStore())
+	ctx := context.Background()
+
+	t.Run("state store not found", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "other", Scope: "scope"})
+		_, err := d.Claim(ctx, "1")
+		require.Error(t, err)
+		require.Error(t, d.Record(ctx, "1"))
+		require.Error(t, d.Release(ctx, "1"))
+	})
+
+	t.Run("recorded IDs are duplicates", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "store", Scope: "scope"})
+		claimed, err := d.Claim(ctx, "1")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		require.NoError(t, d.Record(ctx, "1"))
+		claimed, err = d.Claim(ctx, "1")
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("claimed IDs are in progress", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "store", Scope: "scope"})
+		claimed, err := d.Claim(ctx, "2")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		_, err = d.Claim(ctx, "2")
+		require.ErrorIs(t, err, ErrInProgress)
+	})
+
+	t.Run("released IDs can be claimed again", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "store", Scope: "scope"})
+		claimed, err := d.Claim(ctx, "3")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		require.NoError(t, d.Release(ctx, "3"))
+		claimed, err = d.Claim(ctx, "3")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("IDs are scoped", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "store", Scope: "other"})
+		claimed, err := d.Claim(ctx, "1")
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("default TTL", func(t *testing.T) {
+		d := New(Options{AppID: "app", ComponentStore: compStore, StoreName: "store"})
+		assert.Equal(t, "86400", d.ttl)
+	})
+}
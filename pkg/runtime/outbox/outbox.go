@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package outbox implements the transactional outbox for state stores.
+// Publish intents are saved in the same transaction as the state changes they
+// describe, then a background relay publishes them and deletes them once the
+// pubsub has acknowledged the message.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"k8s.io/utils/clock"
+
+	contribmetadata "github.com/dapr/components-contrib/metadata"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/parked"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// MetadataPublishPubsub is the state store metadata property with the name of the pubsub the outbox publishes to.
+	MetadataPublishPubsub = "outboxPublishPubsub"
+	// MetadataPublishTopic is the state store metadata property with the topic the outbox publishes to.
+	MetadataPublishTopic = "outboxPublishTopic"
+
+	intentKeyPrefix = "outbox-intent-"
+	intentType      = "dapr.outbox.intent"
+)
+
+var log = logger.NewLogger("dapr.runtime.outbox")
+
+// Options contains the options for New.
+type Options struct {
+	AppID          string
+	ComponentStore *compstore.ComponentStore
+	// Publish forwards a message to a pubsub component.
+	Publish func(context.Context, *contribpubsub.PublishRequest) error
+	Clock   clock.WithTickerAndDelayedExecution
+}
+
+// Outbox records publish intents in state transactions and relays them to pubsub.
+type Outbox struct {
+	appID   string
+	publish func(context.Context, *contribpubsub.PublishRequest) error
+	relay   *parked.Relay
+
+	lock    sync.RWMutex
+	configs map[string]outboxConfig
+}
+
+type outboxConfig struct {
+	pubsub string
+	topic  string
+}
+
+// intent is the value saved in the state store for each message to publish.
+type intent struct {
+	parked.Header
+	Pubsub          string `json:"pubsub"`
+	Topic           string `json:"topic"`
+	Key             string `json:"key"`
+	Data            []byte `json:"data"`
+	DataContentType string `json:"dataContentType"`
+}
+
+// New returns a new Outbox.
+func New(opts Options) *Outbox {
+	o := &Outbox{
+		appID:   opts.AppID,
+		publish: opts.Publish,
+		configs: make(map[string]outboxConfig),
+	}
+	o.relay = parked.New(parked.Options{
+		Name:           "outbox intent",
+		Type:           intentType,
+		AppID:          opts.AppID,
+		ComponentStore: opts.ComponentStore,
+		Stores:         o.stores,
+		Relay:          o.relayIntent,
+		Clock:          opts.Clock,
+	})
+	return o
+}
+
+// AddOrUpdateOutbox enables the outbox for a state store if its metadata properties configure one.
+func (o *Outbox) AddOrUpdateOutbox(storeName string, props map[string]string) error {
+	var cfg outboxConfig
+	for k, v := range props {
+		switch {
+		case strings.EqualFold(k, MetadataPublishPubsub):
+			cfg.pubsub = v
+		case strings.EqualFold(k, MetadataPublishTopic):
+			cfg.topic = v
+		}
+	}
+
+	if cfg.pubsub == "" && cfg.topic == "" {
+		o.RemoveOutbox(storeName)
+		return nil
+	}
+	if cfg.pubsub == "" || cfg.topic == "" {
+		return fmt.Errorf("outbox for state store %s requires both %s and %s", storeName, MetadataPublishPubsub, MetadataPublishTopic)
+	}
+
+	if err := o.relay.Recoverable(storeName); err != nil {
+		log.Warnf("Outbox intents of state store %s which are not published before the sidecar stops will not be recovered: %v", storeName, err)
+	}
+
+	o.lock.Lock()
+	o.configs[storeName] = cfg
+	o.lock.Unlock()
+
+	// Intents left behind by a previous instance are published by the relay.
+	o.relay.Recover(storeName)
+
+	log.Infof("Outbox enabled for state store %s, publishing to topic %s of pubsub %s", storeName, cfg.topic, cfg.pubsub)
+	return nil
+}
+
+// RemoveOutbox disables the outbox for a state store.
+func (o *Outbox) RemoveOutbox(storeName string) {
+	o.lock.Lock()
+	delete(o.configs, storeName)
+	o.lock.Unlock()
+}
+
+// Enabled returns true if the outbox is enabled for the state store.
+func (o *Outbox) Enabled(storeName string) bool {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	_, ok := o.configs[storeName]
+	return ok
+}
+
+func (o *Outbox) stores() []string {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	stores := make([]string, 0, len(o.configs))
+	for storeName := range o.configs {
+		stores = append(stores, storeName)
+	}
+	return stores
+}
+
+// Intents returns the operations saving a publish intent for each upsert in the transaction.
+// The operations must be added to the same transaction, after the original
+// operations have been encrypted if needed: intents are encrypted here.
+func (o *Outbox) Intents(storeName string, operations []state.TransactionalStateOperation) ([]state.TransactionalStateOperation, error) {
+	o.lock.RLock()
+	cfg, ok := o.configs[storeName]
+	o.lock.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	intents := make([]state.TransactionalStateOperation, 0, len(operations))
+	for _, op := range operations {
+		req, ok := op.(state.SetRequest)
+		if !ok {
+			continue
+		}
+
+		data, contentType, err := intentData(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox intent for key %s: %w", req.Key, err)
+		}
+
+		id := uuid.NewString()
+		val, err := o.relay.Encode(storeName, intent{
+			Header: parked.Header{
+				Type:   intentType,
+				ID:     id,
+				Source: o.appID,
+			},
+			Pubsub:          cfg.pubsub,
+			Topic:           cfg.topic,
+			Key:             stateLoader.GetOriginalStateKey(req.Key),
+			Data:            data,
+			DataContentType: contentType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox intent for key %s: %w", req.Key, err)
+		}
+
+		key, err := stateLoader.GetModifiedStateKey(intentKeyPrefix+id, storeName, o.appID)
+		if err != nil {
+			return nil, err
+		}
+
+		intents = append(intents, state.SetRequest{
+			Key:   key,
+			Value: val,
+		})
+	}
+
+	return intents, nil
+}
+
+// Enqueue schedules the intents saved by a successful transaction for publishing.
+func (o *Outbox) Enqueue(storeName string, intents []state.TransactionalStateOperation) {
+	if len(intents) == 0 {
+		return
+	}
+
+	keys := make([]string, len(intents))
+	for i, op := range intents {
+		keys[i] = op.GetKey()
+	}
+	if n := o.relay.Queue(storeName, keys...); n < len(keys) {
+		log.Warnf("Outbox queue is full, %d intents of state store %s are left for recovery", len(keys)-n, storeName)
+	}
+}
+
+// Run relays the publish intents to pubsub until the context is canceled.
+func (o *Outbox) Run(ctx context.Context) {
+	o.relay.Run(ctx)
+}
+
+func (o *Outbox) relayIntent(ctx context.Context, _ string, val []byte) error {
+	var in intent
+	if err := json.Unmarshal(val, &in); err != nil {
+		return fmt.Errorf("failed to unmarshal intent: %w", err)
+	}
+
+	envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+		ID:              in.ID,
+		Source:          in.Source,
+		Topic:           in.Topic,
+		Pubsub:          in.Pubsub,
+		DataContentType: in.DataContentType,
+		Data:            in.Data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create cloud event: %w", err)
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cloud event: %w", err)
+	}
+
+	err = o.publish(ctx, &contribpubsub.PublishRequest{
+		PubsubName: in.Pubsub,
+		Topic:      in.Topic,
+		Data:       data,
+		Metadata:   map[string]string{},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+	return nil
+}
+
+// intentData returns the payload of the message published for an upsert and its content type.
+func intentData(req state.SetRequest) ([]byte, string, error) {
+	var data []byte
+	switch v := req.Value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		var err error
+		data, err = json.Marshal(v)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if ct := req.Metadata[contribmetadata.ContentType]; ct != "" {
+		return data, ct, nil
+	}
+	if req.ContentType != nil && *req.ContentType != "" {
+		return data, *req.ContentType, nil
+	}
+	if json.Valid(data) {
+		return data, "application/json", nil
+	}
+	return data, "text/plain", nil
+}
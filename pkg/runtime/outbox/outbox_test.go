@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestAddOrUpdateOutbox(t *testing.T) {
+	o := New(Options{AppID: "app", ComponentStore: compstore.New()})
+
+	t.Run("not configured", func(t *testing.T) {
+		require.NoError(t, o.AddOrUpdateOutbox("store", map[string]string{"foo": "bar"}))
+		assert.False(t, o.Enabled("store"))
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		require.Error(t, o.AddOrUpdateOutbox("store", map[string]string{MetadataPublishPubsub: "pubsub"}))
+		assert.False(t, o.Enabled("store"))
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		require.NoError(t, o.AddOrUpdateOutbox("store", map[string]string{
+			"OutboxPublishPubsub": "pubsub",
+			"outboxPublishTopic":  "topic",
+		}))
+		assert.True(t, o.Enabled("store"))
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		o.RemoveOutbox("store")
+		assert.False(t, o.Enabled("store"))
+	})
+}
+
+func TestIntents(t *testing.T) {
+	o := New(Options{AppID: "app", ComponentStore: compstore.New()})
+	require.NoError(t, o.AddOrUpdateOutbox("store", map[string]string{
+		MetadataPublishPubsub: "pubsub",
+		MetadataPublishTopic:  "topic",
+	}))
+
+	ops := []state.TransactionalStateOperation{
+		state.SetRequest{Key: "app||key1", Value: map[string]any{"a": 1}},
+		state.DeleteRequest{Key: "app||key2"},
+		state.SetRequest{Key: "app||key3", Value: []byte("hello"), Metadata: map[string]string{"contentType": "text/plain"}},
+	}
+
+	t.Run("store without outbox", func(t *testing.T) {
+		intents, err := o.Intents("other", ops)
+		require.NoError(t, err)
+		assert.Empty(t, intents)
+	})
+
+	t.Run("one intent per upsert", func(t *testing.T) {
+		intents, err := o.Intents("store", ops)
+		require.NoError(t, err)
+		require.Len(t, intents, 2)
+
+		var in intent
+		req := intents[0].(state.SetRequest)
+		assert.True(t, strings.HasPrefix(req.Key, "app||"+intentKeyPrefix))
+		require.NoError(t, json.Unmarshal(req.Value.(json.RawMessage), &in))
+		assert.Equal(t, intentType, in.Type)
+		assert.Equal(t, "app", in.Source)
+		assert.Equal(t, "pubsub", in.Pubsub)
+		assert.Equal(t, "topic", in.Topic)
+		assert.Equal(t, "key1", in.Key)
+		assert.Equal(t, "application/json", in.DataContentType)
+		assert.JSONEq(t, `{"a":1}`, string(in.Data))
+
+		req = intents[1].(state.SetRequest)
+		require.NoError(t, json.Unmarshal(req.Value.(json.RawMessage), &in))
+		assert.Equal(t, "key3", in.Key)
+		assert.Equal(t, "text/plain", in.DataContentType)
+		assert.Equal(t, "hello", string(in.Data))
+	})
+}
+
+func TestRun(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+
+	published := make(chan *contribpubsub.PublishRequest, 10)
+	var fail atomic.Bool
+	var failures atomic.Int32
+	clock := clocktesting.NewFakeClock(time.Now())
+	o := New(Options{
+		AppID:          "app",
+		ComponentStore: compStore,
+		Clock:          clock,
+		Publish: func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			if fail.Load() {
+				failures.Add(1)
+				return errors.New("publish failed")
+			}
+			published <- req
+			return nil
+		},
+	})
+	require.NoError(t, o.AddOrUpdateOutbox("store", map[string]string{
+		MetadataPublishPubsub: "pubsub",
+		MetadataPublishTopic:  "topic",
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go o.Run(ctx)
+
+	transaction := func(t *testing.T) []state.TransactionalStateOperation {
+		ops := []state.TransactionalStateOperation{
+			state.SetRequest{Key: "app||key", Value: map[string]any{"a": 1}},
+		}
+		intents, err := o.Intents("store", ops)
+		require.NoError(t, err)
+		require.NoError(t, store.Multi(ctx, &state.TransactionalStateRequest{
+			Operations: append(ops, intents...),
+		}))
+		o.Enqueue("store", intents)
+		return intents
+	}
+
+	assertPublished := func(t *testing.T, intentKey string) {
+		select {
+		case req := <-published:
+			assert.Equal(t, "pubsub", req.PubsubName)
+			assert.Equal(t, "topic", req.Topic)
+			var ce map[string]any
+			require.NoError(t, json.Unmarshal(req.Data, &ce))
+			assert.Equal(t, "app", ce["source"])
+			assert.Equal(t, map[string]any{"a": float64(1)}, ce["data"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("intent was not published")
+		}
+
+		assert.Eventually(t, func() bool {
+			res, err := store.Get(ctx, &state.GetRequest{Key: intentKey})
+			return err == nil && res.Data == nil
+		}, 5*time.Second, 10*time.Millisecond, "intent was not deleted")
+	}
+
+	t.Run("intent is published and deleted", func(t *testing.T) {
+		intents := transaction(t)
+		assertPublished(t, intents[0].GetKey())
+	})
+
+	t.Run("intent is retried when publishing fails", func(t *testing.T) {
+		fail.Store(true)
+		intents := transaction(t)
+
+		assert.Eventually(t, func() bool {
+			return failures.Load() > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		res, err := store.Get(ctx, &state.GetRequest{Key: intents[0].GetKey()})
+		require.NoError(t, err)
+		assert.NotNil(t, res.Data)
+
+		// Step the clock until the retry is scheduled and fires
+		fail.Store(false)
+		assert.Eventually(t, func() bool {
+			clock.Step(time.Second)
+			return len(published) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		assertPublished(t, intents[0].GetKey())
+	})
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parked relays records parked in state stores, such as outbox
+// intents and delayed messages, once they are due.
+// Records are claimed with a lease before being relayed, so replicas of the
+// same app don't relay them twice, and deleted once relayed. Records left
+// behind by a previous instance are found again by querying the state store.
+package parked
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	retryInterval    = 5 * time.Second
+	recoveryInterval = time.Minute
+	recoveryPageSize = 100
+	// leaseDuration is how long an instance owns a record it is relaying.
+	// Other replicas skip claimed records until the lease expires.
+	leaseDuration = time.Minute
+	// scheduleHorizon is how far ahead records get a timer.
+	// Records due later are scheduled by recovery.
+	scheduleHorizon = 2 * recoveryInterval
+	// maxPending is the maximum number of records queued, and of timers, in memory.
+	// Records which don't fit are left in the state store for recovery.
+	maxPending = 10_000
+)
+
+var log = logger.NewLogger("dapr.runtime.parked")
+
+// Header contains the fields of a record used by the relay.
+// Records embed it, so the fields are saved at the top level of their value.
+type Header struct {
+	Type         string     `json:"type"`
+	ID           string     `json:"id"`
+	Source       string     `json:"source"`
+	ClaimedBy    string     `json:"claimedBy,omitempty"`
+	ClaimedUntil *time.Time `json:"claimedUntil,omitempty"`
+}
+
+// Options contains the options for New.
+type Options struct {
+	// Name describes the records in logs, e.g. "outbox intent".
+	Name string
+	// Type is the type of the records, used to find them in the state stores.
+	Type           string
+	AppID          string
+	ComponentStore *compstore.ComponentStore
+	// Stores returns the names of the state stores records are parked in.
+	Stores func() []string
+	// Relay forwards a record, given its decrypted value. The record is deleted once relayed.
+	Relay func(ctx context.Context, storeName string, val []byte) error
+	// DueTime returns the time at which a recovered record is due, given its value.
+	// Records are due right away if nil.
+	DueTime func(val []byte) (time.Time, error)
+	Clock   clock.WithTickerAndDelayedExecution
+}
+
+// Relay relays the records parked in state stores.
+type Relay struct {
+	id         string
+	name       string
+	recordType string
+	appID      string
+	compStore  *compstore.ComponentStore
+	stores     func() []string
+	relay      func(context.Context, string, []byte) error
+	dueTime    func([]byte) (time.Time, error)
+	clock      clock.WithTickerAndDelayedExecution
+
+	lock        sync.Mutex
+	pending     []record
+	queued      map[record]struct{}
+	timers      map[record]clock.Timer
+	maxPending  int
+	unrecovered map[string]struct{}
+	notify      chan struct{}
+}
+
+type record struct {
+	store string
+	key   string
+}
+
+// New returns a new Relay.
+func New(opts Options) *Relay {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	return &Relay{
+		id:          uuid.NewString(),
+		name:        opts.Name,
+		recordType:  opts.Type,
+		appID:       opts.AppID,
+		compStore:   opts.ComponentStore,
+		stores:      opts.Stores,
+		relay:       opts.Relay,
+		dueTime:     opts.DueTime,
+		clock:       opts.Clock,
+		queued:      make(map[record]struct{}),
+		timers:      make(map[record]clock.Timer),
+		maxPending:  maxPending,
+		unrecovered: make(map[string]struct{}),
+		notify:      make(chan struct{}, 1),
+	}
+}
+
+// Recoverable returns an error if the records parked in the state store can't be found again after a restart.
+// Recovery requires a state store which supports queries and doesn't encrypt values.
+func (r *Relay) Recoverable(storeName string) error {
+	store, ok := r.compStore.GetStateStore(storeName)
+	if !ok {
+		return fmt.Errorf("state store %s not found", storeName)
+	}
+	if _, ok = store.(state.Querier); !ok {
+		return fmt.Errorf("state store %s does not support queries", storeName)
+	}
+	if encryption.EncryptedStateStore(storeName) {
+		return fmt.Errorf("state store %s encrypts values, which can't be queried", storeName)
+	}
+	return nil
+}
+
+// Recover looks for the records parked in the state store by previous instances.
+func (r *Relay) Recover(storeName string) {
+	r.lock.Lock()
+	r.unrecovered[storeName] = struct{}{}
+	r.lock.Unlock()
+	r.signal()
+}
+
+// Encode returns the value to save in the state store for a record.
+// Values are saved as JSON, so they can be queried, unless the state store encrypts them.
+func (r *Relay) Encode(storeName string, rec any) (any, error) {
+	val, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	if encryption.EncryptedStateStore(storeName) {
+		return encryption.TryEncryptValue(storeName, val)
+	}
+	return json.RawMessage(val), nil
+}
+
+// Queue relays the records as soon as possible and returns the number of records queued.
+// Records which don't fit in the queue are left for recovery.
+func (r *Relay) Queue(storeName string, keys ...string) int {
+	r.lock.Lock()
+	n := r.queue(storeName, keys)
+	r.lock.Unlock()
+
+	if n > 0 {
+		r.signal()
+	}
+	return n
+}
+
+// queue must be called with the lock held.
+func (r *Relay) queue(storeName string, keys []string) int {
+	var n int
+	for _, key := range keys {
+		rec := record{store: storeName, key: key}
+		if _, ok := r.queued[rec]; ok {
+			n++
+			continue
+		}
+		if len(r.pending) >= r.maxPending {
+			break
+		}
+		r.pending = append(r.pending, rec)
+		r.queued[rec] = struct{}{}
+		n++
+	}
+	return n
+}
+
+// Schedule relays the record when it is due.
+// Records due after the scheduling horizon, or which don't fit, are left for recovery.
+func (r *Relay) Schedule(storeName, key string, dueTime time.Time) {
+	d := dueTime.Sub(r.clock.Now())
+	if d <= 0 {
+		r.Queue(storeName, key)
+		return
+	}
+	if d > scheduleHorizon {
+		return
+	}
+
+	rec := record{store: storeName, key: key}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.timers[rec]; ok {
+		return
+	}
+	if len(r.timers) >= r.maxPending {
+		return
+	}
+
+	var t clock.Timer
+	t = r.clock.AfterFunc(d, func() {
+		r.lock.Lock()
+		if r.timers[rec] != t {
+			r.lock.Unlock()
+			return
+		}
+		delete(r.timers, rec)
+		n := r.queue(storeName, []string{key})
+		r.lock.Unlock()
+
+		if n > 0 {
+			r.signal()
+		}
+	})
+	r.timers[rec] = t
+}
+
+// Unschedule stops the timer of the record, if any.
+func (r *Relay) Unschedule(storeName, key string) {
+	rec := record{store: storeName, key: key}
+	r.lock.Lock()
+	if t, ok := r.timers[rec]; ok {
+		t.Stop()
+		delete(r.timers, rec)
+	}
+	r.lock.Unlock()
+}
+
+// Find returns up to limit records parked by this app whose top-level fields are equal to the given values.
+func (r *Relay) Find(ctx context.Context, storeName string, fields map[string]string, limit int) ([]state.QueryItem, error) {
+	if err := r.Recoverable(storeName); err != nil {
+		return nil, err
+	}
+	store, _ := r.compStore.GetStateStore(storeName)
+	querier := store.(state.Querier)
+
+	var items []state.QueryItem
+	var token string
+	for {
+		q, err := r.query(fields, token)
+		if err != nil {
+			return nil, err
+		}
+		res, err := querier.Query(ctx, &state.QueryRequest{Query: q})
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return items, nil
+		}
+		for _, item := range res.Results {
+			if item.Error == "" {
+				items = append(items, item)
+			}
+		}
+		if len(items) >= limit {
+			return items[:limit], nil
+		}
+		if res.Token == "" || len(res.Results) == 0 {
+			return items, nil
+		}
+		token = res.Token
+	}
+}
+
+// Run relays the records until the context is canceled.
+func (r *Relay) Run(ctx context.Context) {
+	t := r.clock.NewTicker(recoveryInterval)
+	defer t.Stop()
+
+	for {
+		r.recover(ctx)
+		r.relayPending(ctx)
+
+		select {
+		case <-ctx.Done():
+			r.lock.Lock()
+			for rec, t := range r.timers {
+				t.Stop()
+				delete(r.timers, rec)
+			}
+			r.lock.Unlock()
+			return
+		case <-r.notify:
+		case <-t.C():
+			stores := r.stores()
+			r.lock.Lock()
+			for _, storeName := range stores {
+				r.unrecovered[storeName] = struct{}{}
+			}
+			r.lock.Unlock()
+		}
+	}
+}
+
+func (r *Relay) signal() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// relayPending relays all queued records.
+func (r *Relay) relayPending(ctx context.Context) {
+	for {
+		r.lock.Lock()
+		if len(r.pending) == 0 {
+			r.lock.Unlock()
+			return
+		}
+		rec := r.pending[0]
+		r.pending = r.pending[1:]
+		delete(r.queued, rec)
+		r.lock.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := r.relayRecord(ctx, rec); err != nil {
+			log.Warnf("Failed to relay %s %s of state store %s, retrying in %v: %v", r.name, rec.key, rec.store, retryInterval, err)
+			r.Schedule(rec.store, rec.key, r.clock.Now().Add(retryInterval))
+		}
+	}
+}
+
+func (r *Relay) relayRecord(ctx context.Context, rec record) error {
+	store, ok := r.compStore.GetStateStore(rec.store)
+	if !ok {
+		log.Warnf("State store %s not found, dropping %s %s", rec.store, r.name, rec.key)
+		return nil
+	}
+
+	res, err := store.Get(ctx, &state.GetRequest{Key: rec.key})
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", r.name, err)
+	}
+	if res == nil || len(res.Data) == 0 {
+		// Canceled or already relayed.
+		return nil
+	}
+
+	val := res.Data
+	if encryption.EncryptedStateStore(rec.store) {
+		val, err = encryption.TryDecryptValue(rec.store, val)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", r.name, err)
+		}
+	}
+
+	var h Header
+	if err = json.Unmarshal(val, &h); err != nil || h.Type != r.recordType {
+		log.Warnf("Dropping malformed %s %s of state store %s", r.name, rec.key, rec.store)
+		return nil
+	}
+
+	claimed, err := r.claim(ctx, store, rec, val, &h, res.ETag)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		log.Debugf("The %s %s of state store %s is claimed by another instance", r.name, rec.key, rec.store)
+		return nil
+	}
+
+	if err = r.relay(ctx, rec.store, val); err != nil {
+		return err
+	}
+
+	err = store.Delete(ctx, &state.DeleteRequest{Key: rec.key})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s after relaying it: %w", r.name, err)
+	}
+	return nil
+}
+
+// claim saves the record with a lease owned by this instance, so other replicas don't relay it too.
+// It returns false if the record is claimed by another instance.
+func (r *Relay) claim(ctx context.Context, store state.Store, rec record, val []byte, h *Header, etag *string) (bool, error) {
+	now := r.clock.Now()
+	if h.ClaimedBy != "" && h.ClaimedBy != r.id && h.ClaimedUntil != nil && now.Before(*h.ClaimedUntil) {
+		return false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(val, &fields); err != nil {
+		return false, fmt.Errorf("failed to unmarshal %s: %w", r.name, err)
+	}
+	fields["claimedBy"], _ = json.Marshal(r.id)
+	fields["claimedUntil"], _ = json.Marshal(now.Add(leaseDuration))
+
+	claimed, err := r.Encode(rec.store, fields)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode %s: %w", r.name, err)
+	}
+
+	err = store.Set(ctx, &state.SetRequest{
+		Key:   rec.key,
+		Value: claimed,
+		ETag:  etag,
+		Options: state.SetStateOption{
+			Concurrency: state.FirstWrite,
+		},
+	})
+	if err != nil {
+		var etagErr *state.ETagError
+		if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim %s: %w", r.name, err)
+	}
+	return true, nil
+}
+
+// recover schedules the records found in the state stores awaiting recovery.
+// State stores which aren't recoverable are skipped: their owners report it when they are configured.
+func (r *Relay) recover(ctx context.Context) {
+	r.lock.Lock()
+	unrecovered := r.unrecovered
+	r.unrecovered = make(map[string]struct{})
+	r.lock.Unlock()
+
+	for _, storeName := range r.stores() {
+		if _, ok := unrecovered[storeName]; !ok || r.Recoverable(storeName) != nil {
+			continue
+		}
+
+		n, err := r.recoverStore(ctx, storeName)
+		if err != nil {
+			log.Warnf("Failed to recover the %ss of state store %s: %v", r.name, storeName, err)
+			continue
+		}
+		if n > 0 {
+			log.Infof("Recovered %d %ss from state store %s", n, r.name, storeName)
+		}
+	}
+}
+
+func (r *Relay) recoverStore(ctx context.Context, storeName string) (int, error) {
+	r.lock.Lock()
+	limit := r.maxPending - len(r.pending)
+	r.lock.Unlock()
+	if limit <= 0 {
+		// The remaining records are recovered on the next tick.
+		return 0, nil
+	}
+
+	items, err := r.Find(ctx, storeName, nil, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	now := r.clock.Now()
+	var n int
+	for _, item := range items {
+		dueTime := now
+		if r.dueTime != nil {
+			dueTime, err = r.dueTime(item.Data)
+			if err != nil {
+				log.Warnf("Skipping malformed %s %s of state store %s: %v", r.name, item.Key, storeName, err)
+				continue
+			}
+		}
+		r.Schedule(storeName, item.Key, dueTime)
+		n++
+	}
+	return n, nil
+}
+
+func (r *Relay) query(fields map[string]string, token string) (query.Query, error) {
+	filters := []any{
+		map[string]any{"EQ": map[string]any{"type": r.recordType}},
+		map[string]any{"EQ": map[string]any{"source": r.appID}},
+	}
+	for k, v := range fields {
+		filters = append(filters, map[string]any{"EQ": map[string]any{k: v}})
+	}
+	raw := map[string]any{
+		"filter": map[string]any{"AND": filters},
+		"page": map[string]any{
+			"limit": recoveryPageSize,
+			"token": token,
+		},
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return query.Query{}, err
+	}
+
+	var q query.Query
+	if err = json.Unmarshal(b, &q); err != nil {
+		return query.Query{}, errors.New("failed to build query: " + err.Error())
+	}
+	return q, nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parked
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+const testType = "dapr.test.record"
+
+type testRecord struct {
+	Header
+	Value   string    `json:"value"`
+	DueTime time.Time `json:"dueTime"`
+}
+
+func newTestRelay(t *testing.T, store state.Store, clock *clocktesting.FakeClock, relayed chan<- string) *Relay {
+	t.Helper()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+	return New(Options{
+		Name:           "test record",
+		Type:           testType,
+		AppID:          "app",
+		ComponentStore: compStore,
+		Stores:         func() []string { return []string{"store"} },
+		Relay: func(_ context.Context, _ string, val []byte) error {
+			var rec testRecord
+			if err := json.Unmarshal(val, &rec); err != nil {
+				return err
+			}
+			relayed <- rec.Value
+			return nil
+		},
+		DueTime: func(val []byte) (time.Time, error) {
+			var rec testRecord
+			err := json.Unmarshal(val, &rec)
+			return rec.DueTime, err
+		},
+		Clock: clock,
+	})
+}
+
+func park(t *testing.T, r *Relay, store state.Store, rec testRecord) string {
+	t.Helper()
+	rec.Type = testType
+	rec.Source = "app"
+	val, err := r.Encode("store", rec)
+	require.NoError(t, err)
+	key := "app||" + rec.ID
+	require.NoError(t, store.Set(context.Background(), &state.SetRequest{Key: key, Value: val}))
+	return key
+}
+
+func TestQueue(t *testing.T) {
+	r := New(Options{ComponentStore: compstore.New()})
+	r.maxPending = 3
+
+	assert.Equal(t, 2, r.Queue("store", "a", "b"))
+	assert.Equal(t, 2, r.Queue("store", "b", "c", "d"), "queued records count without taking space")
+	assert.Equal(t, 0, r.Queue("store", "e"))
+	assert.Len(t, r.pending, 3)
+}
+
+func TestSchedule(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	r := New(Options{ComponentStore: compstore.New(), Clock: clock})
+	r.maxPending = 2
+
+	r.Schedule("store", "later", clock.Now().Add(scheduleHorizon+time.Second))
+	assert.Empty(t, r.timers, "records after the horizon are left for recovery")
+
+	r.Schedule("store", "a", clock.Now().Add(time.Second))
+	r.Schedule("store", "b", clock.Now().Add(time.Second))
+	r.Schedule("store", "c", clock.Now().Add(time.Second))
+	assert.Len(t, r.timers, 2)
+
+	r.Unschedule("store", "b")
+	assert.Len(t, r.timers, 1)
+
+	r.Schedule("store", "due", clock.Now())
+	assert.Len(t, r.pending, 1)
+
+	clock.Step(time.Second)
+	assert.Eventually(t, func() bool {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		return len(r.pending) == 2 && len(r.timers) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestRun(t *testing.T) {
+	store := daprt.NewFakeQueryStateStore()
+	clock := clocktesting.NewFakeClock(time.Now())
+	relayed := make(chan string, 10)
+	r := newTestRelay(t, store, clock, relayed)
+
+	due := park(t, r, store, testRecord{Header: Header{ID: "1"}, Value: "due", DueTime: clock.Now()})
+	later := park(t, r, store, testRecord{Header: Header{ID: "2"}, Value: "later", DueTime: clock.Now().Add(time.Minute)})
+	claimedUntil := clock.Now().Add(leaseDuration + 30*time.Second)
+	claimed := park(t, r, store, testRecord{Header: Header{ID: "3", ClaimedBy: "other", ClaimedUntil: &claimedUntil}, Value: "claimed"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Recover("store")
+	go r.Run(ctx)
+
+	assertRelayed := func(t *testing.T, value, key string) {
+		t.Helper()
+		select {
+		case v := <-relayed:
+			assert.Equal(t, value, v)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("record %s was not relayed", value)
+		}
+		assert.Eventually(t, func() bool {
+			res, err := store.Get(ctx, &state.GetRequest{Key: key})
+			return err == nil && res.Data == nil
+		}, 5*time.Second, 10*time.Millisecond, "record was not deleted")
+	}
+
+	t.Run("recovered records are relayed when due", func(t *testing.T) {
+		assertRelayed(t, "due", due)
+
+		select {
+		case v := <-relayed:
+			t.Fatalf("record %s was relayed before it was due or while claimed by another instance", v)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		clock.Step(time.Minute)
+		assertRelayed(t, "later", later)
+	})
+
+	t.Run("claimed records are relayed once the lease expires", func(t *testing.T) {
+		res, err := store.Get(ctx, &state.GetRequest{Key: claimed})
+		require.NoError(t, err)
+		assert.NotNil(t, res.Data)
+
+		assert.Eventually(t, func() bool {
+			clock.Step(recoveryInterval)
+			return len(relayed) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		assertRelayed(t, "claimed", claimed)
+	})
+}
+
+func TestClaim(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	clock := clocktesting.NewFakeClock(time.Now())
+	r := newTestRelay(t, store, clock, nil)
+	ctx := context.Background()
+
+	get := func(t *testing.T, key string) ([]byte, *Header, *string) {
+		t.Helper()
+		res, err := store.Get(ctx, &state.GetRequest{Key: key})
+		require.NoError(t, err)
+		var h Header
+		require.NoError(t, json.Unmarshal(res.Data, &h))
+		return res.Data, &h, res.ETag
+	}
+	claim := func(t *testing.T, r *Relay, key string) bool {
+		t.Helper()
+		val, h, etag := get(t, key)
+		claimed, err := r.claim(ctx, store, record{store: "store", key: key}, val, h, etag)
+		require.NoError(t, err)
+		return claimed
+	}
+
+	t.Run("unclaimed record is claimed", func(t *testing.T) {
+		key := park(t, r, store, testRecord{Header: Header{ID: "1"}, Value: "v"})
+		assert.True(t, claim(t, r, key))
+
+		val, h, _ := get(t, key)
+		assert.Equal(t, r.id, h.ClaimedBy)
+		require.NotNil(t, h.ClaimedUntil)
+		assert.True(t, h.ClaimedUntil.After(clock.Now()))
+
+		var rec testRecord
+		require.NoError(t, json.Unmarshal(val, &rec))
+		assert.Equal(t, "v", rec.Value, "claim keeps the other fields")
+	})
+
+	t.Run("record claimed by another instance is skipped", func(t *testing.T) {
+		until := clock.Now().Add(time.Second)
+		key := park(t, r, store, testRecord{Header: Header{ID: "2", ClaimedBy: "other", ClaimedUntil: &until}})
+		assert.False(t, claim(t, r, key))
+	})
+
+	t.Run("expired claim is taken over", func(t *testing.T) {
+		until := clock.Now().Add(-time.Second)
+		key := park(t, r, store, testRecord{Header: Header{ID: "3", ClaimedBy: "other", ClaimedUntil: &until}})
+		assert.True(t, claim(t, r, key))
+	})
+
+	t.Run("concurrent claim loses", func(t *testing.T) {
+		key := park(t, r, store, testRecord{Header: Header{ID: "4"}})
+		val, h, etag := get(t, key)
+
+		claimed, err := newTestRelay(t, store, clock, nil).claim(ctx, store, record{store: "store", key: key}, val, h, etag)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = r.claim(ctx, store, record{store: "store", key: key}, val, h, etag)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+}
@@ -49,6 +49,7 @@ const (
 var log = logger.NewLogger("dapr.runtime.processor.binding")
 
 type Options struct {
+	ID     string
 	IsHTTP bool
 
 	Registry       *compbindings.Registry
@@ -60,6 +61,7 @@ type Options struct {
 }
 
 type binding struct {
+	appID  string
 	isHTTP bool
 
 	registry    *compbindings.Registry
@@ -74,10 +76,16 @@ type binding struct {
 
 	subscribeBindingList []string
 	inputCancel          context.CancelFunc
+	inputCtx             context.Context
+	readCancels          map[string]context.CancelFunc
+	subscribedBindings   map[string]struct{}
+	inputDedup           map[string]*inputDeduplication
+	inputDedupLock       sync.RWMutex
 }
 
 func New(opts Options) *binding {
 	return &binding{
+		appID:       opts.ID,
 		registry:    opts.Registry,
 		compStore:   opts.ComponentStore,
 		meta:        opts.Meta,
@@ -85,6 +93,7 @@ func New(opts Options) *binding {
 		resiliency:  opts.Resiliency,
 		tracingSpec: opts.TracingSpec,
 		grpc:        opts.GRPC,
+		inputDedup:  make(map[string]*inputDeduplication),
 	}
 }
 
@@ -128,10 +137,17 @@ func (b *binding) Close(comp compapi.Component) error {
 
 	inbinding, ok := b.compStore.GetInputBinding(comp.Name)
 	if ok {
+		if cancel, ok := b.readCancels[comp.Name]; ok {
+			cancel()
+			delete(b.readCancels, comp.Name)
+		}
 		if err := inbinding.Close(); err != nil {
 			errs = append(errs, err)
 		} else {
 			b.compStore.DeleteInputBinding(comp.Name)
+			b.inputDedupLock.Lock()
+			delete(b.inputDedup, comp.Name)
+			b.inputDedupLock.Unlock()
 		}
 	}
 
@@ -166,7 +182,13 @@ func (b *binding) initInputBinding(ctx context.Context, comp compapi.Component)
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "creation", comp.ObjectMeta.Name)
 		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
 	}
-	err = binding.Init(ctx, bindings.Metadata{Base: b.meta.ToBaseMetadata(comp)})
+	base := b.meta.ToBaseMetadata(comp)
+	dedup, err := b.newInputDeduplication(comp.Name, base.Properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+	err = binding.Init(ctx, bindings.Metadata{Base: base})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
@@ -181,6 +203,13 @@ func (b *binding) initInputBinding(ctx context.Context, comp compapi.Component)
 		}
 	}
 	b.compStore.AddInputBinding(comp.Name, binding)
+	b.inputDedupLock.Lock()
+	if dedup != nil {
+		b.inputDedup[comp.Name] = dedup
+	} else {
+		delete(b.inputDedup, comp.Name)
+	}
+	b.inputDedupLock.Unlock()
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 	return nil
 }
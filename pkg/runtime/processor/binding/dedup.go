@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/runtime/dedup"
+)
+
+const (
+	// MetadataDeduplicationStateStore is the input binding metadata property with the name of the
+	// state store where the IDs of the events processed by the app are recorded.
+	MetadataDeduplicationStateStore = "deduplicationStateStore"
+	// MetadataDeduplicationTTLInSeconds is the input binding metadata property with how long the IDs
+	// of processed events are recorded for.
+	MetadataDeduplicationTTLInSeconds = "deduplicationTTLInSeconds"
+	// MetadataDeduplicationIDField is the input binding metadata property with the name of the event
+	// metadata key, or top-level field of the JSON event data, holding the event ID.
+	MetadataDeduplicationIDField = "deduplicationIDField"
+
+	defaultDeduplicationIDField = "id"
+)
+
+// inputDeduplication detects the events of an input binding already processed by the app.
+type inputDeduplication struct {
+	deduplicator *dedup.Deduplicator
+	idField      string
+}
+
+// newInputDeduplication returns the duplicate detection for an input binding, or nil if it is not configured.
+func (b *binding) newInputDeduplication(bindingName string, props map[string]string) (*inputDeduplication, error) {
+	var storeName, ttl, idField string
+	for k, v := range props {
+		switch {
+		case strings.EqualFold(k, MetadataDeduplicationStateStore):
+			storeName = v
+		case strings.EqualFold(k, MetadataDeduplicationTTLInSeconds):
+			ttl = v
+		case strings.EqualFold(k, MetadataDeduplicationIDField):
+			idField = v
+		}
+	}
+	if storeName == "" {
+		return nil, nil
+	}
+
+	var ttlInSeconds int
+	if ttl != "" {
+		var err error
+		ttlInSeconds, err = strconv.Atoi(ttl)
+		if err != nil || ttlInSeconds < 0 {
+			return nil, fmt.Errorf("invalid value for %s: %s", MetadataDeduplicationTTLInSeconds, ttl)
+		}
+	}
+	if idField == "" {
+		idField = defaultDeduplicationIDField
+	}
+
+	return &inputDeduplication{
+		deduplicator: dedup.New(dedup.Options{
+			AppID:          b.appID,
+			ComponentStore: b.compStore,
+			StoreName:      storeName,
+			Scope:          bindingName,
+			TTL:            time.Duration(ttlInSeconds) * time.Second,
+		}),
+		idField: idField,
+	}, nil
+}
+
+// eventID returns the ID of an input binding event, looking first at the event metadata and then at the
+// top-level fields of the event data if it is a JSON object. It returns an empty string if there is no ID.
+func (d *inputDeduplication) eventID(data []byte, metadata map[string]string) string {
+	if id := metadata[d.idField]; id != "" {
+		return id
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	switch v := fields[d.idField].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -37,6 +37,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	rtdedup "github.com/dapr/dapr/pkg/runtime/dedup"
 )
 
 func (b *binding) StartReadingFromBindings(ctx context.Context) error {
@@ -54,6 +55,9 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 
 	// Input bindings are stopped via cancellation of the main runtime's context
 	ctx, b.inputCancel = context.WithCancel(ctx)
+	b.inputCtx = ctx
+	b.readCancels = make(map[string]context.CancelFunc)
+	b.subscribedBindings = make(map[string]struct{})
 
 	comps := b.compStore.ListComponents()
 	bindings := make(map[string]componentsV1alpha1.Component)
@@ -82,8 +86,14 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 			log.Infof("app has not subscribed to binding %s.", name)
 			continue
 		}
+		b.subscribedBindings[name] = struct{}{}
 
-		if err := b.readFromBinding(ctx, name, bind); err != nil {
+		if b.compStore.IsInputBindingPaused(name) {
+			log.Infof("not reading from input binding %s as it is paused", name)
+			continue
+		}
+
+		if err := b.startReadingFromBinding(name, bind); err != nil {
 			log.Errorf("error reading from input binding %s: %s", name, err)
 			continue
 		}
@@ -101,6 +111,71 @@ func (b *binding) StopReadingFromBindings() {
 	}
 
 	b.inputCancel = nil
+	b.inputCtx = nil
+	b.readCancels = nil
+	b.subscribedBindings = nil
+}
+
+// startReadingFromBinding reads from an input binding until it is paused or the
+// bindings are stopped. Don't lock as caller is expected to do so.
+func (b *binding) startReadingFromBinding(name string, bind bindings.InputBinding) error {
+	ctx, cancel := context.WithCancel(b.inputCtx)
+	if err := b.readFromBinding(ctx, name, bind); err != nil {
+		cancel()
+		return err
+	}
+	b.readCancels[name] = cancel
+	return nil
+}
+
+// PauseInputBinding stops reading from an input binding until it is resumed.
+// Events which are being delivered to the app when the binding is paused are not interrupted.
+func (b *binding) PauseInputBinding(name string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.compStore.GetInputBinding(name); !ok {
+		return fmt.Errorf("input binding '%s' not found", name)
+	}
+
+	b.compStore.SetInputBindingPaused(name, true)
+
+	if cancel, ok := b.readCancels[name]; ok {
+		log.Infof("pausing input binding %s", name)
+		cancel()
+		delete(b.readCancels, name)
+	}
+
+	return nil
+}
+
+// ResumeInputBinding reads again from a paused input binding.
+func (b *binding) ResumeInputBinding(name string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	bind, ok := b.compStore.GetInputBinding(name)
+	if !ok {
+		return fmt.Errorf("input binding '%s' not found", name)
+	}
+
+	if !b.compStore.IsInputBindingPaused(name) {
+		return nil
+	}
+	b.compStore.SetInputBindingPaused(name, false)
+
+	// The binding is read from when the bindings are started, if the app is subscribed to it.
+	if _, ok = b.subscribedBindings[name]; !ok || b.inputCtx == nil {
+		return nil
+	}
+
+	log.Infof("resuming input binding %s", name)
+	if err := b.startReadingFromBinding(name, bind); err != nil {
+		b.compStore.SetInputBindingPaused(name, true)
+		return fmt.Errorf("error reading from input binding %s: %w", name, err)
+	}
+
+	return nil
 }
 
 func (b *binding) sendBatchOutputBindingsParallel(ctx context.Context, to []string, data []byte) {
@@ -328,7 +403,10 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 				return rResp, rErr
 			}
 			if rResp != nil && rResp.Status().Code != http.StatusOK {
-				return rResp, fmt.Errorf("%w, status %d", respErr, rResp.Status().Code)
+				return rResp, resiliency.HTTPStatusError{
+					StatusCode: int(rResp.Status().Code),
+					Err:        fmt.Errorf("%w, status %d", respErr, rResp.Status().Code),
+				}
 			}
 			return rResp, nil
 		})
@@ -378,17 +456,51 @@ func (b *binding) readFromBinding(readCtx context.Context, name string, binding
 			return nil, nil
 		}
 
+		b.inputDedupLock.RLock()
+		dedup := b.inputDedup[name]
+		b.inputDedupLock.RUnlock()
+
+		var eventID string
+		if dedup != nil {
+			eventID = dedup.eventID(resp.Data, resp.Metadata)
+		}
+		if eventID != "" {
+			claimed, dErr := dedup.deduplicator.Claim(ctx, eventID)
+			switch {
+			case errors.Is(dErr, rtdedup.ErrInProgress):
+				log.Debugf("event %s from binding %s is being processed by another delivery, retrying later", eventID, name)
+				return nil, dErr
+			case dErr != nil:
+				log.Warnf("failed to check for duplicate event %s from binding %s, delivering it anyway: %v", eventID, name, dErr)
+			case !claimed:
+				log.Debugf("dropping duplicate event %s from binding %s", eventID, name)
+				diag.DefaultComponentMonitoring.InputBindingDuplicate(context.Background(), name)
+				return nil, nil
+			}
+		}
+
 		start := time.Now()
-		b, err := b.sendBindingEventToApp(ctx, name, resp.Data, resp.Metadata)
+		res, err := b.sendBindingEventToApp(ctx, name, resp.Data, resp.Metadata)
 		elapsed := diag.ElapsedSince(start)
 
 		diag.DefaultComponentMonitoring.InputBindingEvent(context.Background(), name, err == nil, elapsed)
 
 		if err != nil {
 			log.Debugf("error from app consumer for binding [%s]: %s", name, err)
+			if eventID != "" {
+				if dErr := dedup.deduplicator.Release(ctx, eventID); dErr != nil {
+					log.Warnf("failed to release event %s from binding %s: %v", eventID, name, dErr)
+				}
+			}
 			return nil, err
 		}
-		return b, nil
+
+		if eventID != "" {
+			if dErr := dedup.deduplicator.Record(ctx, eventID); dErr != nil {
+				log.Warnf("failed to record processed event %s from binding %s: %v", eventID, name, dErr)
+			}
+		}
+		return res, nil
 	})
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -172,6 +173,60 @@ func TestStartReadingFromBindings(t *testing.T) {
 	})
 }
 
+func TestPauseInputBinding(t *testing.T) {
+	mockAppChannel := new(channelt.MockAppChannel)
+	b := New(Options{
+		IsHTTP:         true,
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		Meta:           meta.New(meta.Options{}),
+	})
+	b.SetAppChannel(mockAppChannel)
+
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything).Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+
+	m := &rtmock.Binding{ReadErrorCh: make(chan bool, 1)}
+	b.compStore.AddInputBinding("test", m)
+
+	waitRead := func(t *testing.T) {
+		t.Helper()
+		select {
+		case readErr := <-m.ReadErrorCh:
+			assert.False(t, readErr)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "binding was not read from")
+		}
+	}
+
+	t.Run("unknown binding", func(t *testing.T) {
+		require.Error(t, b.PauseInputBinding("other"))
+		require.Error(t, b.ResumeInputBinding("other"))
+	})
+
+	require.NoError(t, b.PauseInputBinding("test"))
+	require.NoError(t, b.StartReadingFromBindings(context.Background()))
+	t.Cleanup(b.StopReadingFromBindings)
+
+	t.Run("paused binding is not read from", func(t *testing.T) {
+		assert.NotContains(t, b.readCancels, "test")
+		assert.Contains(t, b.subscribedBindings, "test")
+		assert.True(t, b.compStore.IsInputBindingPaused("test"))
+	})
+
+	t.Run("resume binding", func(t *testing.T) {
+		require.NoError(t, b.ResumeInputBinding("test"))
+		assert.Contains(t, b.readCancels, "test")
+		assert.False(t, b.compStore.IsInputBindingPaused("test"))
+		waitRead(t)
+	})
+
+	t.Run("pause binding", func(t *testing.T) {
+		require.NoError(t, b.PauseInputBinding("test"))
+		assert.NotContains(t, b.readCancels, "test")
+		assert.True(t, b.compStore.IsInputBindingPaused("test"))
+	})
+}
+
 func TestGetSubscribedBindingsGRPC(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -356,6 +411,46 @@ func TestReadInputBindings(t *testing.T) {
 		assert.Equal(t, string(rtmock.TestInputBindingData), mockBinding.Data)
 	})
 
+	t.Run("duplicate event is dropped", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		compStore := compstore.New()
+		compStore.AddStateStore("dedupstore", daprt.NewFakeStateStore())
+		b := New(Options{
+			ID:             "app",
+			IsHTTP:         true,
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compStore,
+			Meta:           meta.New(meta.Options{}),
+		})
+		b.SetAppChannel(mockAppChannel)
+
+		dedup, err := b.newInputDeduplication(testInputBindingName, map[string]string{
+			MetadataDeduplicationStateStore: "dedupstore",
+			MetadataDeduplicationIDField:    "eventid",
+		})
+		require.NoError(t, err)
+		b.inputDedup[testInputBindingName] = dedup
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+			WithRawDataString("OK").
+			WithContentType("application/json")
+		defer fakeResp.Close()
+
+		mockAppChannel.On("InvokeMethod", mock.MatchedBy(daprt.MatchContextInterface), matchDaprRequestMethod(testInputBindingMethod)).Return(fakeResp, nil)
+
+		b.compStore.AddInputBindingRoute(testInputBindingName, testInputBindingName)
+
+		for i := 0; i < 2; i++ {
+			mockBinding := rtmock.Binding{Metadata: map[string]string{"eventid": "1"}}
+			ch := make(chan bool, 1)
+			mockBinding.ReadErrorCh = ch
+			require.NoError(t, b.readFromBinding(context.Background(), testInputBindingName, &mockBinding))
+			assert.False(t, <-ch)
+		}
+
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	})
+
 	t.Run("start and stop reading", func(t *testing.T) {
 		mockAppChannel := new(channelt.MockAppChannel)
 		b := New(Options{
@@ -32,6 +32,8 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/outbox"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 
 	"github.com/dapr/dapr/pkg/runtime/processor/binding"
 	"github.com/dapr/dapr/pkg/runtime/processor/configuration"
@@ -42,6 +44,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/processor/secret"
 	"github.com/dapr/dapr/pkg/runtime/processor/state"
 	"github.com/dapr/dapr/pkg/runtime/processor/workflow"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/registry"
 )
 
@@ -101,15 +104,23 @@ type StateManager interface {
 type PubsubManager interface {
 	Publish(context.Context, *contribpubsub.PublishRequest) error
 	BulkPublish(context.Context, *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error)
+	SubscribeStream(context.Context, rtpubsub.StreamSubscription, rtpubsub.StreamHandler) error
+	CancelDelayedMessage(ctx context.Context, pubsubName, id string) error
+	ReplayDeadLetters(context.Context, rtpubsub.ReplayRequest) (int, error)
+	PauseSubscription(pubsubName, topic string) error
+	ResumeSubscription(pubsubName, topic string) error
 	SetAppChannel(channel.AppChannel)
 
 	StartSubscriptions(context.Context) error
 	StopSubscriptions()
+	WatchDeclarativeSubscriptions(context.Context) error
 	manager
 }
 
 type BindingManager interface {
 	SendToOutputBinding(context.Context, string, *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	PauseInputBinding(name string) error
+	ResumeInputBinding(name string) error
 	SetAppChannel(channel.AppChannel)
 
 	StartReadingFromBindings(context.Context) error
@@ -124,11 +135,22 @@ type Processor struct {
 	state     StateManager
 	pubsub    PubsubManager
 	binding   BindingManager
+	outbox    *outbox.Outbox
+	delayed   *delayed.Scheduler
 
 	lock sync.RWMutex
 }
 
 func New(opts Options) *Processor {
+	var publish func(context.Context, *contribpubsub.PublishRequest) error
+	delayed := delayed.New(delayed.Options{
+		AppID:          opts.ID,
+		ComponentStore: opts.ComponentStore,
+		Publish: func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+			return publish(ctx, req)
+		},
+	})
+
 	pubsub := pubsub.New(pubsub.Options{
 		ID:             opts.ID,
 		Namespace:      opts.Namespace,
@@ -143,6 +165,14 @@ func New(opts Options) *Processor {
 		GRPC:           opts.GRPC,
 		OperatorClient: opts.OperatorClient,
 		ResourcesPath:  opts.Standalone.ResourcesPath,
+		Delayed:        delayed,
+	})
+	publish = pubsub.Publish
+
+	outbox := outbox.New(outbox.Options{
+		AppID:          opts.ID,
+		ComponentStore: opts.ComponentStore,
+		Publish:        pubsub.Publish,
 	})
 
 	state := state.New(state.Options{
@@ -150,9 +180,11 @@ func New(opts Options) *Processor {
 		Registry:         opts.Registry.StateStores(),
 		ComponentStore:   opts.ComponentStore,
 		Meta:             opts.Meta,
+		Outbox:           outbox,
 	})
 
 	binding := binding.New(binding.Options{
+		ID:             opts.ID,
 		Registry:       opts.Registry.Bindings(),
 		ComponentStore: opts.ComponentStore,
 		Meta:           opts.Meta,
@@ -167,6 +199,8 @@ func New(opts Options) *Processor {
 		state:     state,
 		pubsub:    pubsub,
 		binding:   binding,
+		outbox:    outbox,
+		delayed:   delayed,
 		managers: map[components.Category]manager{
 			components.CategoryBindings: binding,
 			components.CategoryConfiguration: configuration.New(configuration.Options{
@@ -279,3 +313,17 @@ func (p *Processor) Binding() BindingManager {
 	defer p.lock.RUnlock()
 	return p.binding
 }
+
+// Outbox returns the transactional outbox of the state stores.
+func (p *Processor) Outbox() *outbox.Outbox {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.outbox
+}
+
+// Delayed returns the scheduler of the messages published with a delay.
+func (p *Processor) Delayed() *delayed.Scheduler {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.delayed
+}
@@ -671,12 +671,18 @@ func (p *pubsub) sendBulkToDeadLetter(ctx context.Context,
 		}
 		data = data[:n]
 	}
+	originalTopic := p.originalTopic(bscData.psName, msg.Topic)
+	entries := make([]contribpubsub.BulkMessageEntry, len(data))
+	for i, entry := range data {
+		entry.Event = runtimePubsub.WithOriginalTopic(entry.Event, originalTopic)
+		entries[i] = entry
+	}
 	bscData.bulkSubDiag.statusWiseDiag[string(contribpubsub.Drop)] += int64(len(data))
 	if bscData.bulkSubDiag.retryReported {
 		bscData.bulkSubDiag.statusWiseDiag[string(contribpubsub.Retry)] -= int64(len(data))
 	}
 	req := &contribpubsub.BulkPublishRequest{
-		Entries:    data,
+		Entries:    entries,
 		PubsubName: bscData.psName,
 		Topic:      deadLetterTopic,
 		Metadata:   msg.Metadata,
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"fmt"
+)
+
+// PauseSubscription stops consuming messages from the topic of a subscription until it is resumed.
+// Messages which are being delivered to the app when the subscription is paused are not interrupted.
+func (p *pubsub) PauseSubscription(pubsubName, topic string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.compStore.GetTopicRoutes()[pubsubName][topic]; !ok {
+		return fmt.Errorf("app is not subscribed to topic '%s' on pubsub '%s'", topic, pubsubName)
+	}
+
+	p.compStore.SetSubscriptionPaused(pubsubName, topic, true)
+
+	subKey := topicKey(pubsubName, topic)
+	if _, ok := p.topicCancels[subKey]; ok {
+		log.Infof("pausing subscription to topic %s on pubsub %s", topic, pubsubName)
+		p.unsubscribeTopic(subKey)
+	}
+
+	return nil
+}
+
+// ResumeSubscription subscribes again to the topic of a paused subscription.
+func (p *pubsub) ResumeSubscription(pubsubName, topic string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	route, ok := p.compStore.GetTopicRoutes()[pubsubName][topic]
+	if !ok {
+		return fmt.Errorf("app is not subscribed to topic '%s' on pubsub '%s'", topic, pubsubName)
+	}
+
+	if !p.compStore.IsSubscriptionPaused(pubsubName, topic) {
+		return nil
+	}
+	p.compStore.SetSubscriptionPaused(pubsubName, topic, false)
+
+	// The subscription is started with the other subscriptions.
+	if p.subscribeCtx == nil {
+		return nil
+	}
+
+	log.Infof("resuming subscription to topic %s on pubsub %s", topic, pubsubName)
+	if err := p.subscribeTopic(p.subscribeCtx, pubsubName, topic, route); err != nil {
+		p.compStore.SetSubscriptionPaused(pubsubName, topic, true)
+		return err
+	}
+
+	return nil
+}
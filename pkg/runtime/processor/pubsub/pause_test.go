@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/registry"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestPauseSubscription(t *testing.T) {
+	ps := New(Options{
+		ID:             TestRuntimeConfigID,
+		Registry:       registry.New(registry.NewOptions()).PubSubs(),
+		Meta:           meta.New(meta.Options{}),
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		IsHTTP:         true,
+	})
+	ps.registry.RegisterComponent(
+		func(_ logger.Logger) contribpubsub.PubSub {
+			return &mockSubscribePubSub{}
+		},
+		"mockPubSub",
+	)
+	ps.appChannel = new(channelt.MockAppChannel)
+
+	require.NoError(t, ps.Init(context.Background(), componentsV1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: TestPubsubName},
+		Spec: componentsV1alpha1.ComponentSpec{
+			Type:     "pubsub.mockPubSub",
+			Version:  "v1",
+			Metadata: daprt.GetFakeMetadataItems(),
+		},
+	}))
+	ps.compStore.SetTopicRoutes(map[string]compstore.TopicRoutes{
+		TestPubsubName: {
+			"topic0": {Rules: []*rtpubsub.Rule{{Path: "a"}}},
+			"topic1": {Rules: []*rtpubsub.Rule{{Path: "b"}}},
+		},
+	})
+	ctx := context.Background()
+	topic0 := topicKey(TestPubsubName, "topic0")
+	topic1 := topicKey(TestPubsubName, "topic1")
+
+	t.Run("unknown subscription", func(t *testing.T) {
+		require.Error(t, ps.PauseSubscription(TestPubsubName, "topic2"))
+		require.Error(t, ps.ResumeSubscription("other", "topic0"))
+	})
+
+	require.NoError(t, ps.PauseSubscription(TestPubsubName, "topic1"))
+	require.NoError(t, ps.StartSubscriptions(ctx))
+	t.Cleanup(ps.StopSubscriptions)
+
+	t.Run("paused subscription is not started", func(t *testing.T) {
+		assert.Contains(t, ps.topicCancels, topic0)
+		assert.NotContains(t, ps.topicCancels, topic1)
+		assert.True(t, ps.compStore.IsSubscriptionPaused(TestPubsubName, "topic1"))
+	})
+
+	t.Run("pause active subscription", func(t *testing.T) {
+		require.NoError(t, ps.PauseSubscription(TestPubsubName, "topic0"))
+		assert.NotContains(t, ps.topicCancels, topic0)
+		assert.True(t, ps.compStore.IsSubscriptionPaused(TestPubsubName, "topic0"))
+
+		// Pausing again is a no-op.
+		require.NoError(t, ps.PauseSubscription(TestPubsubName, "topic0"))
+	})
+
+	t.Run("resume subscriptions", func(t *testing.T) {
+		require.NoError(t, ps.ResumeSubscription(TestPubsubName, "topic0"))
+		require.NoError(t, ps.ResumeSubscription(TestPubsubName, "topic1"))
+		assert.Contains(t, ps.topicCancels, topic0)
+		assert.Contains(t, ps.topicCancels, topic1)
+		assert.False(t, ps.compStore.IsSubscriptionPaused(TestPubsubName, "topic0"))
+		assert.False(t, ps.compStore.IsSubscriptionPaused(TestPubsubName, "topic1"))
+
+		// Resuming a subscription which is not paused is a no-op.
+		require.NoError(t, ps.ResumeSubscription(TestPubsubName, "topic0"))
+		assert.Contains(t, ps.topicCancels, topic0)
+	})
+
+	t.Run("resume when stopped", func(t *testing.T) {
+		require.NoError(t, ps.PauseSubscription(TestPubsubName, "topic0"))
+		ps.StopSubscriptions()
+
+		require.NoError(t, ps.ResumeSubscription(TestPubsubName, "topic0"))
+		assert.Empty(t, ps.topicCancels)
+		assert.False(t, ps.compStore.IsSubscriptionPaused(TestPubsubName, "topic0"))
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/dapr/components-contrib/contenttype"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
@@ -37,6 +38,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 )
 
 // Publish is an adapter method for the runtime to pre-validate publish requests
@@ -55,6 +57,25 @@ func (p *pubsub) Publish(ctx context.Context, req *contribpubsub.PublishRequest)
 		return rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.id}
 	}
 
+	if v := ps.TopicSchemas[req.Topic]; v != nil {
+		contentType := ""
+		if req.ContentType != nil {
+			contentType = *req.ContentType
+		}
+		if err := validateOutgoing(v, req.Data, contentType, req.Metadata); err != nil {
+			return rtpubsub.SchemaValidationError{Topic: req.Topic, Err: err}
+		}
+	}
+
+	if p.delayed != nil {
+		var err error
+		req, err = p.delayed.Schedule(ctx, req)
+		if err != nil || req == nil {
+			return err
+		}
+	}
+
+	topic := req.Topic
 	if ps.NamespaceScoped {
 		req.Topic = p.namespace + req.Topic
 	}
@@ -65,9 +86,56 @@ func (p *pubsub) Publish(ctx context.Context, req *contribpubsub.PublishRequest)
 	_, err := policyRunner(func(ctx context.Context) (any, error) {
 		return nil, ps.Component.Publish(ctx, req)
 	})
+	if fallback := p.resiliency.ComponentFallbackPolicy(req.PubsubName, resiliency.Pubsub); fallback.ShouldFallback(err) {
+		return p.publishFallback(ctx, req, topic, fallback.Component, err)
+	}
+	return err
+}
+
+// publishFallback publishes a message to the alternate pubsub of a fallback policy, after publishing it failed with publishErr.
+// The error is returned as-is if the alternate pubsub doesn't exist or isn't allowed to publish to the topic.
+func (p *pubsub) publishFallback(ctx context.Context, req *contribpubsub.PublishRequest, topic string, pubsubName string, publishErr error) error {
+	ps, ok := p.compStore.GetPubSub(pubsubName)
+	if !ok {
+		log.Warnf("Fallback pubsub %s of pubsub %s not found", pubsubName, req.PubsubName)
+		return publishErr
+	}
+	if allowed := p.isOperationAllowed(pubsubName, topic, ps.ScopedPublishings); !allowed {
+		log.Warnf("Fallback pubsub %s of pubsub %s is not allowed to publish to topic %s", pubsubName, req.PubsubName, topic)
+		return publishErr
+	}
+	log.Debugf("Publishing to pubsub %s failed, using fallback pubsub %s: %v", req.PubsubName, pubsubName, publishErr)
+
+	fallbackReq := *req
+	fallbackReq.PubsubName = pubsubName
+	fallbackReq.Topic = topic
+	if ps.NamespaceScoped {
+		fallbackReq.Topic = p.namespace + topic
+	}
+
+	policyRunner := resiliency.NewRunner[any](ctx,
+		p.resiliency.ComponentOutboundPolicy(pubsubName, resiliency.Pubsub),
+	)
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		return nil, ps.Component.Publish(ctx, &fallbackReq)
+	})
 	return err
 }
 
+// CancelDelayedMessage cancels a message published with a delay which has not been published yet.
+func (p *pubsub) CancelDelayedMessage(ctx context.Context, pubsubName, id string) error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if _, ok := p.compStore.GetPubSub(pubsubName); !ok {
+		return rtpubsub.NotFoundError{PubsubName: pubsubName}
+	}
+	if p.delayed == nil {
+		return delayed.ErrNotFound
+	}
+	return p.delayed.Cancel(ctx, pubsubName, id)
+}
+
 func (p *pubsub) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -81,6 +149,17 @@ func (p *pubsub) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublish
 		return contribpubsub.BulkPublishResponse{}, rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.id}
 	}
 
+	if v := ps.TopicSchemas[req.Topic]; v != nil {
+		for _, entry := range req.Entries {
+			if err := validateOutgoing(v, entry.Event, entry.ContentType, req.Metadata); err != nil {
+				return contribpubsub.BulkPublishResponse{}, rtpubsub.SchemaValidationError{
+					Topic: req.Topic,
+					Err:   fmt.Errorf("entry %s: %w", entry.EntryId, err),
+				}
+			}
+		}
+	}
+
 	policyDef := p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub)
 
 	if contribpubsub.FeatureBulkPublish.IsPresent(ps.Component.Features()) {
@@ -93,6 +172,24 @@ func (p *pubsub) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublish
 	return rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, defaultBulkPublisher)
 }
 
+// validateOutgoing validates the data of a message being published against the schema of its topic.
+// Unless the message is published with a raw payload, the data is wrapped in a cloud event envelope.
+func validateOutgoing(v rtpubsub.SchemaValidator, data []byte, contentType string, metadata map[string]string) error {
+	rawPayload, err := contribMetadata.IsRawPayload(metadata)
+	if err != nil {
+		return err
+	}
+	if rawPayload {
+		return v.Validate(data, contentType)
+	}
+
+	var cloudEvent map[string]any
+	if err = json.Unmarshal(data, &cloudEvent); err != nil {
+		return fmt.Errorf("failed to parse cloud event: %w", err)
+	}
+	return rtpubsub.ValidateCloudEventData(v, cloudEvent)
+}
+
 func (p *pubsub) publishMessageHTTP(ctx context.Context, msg *subscribedMessage) error {
 	cloudEvent := msg.cloudEvent
 
@@ -178,54 +275,16 @@ func (p *pubsub) publishMessageHTTP(ctx context.Context, msg *subscribedMessage)
 	errMsg := fmt.Sprintf("retriable error returned from app while processing pub/sub event %v, topic: %v, body: %s. status code returned: %v", cloudEvent[contribpubsub.IDField], cloudEvent[contribpubsub.TopicField], body, statusCode)
 	log.Warnf(errMsg)
 	diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, elapsed)
-	return rterrors.NewRetriable(errors.New(errMsg))
+	return rterrors.NewRetriable(resiliency.HTTPStatusError{StatusCode: statusCode, Err: errors.New(errMsg)})
 }
 
 func (p *pubsub) publishMessageGRPC(ctx context.Context, msg *subscribedMessage) error {
 	cloudEvent := msg.cloudEvent
 
-	envelope := &runtimev1.TopicEventRequest{
-		Id:              extractCloudEventProperty(cloudEvent, contribpubsub.IDField),
-		Source:          extractCloudEventProperty(cloudEvent, contribpubsub.SourceField),
-		DataContentType: extractCloudEventProperty(cloudEvent, contribpubsub.DataContentTypeField),
-		Type:            extractCloudEventProperty(cloudEvent, contribpubsub.TypeField),
-		SpecVersion:     extractCloudEventProperty(cloudEvent, contribpubsub.SpecVersionField),
-		Topic:           msg.topic,
-		PubsubName:      msg.metadata[metadataKeyPubSub],
-		Path:            msg.path,
-	}
-
-	if data, ok := cloudEvent[contribpubsub.DataBase64Field]; ok && data != nil {
-		if dataAsString, ok := data.(string); ok {
-			decoded, decodeErr := base64.StdEncoding.DecodeString(dataAsString)
-			if decodeErr != nil {
-				log.Debugf("unable to base64 decode cloudEvent field data_base64: %s", decodeErr)
-				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
-
-				return fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(decodeErr))
-			}
-
-			envelope.Data = decoded
-		} else {
-			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
-			return fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(errUnexpectedEnvelopeData))
-		}
-	} else if data, ok := cloudEvent[contribpubsub.DataField]; ok && data != nil {
-		envelope.Data = nil
-
-		if contenttype.IsStringContentType(envelope.DataContentType) {
-			switch v := data.(type) {
-			case string:
-				envelope.Data = []byte(v)
-			case []byte:
-				envelope.Data = v
-			default:
-				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
-				return fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(errUnexpectedEnvelopeData))
-			}
-		} else if contenttype.IsJSONContentType(envelope.DataContentType) || contenttype.IsCloudEventContentType(envelope.DataContentType) {
-			envelope.Data, _ = json.Marshal(data)
-		}
+	envelope, err := newTopicEventRequest(msg)
+	if err != nil {
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
+		return err
 	}
 
 	var span trace.Span
@@ -249,13 +308,6 @@ func (p *pubsub) publishMessageGRPC(ctx context.Context, msg *subscribedMessage)
 		}
 	}
 
-	extensions, extensionsErr := extractCloudEventExtensions(cloudEvent)
-	if extensionsErr != nil {
-		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
-		return extensionsErr
-	}
-	envelope.Extensions = extensions
-
 	ctx = invokev1.WithCustomGRPCMetadata(ctx, msg.metadata)
 
 	conn, err := p.grpc.GetAppClient()
@@ -294,7 +346,67 @@ func (p *pubsub) publishMessageGRPC(ctx context.Context, msg *subscribedMessage)
 		return err
 	}
 
-	switch res.GetStatus() {
+	return topicEventResponseError(ctx, msg, res.GetStatus(), elapsed)
+}
+
+// newTopicEventRequest creates the envelope sent to gRPC subscribers for a subscribed message.
+func newTopicEventRequest(msg *subscribedMessage) (*runtimev1.TopicEventRequest, error) {
+	cloudEvent := msg.cloudEvent
+
+	envelope := &runtimev1.TopicEventRequest{
+		Id:              extractCloudEventProperty(cloudEvent, contribpubsub.IDField),
+		Source:          extractCloudEventProperty(cloudEvent, contribpubsub.SourceField),
+		DataContentType: extractCloudEventProperty(cloudEvent, contribpubsub.DataContentTypeField),
+		Type:            extractCloudEventProperty(cloudEvent, contribpubsub.TypeField),
+		SpecVersion:     extractCloudEventProperty(cloudEvent, contribpubsub.SpecVersionField),
+		Topic:           msg.topic,
+		PubsubName:      msg.metadata[metadataKeyPubSub],
+		Path:            msg.path,
+	}
+
+	if data, ok := cloudEvent[contribpubsub.DataBase64Field]; ok && data != nil {
+		if dataAsString, ok := data.(string); ok {
+			decoded, decodeErr := base64.StdEncoding.DecodeString(dataAsString)
+			if decodeErr != nil {
+				log.Debugf("unable to base64 decode cloudEvent field data_base64: %s", decodeErr)
+				return nil, fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(decodeErr))
+			}
+
+			envelope.Data = decoded
+		} else {
+			return nil, fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(errUnexpectedEnvelopeData))
+		}
+	} else if data, ok := cloudEvent[contribpubsub.DataField]; ok && data != nil {
+		envelope.Data = nil
+
+		if contenttype.IsStringContentType(envelope.DataContentType) {
+			switch v := data.(type) {
+			case string:
+				envelope.Data = []byte(v)
+			case []byte:
+				envelope.Data = v
+			default:
+				return nil, fmt.Errorf("error returned from app while processing pub/sub event: %w", rterrors.NewRetriable(errUnexpectedEnvelopeData))
+			}
+		} else if contenttype.IsJSONContentType(envelope.DataContentType) || contenttype.IsCloudEventContentType(envelope.DataContentType) {
+			envelope.Data, _ = json.Marshal(data)
+		}
+	}
+
+	extensions, err := extractCloudEventExtensions(cloudEvent)
+	if err != nil {
+		return nil, err
+	}
+	envelope.Extensions = extensions
+
+	return envelope, nil
+}
+
+// topicEventResponseError returns the error for the status returned by a gRPC subscriber, if any.
+func topicEventResponseError(ctx context.Context, msg *subscribedMessage, s runtimev1.TopicEventResponse_TopicEventResponseStatus, elapsed float64) error { //nolint:nosnakecase
+	cloudEvent := msg.cloudEvent
+
+	switch s {
 	case runtimev1.TopicEventResponse_SUCCESS: //nolint:nosnakecase
 		// on uninitialized status, this is the case it defaults to as an uninitialized status defaults to 0 which is
 		// success from protobuf definition
@@ -312,7 +424,7 @@ func (p *pubsub) publishMessageGRPC(ctx context.Context, msg *subscribedMessage)
 
 	// Consider unknown status field as error and retry
 	diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, elapsed)
-	return fmt.Errorf("unknown status returned from app while processing pub/sub event %v, status: %v, err: %w", cloudEvent[contribpubsub.IDField], res.GetStatus(), rterrors.NewRetriable(nil))
+	return fmt.Errorf("unknown status returned from app while processing pub/sub event %v, status: %v, err: %w", cloudEvent[contribpubsub.IDField], s, rterrors.NewRetriable(nil))
 }
 
 func extractCloudEventExtensions(cloudEvent map[string]interface{}) (*structpb.Struct, error) {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sync/atomic"
 	"testing"
 
 	"github.com/phayes/freeport"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/dapr/components-contrib/contenttype"
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	"github.com/dapr/dapr/pkg/config"
 	daprgrpc "github.com/dapr/dapr/pkg/grpc"
@@ -583,6 +585,9 @@ func TestOnNewPublishedMessage(t *testing.T) {
 		errMsg := fmt.Sprintf("retriable error returned from app while processing pub/sub event %v, topic: %v, body: Internal Error. status code returned: 500", cloudEvent["id"].(string), cloudEvent["topic"])
 		expectedClientError := rterrors.NewRetriable(errors.New(errMsg))
 		assert.Equal(t, expectedClientError.Error(), err.Error())
+		var statusErr resiliency.HTTPStatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, 500, statusErr.StatusCode)
 		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
 	})
 }
@@ -797,3 +802,166 @@ func TestOnNewPublishedMessageGRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishSchemaValidation(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(context.Background(), contribpubsub.Metadata{}))
+	validators, err := runtimePubsub.ParseTopicSchemas(map[string]string{
+		runtimePubsub.TopicSchemasMetadataKey: `{"orders": {"type": "jsonschema", "jsonSchema": {"type": "object", "required": ["orderId"]}}}`,
+	})
+	require.NoError(t, err)
+	ps := New(Options{
+		ID:             "app",
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+	})
+	ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp, TopicSchemas: validators})
+
+	publish := func(topic, data string, metadata map[string]string) error {
+		return ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      topic,
+			Data:       []byte(data),
+			Metadata:   metadata,
+		})
+	}
+
+	t.Run("valid cloud event", func(t *testing.T) {
+		require.NoError(t, publish("orders", `{"id":"1","datacontenttype":"application/json","data":{"orderId":1}}`, nil))
+	})
+
+	t.Run("invalid cloud event", func(t *testing.T) {
+		err := publish("orders", `{"id":"1","datacontenttype":"application/json","data":{"id":1}}`, nil)
+		require.ErrorAs(t, err, &runtimePubsub.SchemaValidationError{})
+	})
+
+	t.Run("raw payload", func(t *testing.T) {
+		require.NoError(t, publish("orders", `{"orderId":1}`, map[string]string{"rawPayload": "true"}))
+		err := publish("orders", `[]`, map[string]string{"rawPayload": "true"})
+		require.ErrorAs(t, err, &runtimePubsub.SchemaValidationError{})
+	})
+
+	t.Run("topic without schema", func(t *testing.T) {
+		require.NoError(t, publish("other", `{"id":"1","data":"hello"}`, nil))
+	})
+}
+
+// failingPublishPubSub fails all the messages it publishes with err.
+type failingPublishPubSub struct {
+	mockPublishPubSub
+	err   error
+	calls atomic.Int32
+}
+
+func (m *failingPublishPubSub) Publish(ctx context.Context, req *contribpubsub.PublishRequest) error {
+	m.calls.Add(1)
+	return m.err
+}
+
+func TestPublishFallback(t *testing.T) {
+	res := resiliency.FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"tripOnFailure": {
+						MaxRequests: 1,
+						Timeout:     "1m",
+						Trip:        "consecutiveFailures > 0",
+					},
+				},
+				Fallbacks: map[string]resiliencyV1alpha.Fallback{
+					"fallbackPubsub": {
+						Component: "fallbackPubsub",
+					},
+					"scopedPubsub": {
+						Component: "scopedPubsub",
+					},
+					"circuitBreakerOpen": {
+						Component:            "fallbackPubsub",
+						OnCircuitBreakerOpen: true,
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"timeoutPubsub": {
+						Outbound: resiliencyV1alpha.PolicyNames{Fallback: "fallbackPubsub"},
+					},
+					"invalidPubsub": {
+						Outbound: resiliencyV1alpha.PolicyNames{Fallback: "fallbackPubsub"},
+					},
+					"scopedFallbackPubsub": {
+						Outbound: resiliencyV1alpha.PolicyNames{Fallback: "scopedPubsub"},
+					},
+					"circuitBreakerPubsub": {
+						Outbound: resiliencyV1alpha.PolicyNames{
+							CircuitBreaker: "tripOnFailure",
+							Fallback:       "circuitBreakerOpen",
+						},
+					},
+				},
+			},
+		},
+	})
+	ps := New(Options{
+		ID:             "app",
+		Namespace:      "ns1",
+		Resiliency:     res,
+		ComponentStore: compstore.New(),
+	})
+
+	timeoutPubsub := &failingPublishPubSub{err: context.DeadlineExceeded}
+	invalidPubsub := &failingPublishPubSub{err: errors.New("invalid message")}
+	scopedFallbackPubsub := &failingPublishPubSub{err: context.DeadlineExceeded}
+	circuitBreakerPubsub := &failingPublishPubSub{err: context.DeadlineExceeded}
+	fallbackPubsub := &mockPublishPubSub{}
+	scopedPubsub := &mockPublishPubSub{}
+	ps.compStore.AddPubSub("timeoutPubsub", compstore.PubsubItem{Component: timeoutPubsub})
+	ps.compStore.AddPubSub("invalidPubsub", compstore.PubsubItem{Component: invalidPubsub})
+	ps.compStore.AddPubSub("scopedFallbackPubsub", compstore.PubsubItem{Component: scopedFallbackPubsub})
+	ps.compStore.AddPubSub("circuitBreakerPubsub", compstore.PubsubItem{Component: circuitBreakerPubsub})
+	ps.compStore.AddPubSub("fallbackPubsub", compstore.PubsubItem{Component: fallbackPubsub, NamespaceScoped: true})
+	ps.compStore.AddPubSub("scopedPubsub", compstore.PubsubItem{Component: scopedPubsub, ScopedPublishings: []string{"othertopic"}})
+
+	publish := func(pubsubName string) error {
+		return ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+			PubsubName: pubsubName,
+			Topic:      "topic0",
+			Data:       []byte(`{"id":"1","data":"hello"}`),
+		})
+	}
+
+	t.Run("timeout publishes to the fallback pubsub", func(t *testing.T) {
+		require.NoError(t, publish("timeoutPubsub"))
+		assert.Equal(t, int32(1), timeoutPubsub.calls.Load())
+
+		req := fallbackPubsub.PublishedRequest.Swap(nil)
+		require.NotNil(t, req)
+		assert.Equal(t, "fallbackPubsub", req.PubsubName)
+		assert.Equal(t, "ns1topic0", req.Topic)
+	})
+
+	t.Run("other errors are returned as-is", func(t *testing.T) {
+		require.EqualError(t, publish("invalidPubsub"), "invalid message")
+		assert.Equal(t, int32(1), invalidPubsub.calls.Load())
+		assert.Nil(t, fallbackPubsub.PublishedRequest.Load())
+	})
+
+	t.Run("error is returned if the fallback pubsub can't publish to the topic", func(t *testing.T) {
+		require.ErrorIs(t, publish("scopedFallbackPubsub"), context.DeadlineExceeded)
+		assert.Nil(t, scopedPubsub.PublishedRequest.Load())
+	})
+
+	t.Run("fallback restricted to open circuit breakers", func(t *testing.T) {
+		// The failure trips the circuit breaker but is returned as-is.
+		require.ErrorIs(t, publish("circuitBreakerPubsub"), context.DeadlineExceeded)
+		assert.Nil(t, fallbackPubsub.PublishedRequest.Load())
+
+		// The message rejected by the open circuit breaker isn't sent to the pubsub and is published to the fallback pubsub.
+		require.NoError(t, publish("circuitBreakerPubsub"))
+		assert.Equal(t, int32(1), circuitBreakerPubsub.calls.Load())
+		req := fallbackPubsub.PublishedRequest.Load()
+		require.NotNil(t, req)
+		assert.Equal(t, "fallbackPubsub", req.PubsubName)
+	})
+}
@@ -39,6 +39,7 @@ import (
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/delayed"
 	"github.com/dapr/dapr/pkg/scopes"
 	"github.com/dapr/kit/logger"
 )
@@ -76,6 +77,7 @@ type Options struct {
 	TracingSpec    *config.TracingSpec
 	GRPC           *grpc.Manager
 	OperatorClient operatorv1.OperatorClient
+	Delayed        *delayed.Scheduler
 }
 
 type pubsub struct {
@@ -94,10 +96,20 @@ type pubsub struct {
 	appChannel     channel.AppChannel
 	grpc           *grpc.Manager
 	operatorClient operatorv1.OperatorClient
+	delayed        *delayed.Scheduler
 
 	lock sync.RWMutex
 
 	topicCancels map[string]context.CancelFunc
+	topicRules   map[string]*routeRules
+	streams      map[string]*streamSubscription
+
+	// subscribeCtx is the context the subscriptions were started with, or nil
+	// while they are stopped.
+	subscribeCtx context.Context
+	// appSubscriptions are the subscriptions returned by the app, which are
+	// merged with the declarative subscriptions.
+	appSubscriptions []rtpubsub.Subscription
 }
 
 type subscribedMessage struct {
@@ -124,7 +136,10 @@ func New(opts Options) *pubsub {
 		tracingSpec:    opts.TracingSpec,
 		grpc:           opts.GRPC,
 		operatorClient: opts.OperatorClient,
+		delayed:        opts.Delayed,
 		topicCancels:   make(map[string]context.CancelFunc),
+		topicRules:     make(map[string]*routeRules),
+		streams:        make(map[string]*streamSubscription),
 	}
 }
 
@@ -147,6 +162,12 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 	}
 	properties["consumerID"] = consumerID
 
+	topicSchemas, err := rtpubsub.ParseTopicSchemas(properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = pubSub.Init(ctx, contribpubsub.Metadata{Base: baseMetadata})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -162,7 +183,11 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 		AllowedTopics:       scopes.GetAllowedTopics(properties),
 		ProtectedTopics:     scopes.GetProtectedTopics(properties),
 		NamespaceScoped:     meta.ContainsNamespace(comp.Spec.Metadata),
+		TopicSchemas:        topicSchemas,
 	})
+	if p.delayed != nil {
+		p.delayed.AddOrUpdatePubsub(pubsubName, properties)
+	}
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
 	return nil
@@ -187,11 +212,21 @@ func (p *pubsub) Close(comp compapi.Component) error {
 		p.compStore.DeleteTopicRoute(subKey)
 	}
 
+	for subKey, s := range p.streams {
+		if strings.HasPrefix(subKey, comp.Name+"||") {
+			s.cancel()
+			delete(p.streams, subKey)
+		}
+	}
+
 	if err := ps.Component.Close(); err != nil {
 		return err
 	}
 
 	p.compStore.DeletePubSub(comp.Name)
+	if p.delayed != nil {
+		p.delayed.RemovePubsub(comp.Name)
+	}
 
 	return nil
 }
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// WatchDeclarativeSubscriptions watches the declarative subscriptions, from the resources paths in
+// standalone mode or from the operator in Kubernetes mode, and reloads them when they change.
+// It blocks until the context is canceled.
+func (p *pubsub) WatchDeclarativeSubscriptions(ctx context.Context) error {
+	onChange := func(subs []rtpubsub.Subscription) {
+		if err := p.ReloadDeclarativeSubscriptions(subs); err != nil {
+			log.Errorf("failed to reload declarative subscriptions: %s", err)
+		}
+	}
+
+	switch p.mode {
+	case modes.KubernetesMode:
+		return rtpubsub.WatchDeclarativeKubernetes(ctx, p.operatorClient, p.podName, p.namespace, log, onChange)
+	case modes.StandaloneMode:
+		return rtpubsub.WatchDeclarativeLocal(ctx, p.resourcesPath, p.namespace, log, onChange)
+	default:
+		return nil
+	}
+}
+
+// ReloadDeclarativeSubscriptions replaces the declarative subscriptions of the app. Topics which are no
+// longer subscribed to are unsubscribed from and new topics are subscribed to. Subscriptions whose
+// routing rules changed keep receiving messages with the new rules, while subscriptions with other
+// changes are re-created.
+// The topics are subscribed to with the context the subscriptions were started with.
+func (p *pubsub) ReloadDeclarativeSubscriptions(declarative []rtpubsub.Subscription) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// The subscriptions have not been loaded yet, so the declarative subscriptions
+	// will be read when they are.
+	if p.compStore.ListSubscriptions() == nil {
+		return nil
+	}
+
+	subscriptions := mergeSubscriptions(p.appSubscriptions, p.scopedSubscriptions(declarative))
+	p.compStore.SetSubscriptions(subscriptions)
+
+	oldRoutes := p.compStore.GetTopicRoutes()
+	newRoutes := buildTopicRoutes(subscriptions)
+	p.compStore.SetTopicRoutes(newRoutes)
+
+	if p.subscribeCtx == nil {
+		return nil
+	}
+
+	var errs []error
+	for name, topics := range oldRoutes {
+		for topic := range topics {
+			if _, ok := newRoutes[name][topic]; ok {
+				continue
+			}
+			subKey := topicKey(name, topic)
+			if _, ok := p.topicCancels[subKey]; ok {
+				log.Infof("unsubscribing from topic %s on pubsub %s as its subscription was removed", topic, name)
+				p.unsubscribeTopic(subKey)
+			}
+		}
+	}
+
+	for name, topics := range newRoutes {
+		for topic, route := range topics {
+			subKey := topicKey(name, topic)
+			oldRoute, exists := oldRoutes[name][topic]
+			if exists {
+				if reflect.DeepEqual(oldRoute, route) {
+					continue
+				}
+				if rules, ok := p.topicRules[subKey]; ok && onlyRulesChanged(oldRoute, route) {
+					log.Infof("updating routing rules of topic %s on pubsub %s", topic, name)
+					rules.set(route.Rules)
+					continue
+				}
+				if _, ok := p.topicCancels[subKey]; ok {
+					log.Infof("re-subscribing to topic %s on pubsub %s as its subscription changed", topic, name)
+					p.unsubscribeTopic(subKey)
+				}
+			}
+
+			if _, ok := p.compStore.GetPubSub(name); !ok || p.compStore.IsSubscriptionPaused(name, topic) {
+				continue
+			}
+			log.Infof("subscribing to topic %s on pubsub %s", topic, name)
+			if err := p.subscribeTopic(p.subscribeCtx, name, topic, route); err != nil {
+				errs = append(errs, fmt.Errorf("error occurred while subscribing to topic %s on pubsub %s: %w", topic, name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// onlyRulesChanged returns true if the routes only differ by their routing rules.
+func onlyRulesChanged(oldRoute, newRoute compstore.TopicRouteElem) bool {
+	if newRoute.BulkSubscribe != nil && newRoute.BulkSubscribe.Enabled {
+		return false
+	}
+	oldRoute.Rules = newRoute.Rules
+	return reflect.DeepEqual(oldRoute, newRoute)
+}
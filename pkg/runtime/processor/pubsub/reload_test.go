@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subscriptionsapiV2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/modes"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/registry"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestReloadDeclarativeSubscriptions(t *testing.T) {
+	ps := New(Options{
+		ID:             TestRuntimeConfigID,
+		Registry:       registry.New(registry.NewOptions()).PubSubs(),
+		Meta:           meta.New(meta.Options{}),
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		IsHTTP:         true,
+	})
+	ps.registry.RegisterComponent(
+		func(_ logger.Logger) contribpubsub.PubSub {
+			return &mockSubscribePubSub{}
+		},
+		"mockPubSub",
+	)
+
+	subResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes([]byte("[]")).
+		WithContentType("application/json")
+	defer subResp.Close()
+	okResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+	defer okResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	ps.appChannel = mockAppChannel
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), matchDaprRequestMethod("dapr/subscribe")).Return(subResp, nil)
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(okResp, nil)
+
+	require.NoError(t, ps.Init(context.Background(), componentsV1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: TestPubsubName},
+		Spec: componentsV1alpha1.ComponentSpec{
+			Type:     "pubsub.mockPubSub",
+			Version:  "v1",
+			Metadata: daprt.GetFakeMetadataItems(),
+		},
+	}))
+
+	subscription := func(topic, path string, scopes ...string) rtpubsub.Subscription {
+		return rtpubsub.Subscription{
+			PubsubName: TestPubsubName,
+			Topic:      topic,
+			Rules:      []*rtpubsub.Rule{{Path: path}},
+			Scopes:     scopes,
+		}
+	}
+	publish := func(t *testing.T, topic string) {
+		t.Helper()
+		require.NoError(t, ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      topic,
+			Data:       []byte(`{"id":"1","source":"src","type":"t","specversion":"1.0","data":"hello"}`),
+		}))
+	}
+	ctx := context.Background()
+
+	t.Run("subscriptions not loaded yet", func(t *testing.T) {
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{subscription("topic0", "a")}))
+		assert.Empty(t, ps.topicCancels)
+		assert.Nil(t, ps.compStore.ListSubscriptions())
+	})
+
+	require.NoError(t, ps.StartSubscriptions(ctx))
+	t.Cleanup(ps.StopSubscriptions)
+
+	t.Run("new subscription is subscribed", func(t *testing.T) {
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{
+			subscription("topic0", "a"),
+			subscription("topic1", "a", "other-app"),
+		}))
+		assert.Contains(t, ps.topicCancels, topicKey(TestPubsubName, "topic0"))
+		assert.NotContains(t, ps.topicCancels, topicKey(TestPubsubName, "topic1"))
+		assert.Contains(t, ps.compStore.GetTopicRoutes()[TestPubsubName], "topic0")
+
+		publish(t, "topic0")
+		assert.Contains(t, mockAppChannel.GetInvokedRequest(), "a")
+	})
+
+	t.Run("routing rules are updated in place", func(t *testing.T) {
+		rules := ps.topicRules[topicKey(TestPubsubName, "topic0")]
+		require.NotNil(t, rules)
+
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{subscription("topic0", "b")}))
+		assert.Same(t, rules, ps.topicRules[topicKey(TestPubsubName, "topic0")])
+		assert.Equal(t, "b", rules.get()[0].Path)
+
+		publish(t, "topic0")
+		assert.Contains(t, mockAppChannel.GetInvokedRequest(), "b")
+	})
+
+	t.Run("other changes re-subscribe", func(t *testing.T) {
+		rules := ps.topicRules[topicKey(TestPubsubName, "topic0")]
+
+		changed := subscription("topic0", "b")
+		changed.DeadLetterTopic = "dlq"
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{changed}))
+		assert.Contains(t, ps.topicCancels, topicKey(TestPubsubName, "topic0"))
+		assert.NotSame(t, rules, ps.topicRules[topicKey(TestPubsubName, "topic0")])
+		assert.Equal(t, "dlq", ps.compStore.GetTopicRoutes()[TestPubsubName]["topic0"].DeadLetterTopic)
+	})
+
+	t.Run("removed subscription is unsubscribed", func(t *testing.T) {
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{subscription("topic1", "c")}))
+		assert.NotContains(t, ps.topicCancels, topicKey(TestPubsubName, "topic0"))
+		assert.NotContains(t, ps.topicRules, topicKey(TestPubsubName, "topic0"))
+		assert.Contains(t, ps.topicCancels, topicKey(TestPubsubName, "topic1"))
+		assert.Len(t, ps.compStore.ListSubscriptions(), 1)
+	})
+
+	t.Run("topic subscribed over a stream is not subscribed twice", func(t *testing.T) {
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		require.NoError(t, ps.SubscribeStream(streamCtx, rtpubsub.StreamSubscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+		}, func(context.Context, *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+			return &runtimev1pb.TopicEventResponse{}, nil
+		}))
+
+		err := ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{subscription("topic1", "c"), subscription("topic0", "c")})
+		require.ErrorContains(t, err, "the subscription already exists")
+		assert.NotContains(t, ps.topicCancels, topicKey(TestPubsubName, "topic0"))
+		assert.Contains(t, ps.streams, topicKey(TestPubsubName, "topic0"))
+	})
+
+	t.Run("subscriptions are not started when stopped", func(t *testing.T) {
+		ps.StopSubscriptions()
+		require.NoError(t, ps.ReloadDeclarativeSubscriptions([]rtpubsub.Subscription{subscription("topic3", "d")}))
+		assert.Empty(t, ps.topicCancels)
+		assert.Contains(t, ps.compStore.GetTopicRoutes()[TestPubsubName], "topic3")
+	})
+}
+
+// fakeSubscriptionsOperator is an operator client which sends the update events of its channel
+// and fails to list the subscriptions while listErr is set.
+type fakeSubscriptionsOperator struct {
+	operatorv1pb.OperatorClient
+	events    chan *operatorv1pb.SubscriptionUpdateEvent
+	listErr   atomic.Pointer[error]
+	listCalls atomic.Int64
+}
+
+func (o *fakeSubscriptionsOperator) ListSubscriptionsV2(context.Context, *operatorv1pb.ListSubscriptionsRequest, ...grpc.CallOption) (*operatorv1pb.ListSubscriptionsResponse, error) {
+	defer o.listCalls.Add(1)
+	if err := o.listErr.Load(); err != nil {
+		return nil, *err
+	}
+	b, err := json.Marshal(subscriptionsapiV2alpha1.Subscription{
+		TypeMeta: metav1.TypeMeta{Kind: "Subscription", APIVersion: "dapr.io/v2alpha1"},
+		Spec: subscriptionsapiV2alpha1.SubscriptionSpec{
+			Pubsubname: TestPubsubName,
+			Topic:      "topic0",
+			Routes:     subscriptionsapiV2alpha1.Routes{Default: "a"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &operatorv1pb.ListSubscriptionsResponse{Subscriptions: [][]byte{b}}, nil
+}
+
+func (o *fakeSubscriptionsOperator) SubscriptionUpdate(ctx context.Context, _ *operatorv1pb.SubscriptionUpdateRequest, _ ...grpc.CallOption) (operatorv1pb.Operator_SubscriptionUpdateClient, error) { //nolint:nosnakecase
+	return &fakeSubscriptionUpdateStream{ctx: ctx, events: o.events}, nil
+}
+
+type fakeSubscriptionUpdateStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events chan *operatorv1pb.SubscriptionUpdateEvent
+}
+
+func (s *fakeSubscriptionUpdateStream) Recv() (*operatorv1pb.SubscriptionUpdateEvent, error) {
+	select {
+	case e := <-s.events:
+		return e, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestWatchDeclarativeSubscriptionsKubernetes(t *testing.T) {
+	operator := &fakeSubscriptionsOperator{events: make(chan *operatorv1pb.SubscriptionUpdateEvent)}
+	ps := New(Options{
+		ID:             TestRuntimeConfigID,
+		Mode:           modes.KubernetesMode,
+		PodName:        "pod1",
+		OperatorClient: operator,
+		Registry:       registry.New(registry.NewOptions()).PubSubs(),
+		Meta:           meta.New(meta.Options{}),
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+		IsHTTP:         true,
+	})
+	ps.registry.RegisterComponent(
+		func(_ logger.Logger) contribpubsub.PubSub {
+			return &mockSubscribePubSub{}
+		},
+		"mockPubSub",
+	)
+
+	subResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes([]byte("[]")).
+		WithContentType("application/json")
+	defer subResp.Close()
+	okResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+	defer okResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	ps.appChannel = mockAppChannel
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), matchDaprRequestMethod("dapr/subscribe")).Return(subResp, nil)
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(okResp, nil)
+
+	require.NoError(t, ps.Init(context.Background(), componentsV1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: TestPubsubName},
+		Spec: componentsV1alpha1.ComponentSpec{
+			Type:     "pubsub.mockPubSub",
+			Version:  "v1",
+			Metadata: daprt.GetFakeMetadataItems(),
+		},
+	}))
+	require.NoError(t, ps.StartSubscriptions(context.Background()))
+	t.Cleanup(ps.StopSubscriptions)
+
+	subscribed := func() bool {
+		ps.lock.Lock()
+		defer ps.lock.Unlock()
+		_, ok := ps.topicCancels[topicKey(TestPubsubName, "topic0")]
+		return ok
+	}
+	require.True(t, subscribed())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ps.WatchDeclarativeSubscriptions(ctx)
+	}()
+
+	listErr := errors.New("operator unavailable")
+	operator.listErr.Store(&listErr)
+	calls := operator.listCalls.Load()
+	operator.events <- &operatorv1pb.SubscriptionUpdateEvent{}
+	assert.Eventually(t, func() bool {
+		return operator.listCalls.Load() > calls
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Sending the next event guarantees that the previous one was processed.
+	operator.events <- &operatorv1pb.SubscriptionUpdateEvent{}
+	assert.True(t, subscribed())
+	assert.Len(t, ps.compStore.ListSubscriptions(), 1)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
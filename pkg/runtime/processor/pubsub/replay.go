@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// defaultReplayIdleTimeout is how long a replay waits for a message to replay before ending.
+	defaultReplayIdleTimeout = 5 * time.Second
+	// replayConsumerIDSuffix is appended to the consumer ID of the pubsub for the consumer group replaying dead-letter topics.
+	replayConsumerIDSuffix = "-replay"
+)
+
+var (
+	errReplayLimitReached = errors.New("maximum number of messages to replay reached")
+	errReplaySkipped      = errors.New("message skipped")
+)
+
+// ReplayDeadLetters subscribes to a dead-letter topic until req.MaxMessages messages have been republished,
+// or no message was republished for req.IdleTimeout.
+//
+// The dead-letter topic is read by a dedicated instance of the pubsub component, in its own consumer group:
+// the consumer ID of the pubsub suffixed with "-replay", unless req.Metadata sets consumerID. The replay
+// doesn't take messages from the subscriptions of the app, and keeps its own position in the topic.
+// Messages which are skipped, because they don't match the filter or have no topic to be republished to,
+// are acknowledged for the replay consumer group only, so they don't block or spin the replay. Messages
+// which fail to be republished, or are received after the maximum number of messages was reached, are not
+// acknowledged and are replayed by the next replay.
+//
+// The messages available to the replay depend on the broker. Brokers with consumer groups reading from a
+// retained log, such as Kafka (with initialOffset set to oldest), Redis Streams or Pulsar, replay the messages
+// retained in the dead-letter topic. Brokers creating a queue per consumer ID on subscribe, such as RabbitMQ
+// or Azure Service Bus topics, only replay the messages dead-lettered after the replay queue was created by
+// a first replay.
+func (p *pubsub) ReplayDeadLetters(ctx context.Context, req rtpubsub.ReplayRequest) (int, error) {
+	ps, ok := p.compStore.GetPubSub(req.PubsubName)
+	if !ok {
+		return 0, rtpubsub.NotFoundError{PubsubName: req.PubsubName}
+	}
+	if !p.isOperationAllowed(req.PubsubName, req.DeadLetterTopic, ps.ScopedSubscriptions) {
+		return 0, rtpubsub.NotAllowedError{Topic: req.DeadLetterTopic, ID: p.id}
+	}
+	if req.MaxMessages <= 0 {
+		return 0, errors.New("the maximum number of messages to replay must be greater than zero")
+	}
+	idleTimeout := req.IdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = defaultReplayIdleTimeout
+	}
+
+	subscribeTopic := req.DeadLetterTopic
+	if ps.NamespaceScoped {
+		subscribeTopic = p.namespace + subscribeTopic
+	}
+
+	component, err := p.newReplayComponent(ctx, req.PubsubName, req.Metadata)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if cErr := component.Close(); cErr != nil {
+			log.Warnf("failed to close the replay instance of pubsub %s: %v", req.PubsubName, cErr)
+		}
+	}()
+
+	var (
+		lock     sync.Mutex
+		replayed int
+		inflight int
+	)
+	progress := make(chan struct{}, 1)
+	done := make(chan struct{})
+	var doneOnce sync.Once
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err = component.Subscribe(subCtx, contribpubsub.SubscribeRequest{
+		Topic:    subscribeTopic,
+		Metadata: req.Metadata,
+	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
+		lock.Lock()
+		if replayed+inflight >= req.MaxMessages {
+			lock.Unlock()
+			return errReplayLimitReached
+		}
+		inflight++
+		lock.Unlock()
+
+		rErr := p.replayMessage(ctx, req, msg)
+
+		lock.Lock()
+		inflight--
+		if rErr == nil {
+			replayed++
+		}
+		finished := replayed >= req.MaxMessages
+		lock.Unlock()
+
+		if errors.Is(rErr, errReplaySkipped) {
+			log.Debugf("skipping message from dead letter topic %s in pubsub %s: %v", req.DeadLetterTopic, req.PubsubName, rErr)
+			return nil
+		} else if rErr != nil {
+			log.Debugf("not replaying message from dead letter topic %s in pubsub %s: %v", req.DeadLetterTopic, req.PubsubName, rErr)
+			return rErr
+		}
+		select {
+		case progress <- struct{}{}:
+		default:
+		}
+		if finished {
+			doneOnce.Do(func() { close(done) })
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to subscribe to dead letter topic %s: %w", req.DeadLetterTopic, err)
+	}
+
+	timer := time.NewTimer(idleTimeout)
+	defer timer.Stop()
+loop:
+	for {
+		select {
+		case <-done:
+			break loop
+		case <-progress:
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(idleTimeout)
+		case <-timer.C:
+			break loop
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	cancel()
+
+	lock.Lock()
+	defer lock.Unlock()
+	return replayed, ctx.Err()
+}
+
+// newReplayComponent returns a new instance of the pubsub component, initialized with the consumer ID used to replay dead-letter topics.
+func (p *pubsub) newReplayComponent(ctx context.Context, pubsubName string, metadata map[string]string) (contribpubsub.PubSub, error) {
+	var comp compapi.Component
+	var found bool
+	for _, c := range p.compStore.ListComponents() {
+		if c.ObjectMeta.Name == pubsubName && strings.HasPrefix(c.Spec.Type, "pubsub.") {
+			comp, found = c, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("definition of pubsub %s not found", pubsubName)
+	}
+
+	component, err := p.registry.Create(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the replay instance of pubsub %s: %w", pubsubName, err)
+	}
+
+	baseMetadata := p.meta.ToBaseMetadata(comp)
+	consumerID := strings.TrimSpace(metadata["consumerID"])
+	if consumerID == "" {
+		consumerID = strings.TrimSpace(baseMetadata.Properties["consumerID"])
+		if consumerID == "" {
+			consumerID = p.id
+		}
+		consumerID += replayConsumerIDSuffix
+	}
+	baseMetadata.Properties["consumerID"] = consumerID
+
+	err = component.Init(ctx, contribpubsub.Metadata{Base: baseMetadata})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the replay instance of pubsub %s: %w", pubsubName, err)
+	}
+	return component, nil
+}
+
+// replayMessage republishes a message of a dead-letter topic to its original topic.
+// It returns an error wrapping errReplaySkipped for messages which can't be replayed.
+func (p *pubsub) replayMessage(ctx context.Context, req rtpubsub.ReplayRequest, msg *contribpubsub.NewMessage) error {
+	var cloudEvent map[string]any
+	if err := json.Unmarshal(msg.Data, &cloudEvent); err != nil {
+		return fmt.Errorf("%w: not a cloud event: %v", errReplaySkipped, err)
+	}
+
+	if req.Filter != nil {
+		res, err := req.Filter.Eval(map[string]any{"event": cloudEvent})
+		if err != nil {
+			return fmt.Errorf("%w: failed to evaluate the replay filter: %v", errReplaySkipped, err)
+		}
+		if match, ok := res.(bool); !ok || !match {
+			return fmt.Errorf("%w: does not match the replay filter", errReplaySkipped)
+		}
+	}
+
+	topic := req.Topic
+	if topic == "" {
+		topic, _ = cloudEvent[rtpubsub.OriginalTopicExtension].(string)
+	}
+	if topic == "" {
+		return fmt.Errorf("%w: original topic of message %v is unknown", errReplaySkipped, cloudEvent[contribpubsub.IDField])
+	}
+
+	delete(cloudEvent, rtpubsub.OriginalTopicExtension)
+	data, err := json.Marshal(cloudEvent)
+	if err != nil {
+		return err
+	}
+
+	metadata := make(map[string]string, len(msg.Metadata))
+	for k, v := range msg.Metadata {
+		if k != metadataKeyPubSub {
+			metadata[k] = v
+		}
+	}
+
+	return p.Publish(ctx, &contribpubsub.PublishRequest{
+		PubsubName:  req.PubsubName,
+		Topic:       topic,
+		Data:        data,
+		ContentType: ptr.Of(contenttype.CloudEventContentType),
+		Metadata:    metadata,
+	})
+}
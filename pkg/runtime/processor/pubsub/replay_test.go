@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/registry"
+	"github.com/dapr/kit/logger"
+)
+
+// replayPubSub delivers a fixed list of messages to subscribers and records the published messages.
+type replayPubSub struct {
+	mockSubscribePubSub
+	messages [][]byte
+
+	lock       sync.Mutex
+	consumerID string
+	published  []*contribpubsub.PublishRequest
+	acked      int
+	nacked     int
+	closed     bool
+}
+
+func (r *replayPubSub) Init(ctx context.Context, md contribpubsub.Metadata) error {
+	r.lock.Lock()
+	r.consumerID = md.Properties["consumerID"]
+	r.lock.Unlock()
+	return r.mockSubscribePubSub.Init(ctx, md)
+}
+
+func (r *replayPubSub) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.closed = true
+	return nil
+}
+
+func (r *replayPubSub) Publish(_ context.Context, req *contribpubsub.PublishRequest) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.published = append(r.published, req)
+	return nil
+}
+
+func (r *replayPubSub) Subscribe(ctx context.Context, req contribpubsub.SubscribeRequest, handler contribpubsub.Handler) error {
+	go func() {
+		for _, data := range r.messages {
+			if ctx.Err() != nil {
+				return
+			}
+			err := handler(ctx, &contribpubsub.NewMessage{Data: data, Topic: req.Topic})
+			r.lock.Lock()
+			if err != nil {
+				r.nacked++
+			} else {
+				r.acked++
+			}
+			r.lock.Unlock()
+		}
+	}()
+	return nil
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	cloudEvent := func(id, originalTopic string) []byte {
+		ce := map[string]any{
+			"id":              id,
+			"source":          "src",
+			"type":            "test",
+			"specversion":     "1.0",
+			"datacontenttype": "application/json",
+			"data":            map[string]any{"id": id},
+		}
+		if originalTopic != "" {
+			ce[rtpubsub.OriginalTopicExtension] = originalTopic
+		}
+		b, err := json.Marshal(ce)
+		require.NoError(t, err)
+		return b
+	}
+
+	// newPubsub returns the pubsub component of the app, which records the republished messages,
+	// and the replay instance created from its definition, which delivers the dead-letter messages.
+	newPubsub := func(messages ...[]byte) (*pubsub, *replayPubSub, *replayPubSub) {
+		comp := &replayPubSub{}
+		replay := &replayPubSub{messages: messages}
+		ps := New(Options{
+			ID:             "app",
+			Registry:       registry.New(registry.NewOptions()).PubSubs(),
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+		})
+		ps.registry.RegisterComponent(func(_ logger.Logger) contribpubsub.PubSub { return replay }, "mockReplay")
+		ps.compStore.AddComponent(componentsV1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: TestPubsubName},
+			Spec:       componentsV1alpha1.ComponentSpec{Type: "pubsub.mockReplay", Version: "v1"},
+		})
+		ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp})
+		return ps, comp, replay
+	}
+
+	t.Run("messages are republished to their original topic", func(t *testing.T) {
+		ps, comp, replay := newPubsub(cloudEvent("1", "orders"), cloudEvent("2", "payments"), cloudEvent("3", ""))
+		replayed, err := ps.ReplayDeadLetters(context.Background(), rtpubsub.ReplayRequest{
+			PubsubName:      TestPubsubName,
+			DeadLetterTopic: "dlq",
+			MaxMessages:     10,
+			IdleTimeout:     100 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, replayed)
+
+		comp.lock.Lock()
+		defer comp.lock.Unlock()
+		require.Len(t, comp.published, 2)
+		assert.Equal(t, "orders", comp.published[0].Topic)
+		assert.Equal(t, "payments", comp.published[1].Topic)
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(comp.published[0].Data, &ce))
+		assert.Equal(t, "1", ce["id"])
+		assert.NotContains(t, ce, rtpubsub.OriginalTopicExtension)
+
+		replay.lock.Lock()
+		defer replay.lock.Unlock()
+		assert.Equal(t, "app-replay", replay.consumerID)
+		assert.True(t, replay.closed)
+		// The message without original topic is skipped by the replay consumer group
+		assert.Equal(t, 3, replay.acked)
+		assert.Equal(t, 0, replay.nacked)
+	})
+
+	t.Run("consumer ID from the request metadata", func(t *testing.T) {
+		ps, _, replay := newPubsub(cloudEvent("1", "orders"))
+		_, err := ps.ReplayDeadLetters(context.Background(), rtpubsub.ReplayRequest{
+			PubsubName:      TestPubsubName,
+			DeadLetterTopic: "dlq",
+			MaxMessages:     10,
+			IdleTimeout:     100 * time.Millisecond,
+			Metadata:        map[string]string{"consumerID": "custom"},
+		})
+		require.NoError(t, err)
+
+		replay.lock.Lock()
+		defer replay.lock.Unlock()
+		assert.Equal(t, "custom", replay.consumerID)
+	})
+
+	t.Run("replay stops at the maximum number of messages", func(t *testing.T) {
+		ps, comp, _ := newPubsub(cloudEvent("1", "orders"), cloudEvent("2", "orders"), cloudEvent("3", "orders"))
+		replayed, err := ps.ReplayDeadLetters(context.Background(), rtpubsub.ReplayRequest{
+			PubsubName:      TestPubsubName,
+			DeadLetterTopic: "dlq",
+			MaxMessages:     2,
+			IdleTimeout:     5 * time.Second,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, replayed)
+
+		comp.lock.Lock()
+		defer comp.lock.Unlock()
+		assert.Len(t, comp.published, 2)
+	})
+
+	t.Run("filter and topic override", func(t *testing.T) {
+		ps, comp, replay := newPubsub(cloudEvent("1", "orders"), cloudEvent("2", "orders"))
+		filter := &expr.Expr{}
+		require.NoError(t, filter.DecodeString(`event.data.id == "2"`))
+		replayed, err := ps.ReplayDeadLetters(context.Background(), rtpubsub.ReplayRequest{
+			PubsubName:      TestPubsubName,
+			DeadLetterTopic: "dlq",
+			Topic:           "retries",
+			MaxMessages:     10,
+			Filter:          filter,
+			IdleTimeout:     100 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, replayed)
+
+		comp.lock.Lock()
+		defer comp.lock.Unlock()
+		require.Len(t, comp.published, 1)
+		assert.Equal(t, "retries", comp.published[0].Topic)
+
+		replay.lock.Lock()
+		defer replay.lock.Unlock()
+		assert.Equal(t, 2, replay.acked)
+		assert.Equal(t, 0, replay.nacked)
+	})
+
+	t.Run("pubsub not found", func(t *testing.T) {
+		ps, _, _ := newPubsub()
+		_, err := ps.ReplayDeadLetters(context.Background(), rtpubsub.ReplayRequest{
+			PubsubName:      "notfound",
+			DeadLetterTopic: "dlq",
+			MaxMessages:     1,
+		})
+		require.ErrorAs(t, err, &rtpubsub.NotFoundError{})
+	})
+}
+
+func TestSendToDeadLetterOriginalTopic(t *testing.T) {
+	comp := &replayPubSub{}
+	ps := New(Options{
+		ID:             "app",
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+	})
+	ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp})
+
+	require.NoError(t, ps.sendToDeadLetter(context.Background(), TestPubsubName, &contribpubsub.NewMessage{
+		Topic: "orders",
+		Data:  []byte(`{"id":"1","specversion":"1.0","data":"hello"}`),
+	}, "dlq"))
+
+	require.Len(t, comp.published, 1)
+	assert.Equal(t, "dlq", comp.published[0].Topic)
+	var ce map[string]any
+	require.NoError(t, json.Unmarshal(comp.published[0].Data, &ce))
+	assert.Equal(t, "orders", ce[rtpubsub.OriginalTopicExtension])
+	assert.Equal(t, "hello", ce["data"])
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// keySerializer delivers the messages sharing the same concurrency key value to the app one at a time,
+// while messages with different values can be delivered concurrently.
+// It doesn't order the messages: the ones handed to the runtime concurrently race to acquire.
+type keySerializer struct {
+	lock  sync.Mutex
+	tails map[string]chan struct{}
+}
+
+func newKeySerializer() *keySerializer {
+	return &keySerializer{
+		tails: make(map[string]chan struct{}),
+	}
+}
+
+// acquire blocks until all the messages with the same key which called acquire before are delivered.
+// The returned function must be called once the message has been delivered.
+func (k *keySerializer) acquire(ctx context.Context, key string) (func(), error) {
+	done := make(chan struct{})
+	k.lock.Lock()
+	prev := k.tails[key]
+	k.tails[key] = done
+	k.lock.Unlock()
+
+	release := func() {
+		close(done)
+		k.lock.Lock()
+		if k.tails[key] == done {
+			delete(k.tails, key)
+		}
+		k.lock.Unlock()
+	}
+
+	if prev == nil {
+		return release, nil
+	}
+
+	select {
+	case <-prev:
+		return release, nil
+	case <-ctx.Done():
+		// Keep the chain intact for the messages queued after this one.
+		go func() {
+			<-prev
+			release()
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// concurrencyKeyValue returns the value of the concurrency key for a message, looking first at the
+// attributes of the cloud event and then at the message metadata.
+func concurrencyKeyValue(key string, cloudEvent map[string]any, metadata map[string]string) string {
+	if v, ok := cloudEvent[key]; ok && v != nil {
+		if s, ok := v.(string); ok {
+			return s
+		}
+		return fmt.Sprint(v)
+	}
+	return metadata[key]
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySerializer(t *testing.T) {
+	t.Run("same key is delivered one at a time", func(t *testing.T) {
+		k := newKeySerializer()
+		tail := func() chan struct{} {
+			k.lock.Lock()
+			defer k.lock.Unlock()
+			return k.tails["a"]
+		}
+
+		release, err := k.acquire(context.Background(), "a")
+		require.NoError(t, err)
+
+		acquired := make(chan int, 2)
+		enqueue := func(i int) {
+			prev := tail()
+			go func() {
+				release, err := k.acquire(context.Background(), "a")
+				if assert.NoError(t, err) {
+					acquired <- i
+					release()
+				}
+			}()
+			// Wait for the message to be queued before enqueuing the next one.
+			assert.Eventually(t, func() bool {
+				return tail() != prev
+			}, time.Second, time.Millisecond)
+		}
+		enqueue(2)
+		enqueue(3)
+
+		select {
+		case <-acquired:
+			t.Fatal("message was delivered before the previous one was released")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release()
+		assert.Equal(t, 2, <-acquired)
+		assert.Equal(t, 3, <-acquired)
+
+		assert.Eventually(t, func() bool {
+			return tail() == nil
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("different keys are delivered concurrently", func(t *testing.T) {
+		k := newKeySerializer()
+
+		releaseA, err := k.acquire(context.Background(), "a")
+		require.NoError(t, err)
+		defer releaseA()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		releaseB, err := k.acquire(ctx, "b")
+		require.NoError(t, err)
+		releaseB()
+	})
+
+	t.Run("canceled message does not break the chain", func(t *testing.T) {
+		k := newKeySerializer()
+
+		release1, err := k.acquire(context.Background(), "a")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = k.acquire(ctx, "a")
+		require.ErrorIs(t, err, context.Canceled)
+
+		acquired := make(chan struct{})
+		go func() {
+			release, err := k.acquire(context.Background(), "a")
+			if assert.NoError(t, err) {
+				release()
+				close(acquired)
+			}
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("message was delivered before the previous one was released")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release1()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("message was not delivered")
+		}
+	})
+}
+
+func TestConcurrencyKeyValue(t *testing.T) {
+	cloudEvent := map[string]any{"partitionkey": "p1", "sequence": float64(3)}
+	metadata := map[string]string{"sessionId": "s1"}
+
+	assert.Equal(t, "p1", concurrencyKeyValue("partitionkey", cloudEvent, metadata))
+	assert.Equal(t, "3", concurrencyKeyValue("sequence", cloudEvent, metadata))
+	assert.Equal(t, "s1", concurrencyKeyValue("sessionId", cloudEvent, metadata))
+	assert.Equal(t, "", concurrencyKeyValue("missing", cloudEvent, metadata))
+}
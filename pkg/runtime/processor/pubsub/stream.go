@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// streamSubscription is a subscription requested by an app over a stream.
+type streamSubscription struct {
+	cancel context.CancelFunc
+}
+
+// SubscribeStream subscribes to a topic on behalf of an app connected over a stream.
+// Messages are passed to handler until ctx is canceled.
+func (p *pubsub) SubscribeStream(ctx context.Context, sub rtpubsub.StreamSubscription, handler rtpubsub.StreamHandler) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pubSub, ok := p.compStore.GetPubSub(sub.PubsubName)
+	if !ok {
+		return rtpubsub.NotFoundError{PubsubName: sub.PubsubName}
+	}
+
+	if !p.isOperationAllowed(sub.PubsubName, sub.Topic, pubSub.ScopedSubscriptions) {
+		return rtpubsub.NotAllowedError{Topic: sub.Topic, ID: p.id}
+	}
+
+	subKey := topicKey(sub.PubsubName, sub.Topic)
+	_, declared := p.topicCancels[subKey]
+	if _, streamed := p.streams[subKey]; declared || streamed {
+		return fmt.Errorf("cannot subscribe to topic '%s' on pubsub '%s': the subscription already exists", sub.Topic, sub.PubsubName)
+	}
+
+	log.Debugf("subscribing to topic='%s' on pubsub='%s' over a stream", sub.Topic, sub.PubsubName)
+
+	ctx, cancel := context.WithCancel(ctx)
+	route := compstore.TopicRouteElem{
+		Metadata:        sub.Metadata,
+		DeadLetterTopic: sub.DeadLetterTopic,
+		// All messages are delivered to the stream
+		Rules: []*rtpubsub.Rule{{}},
+	}
+	err := p.subscribe(ctx, pubSub, sub.PubsubName, sub.Topic, route, newRouteRules(route.Rules), func(ctx context.Context, msg *subscribedMessage) error {
+		envelope, err := newTopicEventRequest(msg)
+		if err != nil {
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, 0)
+			return err
+		}
+
+		start := time.Now()
+		res, err := handler(ctx, envelope)
+		elapsed := diag.ElapsedSince(start)
+		if err != nil {
+			err = fmt.Errorf("error returned from app while processing pub/sub event %v: %w", msg.cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(err))
+			log.Debug(err)
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.pubsub, strings.ToLower(string(contribpubsub.Retry)), msg.topic, elapsed)
+			return err
+		}
+
+		return topicEventResponseError(ctx, msg, res.GetStatus(), elapsed)
+	})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to subscribe to topic %s: %w", sub.Topic, err)
+	}
+
+	s := &streamSubscription{cancel: cancel}
+	p.streams[subKey] = s
+
+	// Remove the subscription once the stream is closed
+	go func() {
+		<-ctx.Done()
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		if p.streams[subKey] == s {
+			delete(p.streams, subKey)
+		}
+	}()
+
+	return nil
+}
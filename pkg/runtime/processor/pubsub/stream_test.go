@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func TestSubscribeStream(t *testing.T) {
+	newPubsub := func(t *testing.T) (*pubsub, *mockSubscribePubSub) {
+		comp := &mockSubscribePubSub{}
+		require.NoError(t, comp.Init(context.Background(), contribpubsub.Metadata{}))
+		ps := New(Options{
+			ID:             "app",
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+		})
+		ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp})
+		return ps, comp
+	}
+
+	publish := func(t *testing.T, ps *pubsub, topic string) {
+		require.NoError(t, ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      topic,
+			Data:       []byte(`{"id":"1","source":"src","type":"test","specversion":"1.0","datacontenttype":"application/json","data":{"a":1}}`),
+		}))
+	}
+
+	t.Run("messages are passed to the handler", func(t *testing.T) {
+		ps, _ := newPubsub(t)
+
+		var received []*runtimev1pb.TopicEventRequest
+		err := ps.SubscribeStream(context.Background(), rtpubsub.StreamSubscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+		}, func(_ context.Context, req *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+			received = append(received, req)
+			return &runtimev1pb.TopicEventResponse{Status: runtimev1pb.TopicEventResponse_SUCCESS}, nil //nolint:nosnakecase
+		})
+		require.NoError(t, err)
+
+		publish(t, ps, "topic0")
+		require.Len(t, received, 1)
+		assert.Equal(t, "1", received[0].Id)
+		assert.Equal(t, "topic0", received[0].Topic)
+		assert.Equal(t, TestPubsubName, received[0].PubsubName)
+		assert.JSONEq(t, `{"a":1}`, string(received[0].Data))
+	})
+
+	t.Run("failed messages are sent to the dead letter topic", func(t *testing.T) {
+		ps, comp := newPubsub(t)
+
+		err := ps.SubscribeStream(context.Background(), rtpubsub.StreamSubscription{
+			PubsubName:      TestPubsubName,
+			Topic:           "topic0",
+			DeadLetterTopic: "dlq",
+		}, func(context.Context, *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+			return nil, errors.New("stream closed")
+		})
+		require.NoError(t, err)
+
+		publish(t, ps, "topic0")
+		assert.Equal(t, 1, comp.pubCount["dlq"])
+	})
+
+	t.Run("subscription is unique per topic", func(t *testing.T) {
+		ps, _ := newPubsub(t)
+		handler := func(context.Context, *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error) {
+			return &runtimev1pb.TopicEventResponse{}, nil
+		}
+		sub := rtpubsub.StreamSubscription{PubsubName: TestPubsubName, Topic: "topic0"}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, ps.SubscribeStream(ctx, sub, handler))
+		require.Error(t, ps.SubscribeStream(context.Background(), sub, handler))
+
+		// The topic can be subscribed to again once the stream is closed
+		cancel()
+		assert.Eventually(t, func() bool {
+			return ps.SubscribeStream(context.Background(), sub, handler) == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("pubsub not found", func(t *testing.T) {
+		ps, _ := newPubsub(t)
+		err := ps.SubscribeStream(context.Background(), rtpubsub.StreamSubscription{
+			PubsubName: "notfound",
+			Topic:      "topic0",
+		}, nil)
+		require.ErrorAs(t, err, &rtpubsub.NotFoundError{})
+	})
+}
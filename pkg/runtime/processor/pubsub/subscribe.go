@@ -34,6 +34,8 @@ func (p *pubsub) StartSubscriptions(ctx context.Context) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	p.subscribeCtx = ctx
+
 	var errs []error
 	for pubsubName := range p.compStore.ListPubSubs() {
 		if err := p.beginPubSub(ctx, pubsubName); err != nil {
@@ -49,6 +51,7 @@ func (p *pubsub) StopSubscriptions() {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	p.subscribeCtx = nil
 	for subKey := range p.topicCancels {
 		p.unsubscribeTopic(subKey)
 		p.compStore.DeleteTopicRoute(subKey)
@@ -68,6 +71,10 @@ func (p *pubsub) beginPubSub(ctx context.Context, name string) error {
 
 	var errs []error
 	for topic, route := range v {
+		if p.compStore.IsSubscriptionPaused(name, topic) {
+			log.Infof("not subscribing to topic %s on pubsub %s as its subscription is paused", topic, name)
+			continue
+		}
 		err = p.subscribeTopic(ctx, name, topic, route)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("error occurred while beginning pubsub for topic %s on component %s: %v", topic, name, err))
@@ -83,11 +90,9 @@ func (p *pubsub) topicRoutes(ctx context.Context) (map[string]compstore.TopicRou
 		return routes, nil
 	}
 
-	topicRoutes := make(map[string]compstore.TopicRoutes)
-
 	if p.appChannel == nil {
 		log.Warn("app channel not initialized, make sure -app-port is specified if pubsub subscription is required")
-		return topicRoutes, nil
+		return make(map[string]compstore.TopicRoutes), nil
 	}
 
 	subscriptions, err := p.subscriptions(ctx)
@@ -95,18 +100,7 @@ func (p *pubsub) topicRoutes(ctx context.Context) (map[string]compstore.TopicRou
 		return nil, err
 	}
 
-	for _, s := range subscriptions {
-		if topicRoutes[s.PubsubName] == nil {
-			topicRoutes[s.PubsubName] = compstore.TopicRoutes{}
-		}
-
-		topicRoutes[s.PubsubName][s.Topic] = compstore.TopicRouteElem{
-			Metadata:        s.Metadata,
-			Rules:           s.Rules,
-			DeadLetterTopic: s.DeadLetterTopic,
-			BulkSubscribe:   s.BulkSubscribe,
-		}
-	}
+	topicRoutes := buildTopicRoutes(subscriptions)
 
 	if len(topicRoutes) > 0 {
 		for pubsubName, v := range topicRoutes {
@@ -159,13 +153,25 @@ func (p *pubsub) subscriptions(ctx context.Context) ([]rtpubsub.Subscription, er
 		return nil, err
 	}
 
+	p.appSubscriptions = subscriptions
+
 	// handle declarative subscriptions
-	ds := p.declarativeSubscriptions(ctx)
-	for _, s := range ds {
+	subscriptions = mergeSubscriptions(subscriptions, p.declarativeSubscriptions(ctx))
+
+	p.compStore.SetSubscriptions(subscriptions)
+	return subscriptions, nil
+}
+
+// mergeSubscriptions returns the app subscriptions with the declarative subscriptions which don't
+// subscribe to the same topics.
+func mergeSubscriptions(app, declarative []rtpubsub.Subscription) []rtpubsub.Subscription {
+	subscriptions := make([]rtpubsub.Subscription, 0, len(app)+len(declarative))
+	subscriptions = append(subscriptions, app...)
+	for _, s := range declarative {
 		skip := false
 
 		// don't register duplicate subscriptions
-		for _, sub := range subscriptions {
+		for _, sub := range app {
 			if sub.PubsubName == s.PubsubName && sub.Topic == s.Topic {
 				log.Warnf("two identical subscriptions found (sources: declarative, app endpoint). pubsubname: %s, topic: %s",
 					s.PubsubName, s.Topic)
@@ -178,14 +184,30 @@ func (p *pubsub) subscriptions(ctx context.Context) ([]rtpubsub.Subscription, er
 			subscriptions = append(subscriptions, s)
 		}
 	}
+	return subscriptions
+}
 
-	// If subscriptions is nil, set to empty slice to prevent successive calls.
-	if subscriptions == nil {
-		subscriptions = make([]rtpubsub.Subscription, 0)
-	}
+// buildTopicRoutes returns the topic routes of the subscriptions, by pubsub name.
+func buildTopicRoutes(subscriptions []rtpubsub.Subscription) map[string]compstore.TopicRoutes {
+	topicRoutes := make(map[string]compstore.TopicRoutes)
+	for _, s := range subscriptions {
+		if topicRoutes[s.PubsubName] == nil {
+			topicRoutes[s.PubsubName] = compstore.TopicRoutes{}
+		}
 
-	p.compStore.SetSubscriptions(subscriptions)
-	return subscriptions, nil
+		topicRoutes[s.PubsubName][s.Topic] = compstore.TopicRouteElem{
+			Metadata:             s.Metadata,
+			Rules:                s.Rules,
+			DeadLetterTopic:      s.DeadLetterTopic,
+			BulkSubscribe:        s.BulkSubscribe,
+			ConcurrencyKey:       s.ConcurrencyKey,
+			Deduplication:        s.Deduplication,
+			Schema:               s.Schema,
+			MaxConcurrency:       s.MaxConcurrency,
+			MaxMessagesPerSecond: s.MaxMessagesPerSecond,
+		}
+	}
+	return topicRoutes
 }
 
 // Refer for state store api decision
@@ -195,12 +217,20 @@ func (p *pubsub) declarativeSubscriptions(ctx context.Context) []rtpubsub.Subscr
 
 	switch p.mode {
 	case modes.KubernetesMode:
-		subs = rtpubsub.DeclarativeKubernetes(ctx, p.operatorClient, p.podName, p.namespace, log)
+		var err error
+		subs, err = rtpubsub.DeclarativeKubernetes(ctx, p.operatorClient, p.podName, p.namespace, log)
+		if err != nil {
+			log.Error(err)
+		}
 	case modes.StandaloneMode:
 		subs = rtpubsub.DeclarativeLocal(p.resourcesPath, p.namespace, log)
 	}
 
-	// only return valid subscriptions for this app id
+	return p.scopedSubscriptions(subs)
+}
+
+// scopedSubscriptions returns the subscriptions which are scoped to this app id.
+func (p *pubsub) scopedSubscriptions(subs []rtpubsub.Subscription) []rtpubsub.Subscription {
 	i := 0
 	for _, s := range subs {
 		keep := false
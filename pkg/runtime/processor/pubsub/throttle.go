@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// deliveryThrottle limits the number of messages of a subscription delivered to the app
+// concurrently and per second.
+type deliveryThrottle struct {
+	sem     chan struct{}
+	limiter *rate.Limiter
+}
+
+// newDeliveryThrottle returns the throttle for a subscription, or nil if it has no limits.
+func newDeliveryThrottle(maxConcurrency, maxMessagesPerSecond int32) *deliveryThrottle {
+	if maxConcurrency <= 0 && maxMessagesPerSecond <= 0 {
+		return nil
+	}
+
+	t := &deliveryThrottle{}
+	if maxConcurrency > 0 {
+		t.sem = make(chan struct{}, maxConcurrency)
+	}
+	if maxMessagesPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(maxMessagesPerSecond), 1)
+	}
+	return t
+}
+
+// acquire blocks until a message can be delivered to the app.
+// The returned function must be called once the message has been delivered.
+func (t *deliveryThrottle) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if t.sem != nil {
+		select {
+		case t.sem <- struct{}{}:
+			release = func() { <-t.sem }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
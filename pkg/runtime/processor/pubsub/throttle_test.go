@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryThrottle(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		assert.Nil(t, newDeliveryThrottle(0, 0))
+	})
+
+	t.Run("concurrency is limited", func(t *testing.T) {
+		throttle := newDeliveryThrottle(2, 0)
+		release1, err := throttle.acquire(context.Background())
+		require.NoError(t, err)
+		_, err = throttle.acquire(context.Background())
+		require.NoError(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			release, err := throttle.acquire(context.Background())
+			if assert.NoError(t, err) {
+				release()
+			}
+			close(acquired)
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("message was delivered over the concurrency limit")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release1()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("message was not delivered once a slot was released")
+		}
+	})
+
+	t.Run("context canceled while waiting for a slot", func(t *testing.T) {
+		throttle := newDeliveryThrottle(1, 0)
+		_, err := throttle.acquire(context.Background())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = throttle.acquire(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("rate is limited", func(t *testing.T) {
+		throttle := newDeliveryThrottle(0, 20)
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			release, err := throttle.acquire(context.Background())
+			require.NoError(t, err)
+			release()
+		}
+		// The first message is delivered immediately, the following ones every 50ms.
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("slot is released when the rate limit wait fails", func(t *testing.T) {
+		throttle := newDeliveryThrottle(1, 1)
+		release, err := throttle.acquire(context.Background())
+		require.NoError(t, err)
+		release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = throttle.acquire(ctx)
+		require.Error(t, err)
+		assert.Empty(t, throttle.sem)
+	})
+}
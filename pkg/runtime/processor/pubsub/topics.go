@@ -19,13 +19,17 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/dapr/components-contrib/metadata"
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/dedup"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 const (
@@ -47,17 +51,28 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 
 	log.Debugf("subscribing to topic='%s' on pubsub='%s'", topic, name)
 
-	if _, ok := p.topicCancels[subKey]; ok {
+	_, declared := p.topicCancels[subKey]
+	if _, streamed := p.streams[subKey]; declared || streamed {
 		return fmt.Errorf("cannot subscribe to topic '%s' on pubsub '%s': the subscription already exists", topic, name)
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	policyDef := p.resiliency.ComponentInboundPolicy(name, resiliency.Pubsub)
-	routeMetadata := route.Metadata
-
 	namespaced := pubSub.NamespaceScoped
 
 	if route.BulkSubscribe != nil && route.BulkSubscribe.Enabled {
+		if route.ConcurrencyKey != "" {
+			log.Warnf("concurrency key is not supported with bulk subscriptions and is ignored for topic '%s' on pubsub '%s'", topic, name)
+		}
+		if route.Schema != nil || pubSub.TopicSchemas[topic] != nil {
+			log.Warnf("schema validation is not supported with bulk subscriptions and is skipped for topic '%s' on pubsub '%s'", topic, name)
+		}
+		if route.Deduplication != nil {
+			log.Warnf("duplicate detection is not supported with bulk subscriptions and is disabled for topic '%s' on pubsub '%s'", topic, name)
+		}
+		if route.MaxConcurrency > 0 || route.MaxMessagesPerSecond > 0 {
+			log.Warnf("maxConcurrency and maxMessagesPerSecond are not supported with bulk subscriptions and are ignored for topic '%s' on pubsub '%s'", topic, name)
+		}
 		err := p.bulkSubscribeTopic(ctx, policyDef, name, topic, route, namespaced)
 		if err != nil {
 			cancel()
@@ -67,12 +82,80 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 		return nil
 	}
 
+	deliver := p.publishMessageGRPC
+	if p.isHTTP {
+		deliver = p.publishMessageHTTP
+	}
+	rules := newRouteRules(route.Rules)
+	err := p.subscribe(ctx, pubSub, name, topic, route, rules, deliver)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to subscribe to topic %s: %w", topic, err)
+	}
+	p.topicCancels[subKey] = cancel
+	p.topicRules[subKey] = rules
+	return nil
+}
+
+// routeRules holds the routing rules of a subscription, which can be replaced while the subscription
+// is active.
+type routeRules struct {
+	rules atomic.Pointer[[]*rtpubsub.Rule]
+}
+
+func newRouteRules(rules []*rtpubsub.Rule) *routeRules {
+	r := &routeRules{}
+	r.set(rules)
+	return r
+}
+
+func (r *routeRules) get() []*rtpubsub.Rule {
+	return *r.rules.Load()
+}
+
+func (r *routeRules) set(rules []*rtpubsub.Rule) {
+	r.rules.Store(&rules)
+}
+
+// subscribe subscribes to the topic of the pubsub component, passing each message to deliver.
+// Messages are routed with the current rules of the subscription.
+func (p *pubsub) subscribe(ctx context.Context, pubSub compstore.PubsubItem, name, topic string, route compstore.TopicRouteElem, rules *routeRules, deliver func(context.Context, *subscribedMessage) error) error {
+	policyDef := p.resiliency.ComponentInboundPolicy(name, resiliency.Pubsub)
+	routeMetadata := route.Metadata
+
 	subscribeTopic := topic
-	if namespaced {
+	if pubSub.NamespaceScoped {
 		subscribeTopic = p.namespace + topic
 	}
 
-	err := pubSub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+	var serializer *keySerializer
+	if route.ConcurrencyKey != "" {
+		serializer = newKeySerializer()
+	}
+
+	throttle := newDeliveryThrottle(route.MaxConcurrency, route.MaxMessagesPerSecond)
+
+	validator := pubSub.TopicSchemas[topic]
+	if route.Schema != nil {
+		var err error
+		validator, err = rtpubsub.NewSchemaValidator(*route.Schema)
+		if err != nil {
+			return fmt.Errorf("invalid schema: %w", err)
+		}
+	}
+
+	var deduplicator *dedup.Deduplicator
+	if route.Deduplication != nil {
+		deduplicator = dedup.New(dedup.Options{
+			AppID:          p.id,
+			ComponentStore: p.compStore,
+			StoreName:      route.Deduplication.StateStore,
+			Scope:          name + "-" + topic,
+			TTL:            time.Duration(route.Deduplication.TTLInSeconds) * time.Second,
+		})
+	}
+
+	return pubSub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
 		Topic:    subscribeTopic,
 		Metadata: routeMetadata,
 	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
@@ -144,7 +227,19 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 			return nil
 		}
 
-		routePath, shouldProcess, err := findMatchingRoute(route.Rules, cloudEvent)
+		if validator != nil {
+			if vErr := rtpubsub.ValidateCloudEventData(validator, cloudEvent); vErr != nil {
+				log.Warnf("dropping pub/sub event %v in pubsub %s and topic %s that does not match the schema: %v", cloudEvent[contribpubsub.IDField], name, msgTopic, vErr)
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), msgTopic, 0)
+
+				if route.DeadLetterTopic != "" {
+					_ = p.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic)
+				}
+				return nil
+			}
+		}
+
+		routePath, shouldProcess, err := findMatchingRoute(rules.get(), cloudEvent)
 		if err != nil {
 			log.Errorf("error finding matching route for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
 			if route.DeadLetterTopic != "" {
@@ -167,6 +262,44 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 			return nil
 		}
 
+		if serializer != nil {
+			if key := concurrencyKeyValue(route.ConcurrencyKey, cloudEvent, msg.Metadata); key != "" {
+				release, oErr := serializer.acquire(ctx, key)
+				if oErr != nil {
+					return oErr
+				}
+				defer release()
+			}
+		}
+
+		var msgID string
+		if deduplicator != nil {
+			msgID, _ = cloudEvent[contribpubsub.IDField].(string)
+		}
+		if msgID != "" {
+			claimed, dErr := deduplicator.Claim(ctx, msgID)
+			switch {
+			case errors.Is(dErr, dedup.ErrInProgress):
+				log.Debugf("pub/sub event %s in pubsub %s and topic %s is being processed by another delivery, retrying later", msgID, name, msgTopic)
+				return dErr
+			case dErr != nil:
+				log.Warnf("failed to check for duplicate pub/sub event %s in pubsub %s and topic %s, delivering it anyway: %v", msgID, name, msgTopic, dErr)
+			case !claimed:
+				log.Debugf("dropping duplicate pub/sub event %s in pubsub %s and topic %s", msgID, name, msgTopic)
+				diag.DefaultComponentMonitoring.PubsubIngressDuplicate(ctx, name, msgTopic)
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), msgTopic, 0)
+				return nil
+			}
+		}
+
+		if throttle != nil {
+			release, tErr := throttle.acquire(ctx)
+			if tErr != nil {
+				return tErr
+			}
+			defer release()
+		}
+
 		sm := &subscribedMessage{
 			cloudEvent: cloudEvent,
 			data:       data,
@@ -177,12 +310,7 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 		}
 		policyRunner := resiliency.NewRunner[any](ctx, policyDef)
 		_, err = policyRunner(func(ctx context.Context) (any, error) {
-			var pErr error
-			if p.isHTTP {
-				pErr = p.publishMessageHTTP(ctx, sm)
-			} else {
-				pErr = p.publishMessageGRPC(ctx, sm)
-			}
+			pErr := deliver(ctx, sm)
 			var rErr *rterrors.RetriableError
 			if errors.As(pErr, &rErr) {
 				log.Warnf("encountered a retriable error while publishing a subscribed message to topic %s, err: %v", msgTopic, rErr.Unwrap())
@@ -191,6 +319,15 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 			}
 			return nil, pErr
 		})
+		if msgID != "" {
+			if err == nil {
+				if dErr := deduplicator.Record(ctx, msgID); dErr != nil {
+					log.Warnf("failed to record processed pub/sub event %s in pubsub %s and topic %s: %v", msgID, name, msgTopic, dErr)
+				}
+			} else if dErr := deduplicator.Release(ctx, msgID); dErr != nil {
+				log.Warnf("failed to release pub/sub event %s in pubsub %s and topic %s: %v", msgID, name, msgTopic, dErr)
+			}
+		}
 		if err != nil && err != context.Canceled {
 			// Sending msg to dead letter queue.
 			// If no DLQ is configured, return error for backwards compatibility (component-level retry).
@@ -202,12 +339,6 @@ func (p *pubsub) subscribeTopic(ctx context.Context, name, topic string, route c
 		}
 		return err
 	})
-	if err != nil {
-		cancel()
-		return fmt.Errorf("failed to subscribe to topic %s: %w", topic, err)
-	}
-	p.topicCancels[subKey] = cancel
-	return nil
 }
 
 func (p *pubsub) unsubscribeTopic(subKey string) {
@@ -223,6 +354,7 @@ func (p *pubsub) unsubscribeTopic(subKey string) {
 	}
 
 	delete(p.topicCancels, subKey)
+	delete(p.topicRules, subKey)
 }
 
 func (p *pubsub) isOperationAllowed(name string, topic string, scopedTopics []string) bool {
@@ -281,7 +413,7 @@ func topicKey(componentName, topicName string) string {
 
 func (p *pubsub) sendToDeadLetter(ctx context.Context, name string, msg *contribpubsub.NewMessage, deadLetterTopic string) error {
 	req := &contribpubsub.PublishRequest{
-		Data:        msg.Data,
+		Data:        rtpubsub.WithOriginalTopic(msg.Data, p.originalTopic(name, msg.Topic)),
 		PubsubName:  name,
 		Topic:       deadLetterTopic,
 		Metadata:    msg.Metadata,
@@ -295,3 +427,12 @@ func (p *pubsub) sendToDeadLetter(ctx context.Context, name string, msg *contrib
 
 	return nil
 }
+
+// originalTopic returns the name of a topic as it is published to, without the namespace prefix of
+// namespaced pubsubs.
+func (p *pubsub) originalTopic(name, topic string) string {
+	if ps, ok := p.compStore.GetPubSub(name); ok && ps.NamespaceScoped {
+		return strings.TrimPrefix(topic, p.namespace)
+	}
+	return topic
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestSubscribeDeduplication(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(context.Background(), contribpubsub.Metadata{}))
+	ps := New(Options{
+		ID:             "app",
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+	})
+	ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp})
+	ps.compStore.AddStateStore("dedupstore", daprt.NewFakeStateStore())
+	pubSub, _ := ps.compStore.GetPubSub(TestPubsubName)
+
+	var attempts, delivered []string
+	var fail bool
+	route := compstore.TopicRouteElem{
+		Rules:         []*rtpubsub.Rule{{Path: "orders"}},
+		Deduplication: &rtpubsub.Deduplication{StateStore: "dedupstore"},
+	}
+	err := ps.subscribe(context.Background(), pubSub, TestPubsubName, "topic0", route, newRouteRules(route.Rules), func(_ context.Context, sm *subscribedMessage) error {
+		attempts = append(attempts, sm.cloudEvent[contribpubsub.IDField].(string))
+		if fail {
+			return errors.New("app error")
+		}
+		delivered = append(delivered, sm.cloudEvent[contribpubsub.IDField].(string))
+		return nil
+	})
+	require.NoError(t, err)
+
+	publish := func(t *testing.T, id string) {
+		t.Helper()
+		require.NoError(t, ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Data:       []byte(`{"id":"` + id + `","source":"src","type":"test","specversion":"1.0","data":"hello"}`),
+		}))
+	}
+
+	t.Run("failed messages are not recorded", func(t *testing.T) {
+		fail = true
+		publish(t, "1")
+		fail = false
+		publish(t, "1")
+		assert.Equal(t, []string{"1", "1"}, attempts)
+		assert.Equal(t, []string{"1"}, delivered)
+	})
+
+	t.Run("duplicate messages are dropped", func(t *testing.T) {
+		publish(t, "1")
+		publish(t, "2")
+		publish(t, "2")
+		assert.Equal(t, []string{"1", "2"}, delivered)
+	})
+}
+
+func TestSubscribeSchemaValidation(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(context.Background(), contribpubsub.Metadata{}))
+	ps := New(Options{
+		ID:             "app",
+		Resiliency:     resiliency.New(log),
+		ComponentStore: compstore.New(),
+	})
+	ps.compStore.AddPubSub(TestPubsubName, compstore.PubsubItem{Component: comp})
+	pubSub, _ := ps.compStore.GetPubSub(TestPubsubName)
+
+	t.Run("invalid schema", func(t *testing.T) {
+		route := compstore.TopicRouteElem{
+			Rules:  []*rtpubsub.Rule{{Path: "orders"}},
+			Schema: &rtpubsub.Schema{Type: "avro"},
+		}
+		err := ps.subscribe(context.Background(), pubSub, TestPubsubName, "topic1", route, newRouteRules(route.Rules), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid messages are sent to the dead letter topic", func(t *testing.T) {
+		var delivered int
+		route := compstore.TopicRouteElem{
+			Rules:           []*rtpubsub.Rule{{Path: "orders"}},
+			DeadLetterTopic: "dlq",
+			Schema: &rtpubsub.Schema{
+				Type:       rtpubsub.SchemaTypeJSONSchema,
+				JSONSchema: `{"type":"object","required":["orderId"]}`,
+			},
+		}
+		err := ps.subscribe(context.Background(), pubSub, TestPubsubName, "topic0", route, newRouteRules(route.Rules), func(context.Context, *subscribedMessage) error {
+			delivered++
+			return nil
+		})
+		require.NoError(t, err)
+
+		publish := func(data string) {
+			require.NoError(t, ps.Publish(context.Background(), &contribpubsub.PublishRequest{
+				PubsubName: TestPubsubName,
+				Topic:      "topic0",
+				Data:       []byte(`{"id":"1","source":"src","type":"test","specversion":"1.0","datacontenttype":"application/json","data":` + data + `}`),
+			}))
+		}
+
+		publish(`{"orderId":1}`)
+		assert.Equal(t, 1, delivered)
+		assert.Equal(t, 0, comp.pubCount["dlq"])
+
+		publish(`{"id":1}`)
+		assert.Equal(t, 1, delivered)
+		assert.Equal(t, 1, comp.pubCount["dlq"])
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/outbox"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/kit/logger"
 )
@@ -42,6 +43,7 @@ type Options struct {
 	Registry         *compstate.Registry
 	ComponentStore   *compstore.ComponentStore
 	Meta             *meta.Meta
+	Outbox           *outbox.Outbox
 	PlacementEnabled bool
 }
 
@@ -49,6 +51,7 @@ type state struct {
 	registry  *compstate.Registry
 	compStore *compstore.ComponentStore
 	meta      *meta.Meta
+	outbox    *outbox.Outbox
 	lock      sync.RWMutex
 
 	actorStateStoreName *string
@@ -60,6 +63,7 @@ func New(opts Options) *state {
 		registry:         opts.Registry,
 		compStore:        opts.ComponentStore,
 		meta:             opts.Meta,
+		outbox:           opts.Outbox,
 		placementEnabled: opts.PlacementEnabled,
 	}
 }
@@ -108,6 +112,14 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 			return rterrors.NewInit(rterrors.InitComponentFailure, fName, wrapError)
 		}
 
+		if s.outbox != nil {
+			err = s.outbox.AddOrUpdateOutbox(comp.ObjectMeta.Name, props)
+			if err != nil {
+				diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+				return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+			}
+		}
+
 		// when placement address list is not empty, set specified actor store.
 		if s.placementEnabled {
 			// set specified actor store if "actorStateStore" is true in the spec.
@@ -153,6 +165,9 @@ func (s *state) Close(comp compapi.Component) error {
 	}
 
 	s.compStore.DeleteStateStore(comp.Name)
+	if s.outbox != nil {
+		s.outbox.RemoveOutbox(comp.Name)
+	}
 
 	return nil
 }
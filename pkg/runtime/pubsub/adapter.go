@@ -15,8 +15,11 @@ package pubsub
 
 import (
 	"context"
+	"time"
 
 	contribPubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
 
 // Adapter is the interface for message buses.
@@ -24,3 +27,50 @@ type Adapter interface {
 	Publish(context.Context, *contribPubsub.PublishRequest) error
 	BulkPublish(context.Context, *contribPubsub.BulkPublishRequest) (contribPubsub.BulkPublishResponse, error)
 }
+
+// StreamSubscription is a subscription to a topic requested by an app over a stream.
+type StreamSubscription struct {
+	PubsubName      string
+	Topic           string
+	Metadata        map[string]string
+	DeadLetterTopic string
+}
+
+// StreamHandler delivers a message to an app subscribed over a stream and returns the app's response.
+type StreamHandler func(context.Context, *runtimev1pb.TopicEventRequest) (*runtimev1pb.TopicEventResponse, error)
+
+// StreamSubscriber is implemented by adapters that support subscriptions requested by apps over a stream.
+type StreamSubscriber interface {
+	// SubscribeStream subscribes to a topic until the context is canceled.
+	SubscribeStream(ctx context.Context, sub StreamSubscription, handler StreamHandler) error
+}
+
+// DelayedMessageCanceler is implemented by adapters that support canceling messages published with a delay.
+type DelayedMessageCanceler interface {
+	// CancelDelayedMessage cancels a delayed message which has not been published yet.
+	CancelDelayedMessage(ctx context.Context, pubsubName, id string) error
+}
+
+// ReplayRequest is a request to republish the messages of a dead-letter topic to the topic they were
+// originally published to.
+type ReplayRequest struct {
+	PubsubName      string
+	DeadLetterTopic string
+	// Topic overrides the topic the messages are republished to. By default, messages are republished
+	// to the topic recorded in their OriginalTopicExtension attribute.
+	Topic string
+	// Metadata is passed to the pubsub component when subscribing to the dead-letter topic. Its consumerID
+	// overrides the consumer group used by the replay.
+	Metadata    map[string]string
+	MaxMessages int
+	// Filter selects the messages to replay. Messages which don't match it are skipped by the replay consumer group.
+	Filter *expr.Expr
+	// IdleTimeout is how long to wait for a message to replay before ending the replay.
+	IdleTimeout time.Duration
+}
+
+// DeadLetterReplayer is implemented by adapters that support replaying the messages of dead-letter topics.
+type DeadLetterReplayer interface {
+	// ReplayDeadLetters republishes up to req.MaxMessages messages and returns the number of messages replayed.
+	ReplayDeadLetters(ctx context.Context, req ReplayRequest) (int, error)
+}
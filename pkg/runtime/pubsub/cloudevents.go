@@ -14,12 +14,18 @@ limitations under the License.
 package pubsub
 
 import (
+	"encoding/json"
+
 	"github.com/mitchellh/mapstructure"
 
 	contribContenttype "github.com/dapr/components-contrib/contenttype"
 	contribPubsub "github.com/dapr/components-contrib/pubsub"
 )
 
+// OriginalTopicExtension is the cloud event extension attribute with the topic a message was
+// published to, which the runtime adds when sending the message to a dead-letter topic.
+const OriginalTopicExtension = "daproriginaltopic"
+
 // CloudEvent is a request object to create a Dapr compliant cloudevent.
 // The cloud event properties can manually be overwritten by using metadata beginning with "cloudevent." as prefix.
 type CloudEvent struct {
@@ -55,3 +61,21 @@ func NewCloudEvent(req *CloudEvent, metadata map[string]string) (map[string]inte
 	return contribPubsub.NewCloudEventsEnvelope(req.ID, req.Source, req.Type,
 		"", req.Topic, req.Pubsub, req.DataContentType, req.Data, req.TraceID, req.TraceState), nil
 }
+
+// WithOriginalTopic returns the cloud event with the OriginalTopicExtension attribute set to topic.
+// Data which isn't a JSON cloud event is returned unchanged.
+func WithOriginalTopic(data []byte, topic string) []byte {
+	var cloudEvent map[string]interface{}
+	if err := json.Unmarshal(data, &cloudEvent); err != nil {
+		return data
+	}
+	if _, ok := cloudEvent[contribPubsub.SpecVersionField]; !ok {
+		return data
+	}
+	cloudEvent[OriginalTopicExtension] = topic
+	b, err := json.Marshal(cloudEvent)
+	if err != nil {
+		return data
+	}
+	return b
+}
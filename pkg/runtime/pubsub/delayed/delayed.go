@@ -0,0 +1,349 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package delayed implements delayed publishing for pubsub components.
+// Messages published with a delay are parked in a state store configured on
+// the pubsub component and published by the runtime when they are due,
+// independently of the broker's support for delayed messages.
+package delayed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/utils/clock"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/parked"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// MetadataStateStore is the pubsub metadata property with the name of the state store used to park delayed messages.
+	MetadataStateStore = "delayedPublishStateStore"
+	// MetadataDeliverAt is the publish metadata key with the RFC3339 time at which the message is published.
+	MetadataDeliverAt = "deliverAt"
+	// MetadataDelay is the publish metadata key with the delay after which the message is published, e.g. "10s".
+	MetadataDelay = "delay"
+
+	messageKeyPrefix = "delayed-publish-"
+	messageType      = "dapr.pubsub.delayed"
+	// maxCanceled is the maximum number of pending messages with the same ID deleted by Cancel.
+	maxCanceled = 100
+)
+
+var log = logger.NewLogger("dapr.runtime.pubsub.delayed")
+
+// ErrNotFound is returned when canceling a delayed message which does not exist or was already published.
+var ErrNotFound = errors.New("delayed message not found")
+
+// Options contains the options for New.
+type Options struct {
+	AppID          string
+	ComponentStore *compstore.ComponentStore
+	// Publish forwards a message to a pubsub component.
+	Publish func(context.Context, *contribpubsub.PublishRequest) error
+	Clock   clock.WithTickerAndDelayedExecution
+}
+
+// Scheduler parks delayed messages in state stores and publishes them when they are due.
+type Scheduler struct {
+	appID     string
+	compStore *compstore.ComponentStore
+	publish   func(context.Context, *contribpubsub.PublishRequest) error
+	clock     clock.WithTickerAndDelayedExecution
+	relay     *parked.Relay
+
+	lock   sync.RWMutex
+	stores map[string]string
+}
+
+// message is the value saved in the state store for each delayed message.
+type message struct {
+	parked.Header
+	Pubsub      string            `json:"pubsub"`
+	Topic       string            `json:"topic"`
+	Data        []byte            `json:"data"`
+	ContentType string            `json:"contentType,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	DeliverAt   time.Time         `json:"deliverAt"`
+}
+
+// New returns a new Scheduler.
+func New(opts Options) *Scheduler {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	s := &Scheduler{
+		appID:     opts.AppID,
+		compStore: opts.ComponentStore,
+		publish:   opts.Publish,
+		clock:     opts.Clock,
+		stores:    make(map[string]string),
+	}
+	s.relay = parked.New(parked.Options{
+		Name:           "delayed message",
+		Type:           messageType,
+		AppID:          opts.AppID,
+		ComponentStore: opts.ComponentStore,
+		Stores:         s.stateStores,
+		Relay:          s.relayMessage,
+		DueTime:        deliverAtOf,
+		Clock:          opts.Clock,
+	})
+	return s
+}
+
+// AddOrUpdatePubsub enables delayed messages for a pubsub if its metadata properties configure a state store.
+func (s *Scheduler) AddOrUpdatePubsub(pubsubName string, props map[string]string) {
+	var storeName string
+	for k, v := range props {
+		if strings.EqualFold(k, MetadataStateStore) {
+			storeName = v
+		}
+	}
+
+	if storeName == "" {
+		s.RemovePubsub(pubsubName)
+		return
+	}
+
+	s.lock.Lock()
+	s.stores[pubsubName] = storeName
+	s.lock.Unlock()
+
+	// Messages parked by a previous instance are scheduled by Run.
+	s.relay.Recover(storeName)
+
+	log.Infof("Delayed messages enabled for pubsub %s using state store %s", pubsubName, storeName)
+}
+
+// RemovePubsub disables delayed messages for a pubsub.
+func (s *Scheduler) RemovePubsub(pubsubName string) {
+	s.lock.Lock()
+	delete(s.stores, pubsubName)
+	s.lock.Unlock()
+}
+
+func (s *Scheduler) stateStore(pubsubName string) (string, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	storeName, ok := s.stores[pubsubName]
+	return storeName, ok
+}
+
+// stateStores returns the names of the state stores used by the pubsubs.
+func (s *Scheduler) stateStores() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stores := make([]string, 0, len(s.stores))
+	seen := make(map[string]struct{}, len(s.stores))
+	for _, storeName := range s.stores {
+		if _, ok := seen[storeName]; !ok {
+			seen[storeName] = struct{}{}
+			stores = append(stores, storeName)
+		}
+	}
+	return stores
+}
+
+// Schedule parks the message if its metadata asks for it to be published later.
+// It returns the request to publish right away, without the delay metadata keys, or nil if the message was parked.
+// The request passed in is not modified.
+// The ID of a parked message, used to cancel it, is the ID of its cloud event; raw payloads get a random ID.
+// Messages sharing the same ID are all parked, and canceled together.
+func (s *Scheduler) Schedule(ctx context.Context, req *contribpubsub.PublishRequest) (*contribpubsub.PublishRequest, error) {
+	metadata, deliverAt, ok, err := s.deliverAt(req.Metadata)
+	if err != nil || !ok {
+		return req, err
+	}
+	if !deliverAt.After(s.clock.Now()) {
+		due := *req
+		due.Metadata = metadata
+		return &due, nil
+	}
+
+	storeName, ok := s.stateStore(req.PubsubName)
+	if !ok {
+		return nil, fmt.Errorf("pubsub %s does not support delayed messages: metadata property %s is not set", req.PubsubName, MetadataStateStore)
+	}
+	store, ok := s.compStore.GetStateStore(storeName)
+	if !ok {
+		return nil, fmt.Errorf("state store %s for delayed messages of pubsub %s not found", storeName, req.PubsubName)
+	}
+	// Messages due after the scheduling horizon of the relay are only found by querying the state store.
+	if err = s.relay.Recoverable(storeName); err != nil {
+		return nil, fmt.Errorf("state store %s can't be used for delayed messages of pubsub %s: %w", storeName, req.PubsubName, err)
+	}
+
+	id := messageID(req.Data)
+	val, err := s.relay.Encode(storeName, message{
+		Header: parked.Header{
+			Type:   messageType,
+			ID:     id,
+			Source: s.appID,
+		},
+		Pubsub:      req.PubsubName,
+		Topic:       req.Topic,
+		Data:        req.Data,
+		ContentType: stringValue(req.ContentType),
+		Metadata:    metadata,
+		DeliverAt:   deliverAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode delayed message: %w", err)
+	}
+
+	// Keys are unique so messages sharing the same ID don't overwrite each other.
+	key, err := stateLoader.GetModifiedStateKey(messageKeyPrefix+req.PubsubName+"-"+uuid.NewString(), storeName, s.appID)
+	if err != nil {
+		return nil, err
+	}
+	err = store.Set(ctx, &state.SetRequest{Key: key, Value: val})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save delayed message: %w", err)
+	}
+
+	s.relay.Schedule(storeName, key, deliverAt)
+	log.Debugf("Delayed message %s for topic %s of pubsub %s until %v", id, req.Topic, req.PubsubName, deliverAt)
+	return nil, nil
+}
+
+// Cancel deletes the delayed messages with the given ID which have not been published yet.
+func (s *Scheduler) Cancel(ctx context.Context, pubsubName, id string) error {
+	storeName, ok := s.stateStore(pubsubName)
+	if !ok {
+		return ErrNotFound
+	}
+	store, ok := s.compStore.GetStateStore(storeName)
+	if !ok {
+		return fmt.Errorf("state store %s for delayed messages of pubsub %s not found", storeName, pubsubName)
+	}
+
+	items, err := s.relay.Find(ctx, storeName, map[string]string{"pubsub": pubsubName, "id": id}, maxCanceled)
+	if err != nil {
+		return fmt.Errorf("failed to find delayed message: %w", err)
+	}
+	if len(items) == 0 {
+		return ErrNotFound
+	}
+
+	for _, item := range items {
+		s.relay.Unschedule(storeName, item.Key)
+		err = store.Delete(ctx, &state.DeleteRequest{Key: item.Key})
+		if err != nil {
+			return fmt.Errorf("failed to delete delayed message: %w", err)
+		}
+	}
+	return nil
+}
+
+// Run publishes the delayed messages when they are due, until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.relay.Run(ctx)
+}
+
+func (s *Scheduler) relayMessage(ctx context.Context, _ string, val []byte) error {
+	var msg message
+	if err := json.Unmarshal(val, &msg); err != nil {
+		return fmt.Errorf("failed to unmarshal delayed message: %w", err)
+	}
+
+	req := &contribpubsub.PublishRequest{
+		PubsubName: msg.Pubsub,
+		Topic:      msg.Topic,
+		Data:       msg.Data,
+		Metadata:   msg.Metadata,
+	}
+	if msg.ContentType != "" {
+		req.ContentType = &msg.ContentType
+	}
+	if err := s.publish(ctx, req); err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+	return nil
+}
+
+// deliverAt returns a copy of the metadata without the delay keys, and the time at which the message must be published if set.
+func (s *Scheduler) deliverAt(metadata map[string]string) (map[string]string, time.Time, bool, error) {
+	var deliverAt, delay string
+	rest := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		switch {
+		case strings.EqualFold(k, MetadataDeliverAt):
+			deliverAt = v
+		case strings.EqualFold(k, MetadataDelay):
+			delay = v
+		default:
+			rest[k] = v
+		}
+	}
+
+	switch {
+	case deliverAt != "" && delay != "":
+		return nil, time.Time{}, false, fmt.Errorf("metadata %s and %s are mutually exclusive", MetadataDeliverAt, MetadataDelay)
+	case deliverAt != "":
+		t, err := time.Parse(time.RFC3339, deliverAt)
+		if err != nil {
+			return nil, time.Time{}, false, fmt.Errorf("invalid %s metadata: %w", MetadataDeliverAt, err)
+		}
+		return rest, t, true, nil
+	case delay != "":
+		d, err := time.ParseDuration(delay)
+		if err != nil {
+			return nil, time.Time{}, false, fmt.Errorf("invalid %s metadata: %w", MetadataDelay, err)
+		}
+		return rest, s.clock.Now().Add(d), true, nil
+	default:
+		return metadata, time.Time{}, false, nil
+	}
+}
+
+// deliverAtOf returns the time at which the message saved with the given value is published.
+func deliverAtOf(val []byte) (time.Time, error) {
+	var msg message
+	if err := json.Unmarshal(val, &msg); err != nil {
+		return time.Time{}, err
+	}
+	if msg.Type != messageType {
+		return time.Time{}, fmt.Errorf("unexpected type %q", msg.Type)
+	}
+	return msg.DeliverAt, nil
+}
+
+// messageID returns the ID of the cloud event in data, or a random ID if data is not a cloud event.
+func messageID(data []byte) string {
+	var ce struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &ce); err == nil && ce.ID != "" {
+		return ce.ID
+	}
+	return uuid.NewString()
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
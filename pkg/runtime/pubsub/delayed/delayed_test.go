@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delayed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestSchedule(t *testing.T) {
+	store := daprt.NewFakeQueryStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+	compStore.AddStateStore("noquery", daprt.NewFakeStateStore())
+
+	published := make(chan *contribpubsub.PublishRequest, 10)
+	clock := clocktesting.NewFakeClock(time.Now())
+	s := New(Options{
+		AppID:          "app",
+		ComponentStore: compStore,
+		Clock:          clock,
+		Publish: func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			published <- req
+			return nil
+		},
+	})
+	s.AddOrUpdatePubsub("pubsub", map[string]string{"DelayedPublishStateStore": "store"})
+	s.AddOrUpdatePubsub("noquery", map[string]string{MetadataStateStore: "noquery"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	parked := func(t *testing.T, id string) []string {
+		t.Helper()
+		items, err := s.relay.Find(ctx, "store", map[string]string{"id": id}, 10)
+		require.NoError(t, err)
+		keys := make([]string, len(items))
+		for i, item := range items {
+			keys[i] = item.Key
+		}
+		return keys
+	}
+
+	assertNotPublished := func(t *testing.T) {
+		t.Helper()
+		select {
+		case <-published:
+			t.Fatal("message was published before it was due")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	t.Run("message without delay", func(t *testing.T) {
+		req := &contribpubsub.PublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Metadata:   map[string]string{"foo": "bar"},
+		}
+		due, err := s.Schedule(ctx, req)
+		require.NoError(t, err)
+		assert.Same(t, req, due)
+	})
+
+	t.Run("message already due", func(t *testing.T) {
+		md := map[string]string{MetadataDeliverAt: clock.Now().Add(-time.Minute).Format(time.RFC3339), "foo": "bar"}
+		due, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Metadata:   md,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, due)
+		assert.Equal(t, map[string]string{"foo": "bar"}, due.Metadata)
+		assert.Len(t, md, 2, "metadata of the request is not modified")
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Metadata:   map[string]string{MetadataDelay: "soon"},
+		})
+		require.Error(t, err)
+
+		_, err = s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Metadata:   map[string]string{MetadataDelay: "1m", MetadataDeliverAt: clock.Now().Format(time.RFC3339)},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("pubsub without state store", func(t *testing.T) {
+		_, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "other",
+			Topic:      "topic",
+			Metadata:   map[string]string{MetadataDelay: "1m"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("state store without queries", func(t *testing.T) {
+		_, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "noquery",
+			Topic:      "topic",
+			Metadata:   map[string]string{MetadataDelay: "1m"},
+		})
+		require.ErrorContains(t, err, "does not support queries")
+	})
+
+	t.Run("message is published when due", func(t *testing.T) {
+		md := map[string]string{MetadataDelay: "1m", "foo": "bar"}
+		due, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Data:       []byte(`{"id":"msg1","data":"hello"}`),
+			Metadata:   md,
+		})
+		require.NoError(t, err)
+		require.Nil(t, due)
+		assert.Len(t, md, 2, "metadata of the request is not modified")
+
+		keys := parked(t, "msg1")
+		require.Len(t, keys, 1)
+
+		clock.Step(30 * time.Second)
+		assertNotPublished(t)
+
+		clock.Step(30 * time.Second)
+		select {
+		case req := <-published:
+			assert.Equal(t, "pubsub", req.PubsubName)
+			assert.Equal(t, "topic", req.Topic)
+			assert.Equal(t, `{"id":"msg1","data":"hello"}`, string(req.Data))
+			assert.Equal(t, map[string]string{"foo": "bar"}, req.Metadata)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message was not published")
+		}
+
+		assert.Eventually(t, func() bool {
+			res, err := store.Get(ctx, &state.GetRequest{Key: keys[0]})
+			return err == nil && res.Data == nil
+		}, 5*time.Second, 10*time.Millisecond, "message was not deleted")
+	})
+
+	t.Run("messages with the same ID don't overwrite each other", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			due, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+				PubsubName: "pubsub",
+				Topic:      "topic",
+				Data:       []byte(`{"id":"msg2"}`),
+				Metadata:   map[string]string{MetadataDelay: "1m"},
+			})
+			require.NoError(t, err)
+			require.Nil(t, due)
+		}
+		assert.Len(t, parked(t, "msg2"), 2)
+
+		clock.Step(time.Minute)
+		for i := 0; i < 2; i++ {
+			select {
+			case <-published:
+			case <-time.After(5 * time.Second):
+				t.Fatal("message was not published")
+			}
+		}
+	})
+
+	t.Run("canceled messages are not published", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			due, err := s.Schedule(ctx, &contribpubsub.PublishRequest{
+				PubsubName: "pubsub",
+				Topic:      "topic",
+				Data:       []byte(`{"id":"msg3"}`),
+				Metadata:   map[string]string{MetadataDeliverAt: clock.Now().Add(time.Minute).Format(time.RFC3339)},
+			})
+			require.NoError(t, err)
+			require.Nil(t, due)
+		}
+
+		require.NoError(t, s.Cancel(ctx, "pubsub", "msg3"))
+		assert.Empty(t, parked(t, "msg3"))
+		require.ErrorIs(t, s.Cancel(ctx, "pubsub", "msg3"), ErrNotFound)
+
+		clock.Step(2 * time.Minute)
+		select {
+		case <-published:
+			t.Fatal("canceled message was published")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+const (
+	// SchemaTypeJSONSchema validates the data of messages against a JSON Schema.
+	SchemaTypeJSONSchema = "jsonschema"
+	// SchemaTypeProtobuf validates the data of messages against a protobuf message descriptor.
+	SchemaTypeProtobuf = "protobuf"
+
+	// TopicSchemasMetadataKey is the pubsub component metadata property with the schemas of its topics,
+	// as a JSON object mapping topic names to schemas.
+	TopicSchemasMetadataKey = "topicSchemas"
+)
+
+// Schema is the schema the data of the messages of a topic must match.
+type Schema struct {
+	Type string `json:"type"`
+	// JSONSchema is the self-contained JSON Schema document, for the jsonschema type.
+	JSONSchema string `json:"jsonSchema,omitempty"`
+	// ProtoDescriptorSet is the base64-encoded FileDescriptorSet containing the message and its
+	// dependencies, for the protobuf type.
+	ProtoDescriptorSet string `json:"protoDescriptorSet,omitempty"`
+	// ProtoMessage is the fully-qualified name of the message in ProtoDescriptorSet, for the protobuf type.
+	ProtoMessage string `json:"protoMessage,omitempty"`
+}
+
+// SchemaValidator validates the data of messages against a schema.
+type SchemaValidator interface {
+	Validate(data []byte, contentType string) error
+}
+
+// SchemaValidationError is returned by the runtime when a message does not match the schema of its topic.
+type SchemaValidationError struct {
+	Topic string
+	Err   error
+}
+
+func (e SchemaValidationError) Error() string {
+	return fmt.Sprintf("message for topic %s does not match the schema: %s", e.Topic, e.Err)
+}
+
+func (e SchemaValidationError) Unwrap() error {
+	return e.Err
+}
+
+// NewSchemaValidator returns a validator for the schema.
+func NewSchemaValidator(s Schema) (SchemaValidator, error) {
+	switch strings.ToLower(s.Type) {
+	case SchemaTypeJSONSchema:
+		if s.JSONSchema == "" {
+			return nil, errors.New("jsonSchema is required for schemas of type jsonschema")
+		}
+		var schema spec.Schema
+		if err := json.Unmarshal([]byte(s.JSONSchema), &schema); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON Schema: %w", err)
+		}
+		return &jsonSchemaValidator{schema: &schema}, nil
+
+	case SchemaTypeProtobuf:
+		if s.ProtoDescriptorSet == "" || s.ProtoMessage == "" {
+			return nil, errors.New("protoDescriptorSet and protoMessage are required for schemas of type protobuf")
+		}
+		b, err := base64.StdEncoding.DecodeString(s.ProtoDescriptorSet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode protobuf descriptor set: %w", err)
+		}
+		var fds descriptorpb.FileDescriptorSet
+		if err = proto.Unmarshal(b, &fds); err != nil {
+			return nil, fmt.Errorf("failed to parse protobuf descriptor set: %w", err)
+		}
+		files, err := protodesc.NewFiles(&fds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load protobuf descriptor set: %w", err)
+		}
+		d, err := files.FindDescriptorByName(protoreflect.FullName(s.ProtoMessage))
+		if err != nil {
+			return nil, fmt.Errorf("protobuf message %s not found in descriptor set: %w", s.ProtoMessage, err)
+		}
+		md, ok := d.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a protobuf message", s.ProtoMessage)
+		}
+		return &protoValidator{desc: md}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported schema type '%s'", s.Type)
+	}
+}
+
+// ParseTopicSchemas returns the validators for the schemas of the topics configured in the metadata
+// of a pubsub component.
+func ParseTopicSchemas(props map[string]string) (map[string]SchemaValidator, error) {
+	var val string
+	for k, v := range props {
+		if strings.EqualFold(k, TopicSchemasMetadataKey) {
+			val = v
+			break
+		}
+	}
+	if val == "" {
+		return nil, nil
+	}
+
+	var schemas map[string]struct {
+		Schema
+		// JSONSchema can also be embedded as a JSON object in the metadata.
+		JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(val), &schemas); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", TopicSchemasMetadataKey, err)
+	}
+
+	validators := make(map[string]SchemaValidator, len(schemas))
+	for topic, s := range schemas {
+		if len(s.JSONSchema) > 0 {
+			s.Schema.JSONSchema = string(s.JSONSchema)
+			var str string
+			if json.Unmarshal(s.JSONSchema, &str) == nil {
+				s.Schema.JSONSchema = str
+			}
+		}
+		v, err := NewSchemaValidator(s.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema for topic %s: %w", topic, err)
+		}
+		validators[topic] = v
+	}
+	return validators, nil
+}
+
+// ValidateCloudEventData validates the data of a cloud event against the schema.
+func ValidateCloudEventData(v SchemaValidator, cloudEvent map[string]any) error {
+	ct, _ := cloudEvent[contribpubsub.DataContentTypeField].(string)
+
+	var data []byte
+	if b64, ok := cloudEvent[contribpubsub.DataBase64Field].(string); ok {
+		var err error
+		data, err = base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", contribpubsub.DataBase64Field, err)
+		}
+	} else if s, ok := cloudEvent[contribpubsub.DataField].(string); ok && !contenttype.IsJSONContentType(ct) {
+		data = []byte(s)
+	} else {
+		var err error
+		data, err = json.Marshal(cloudEvent[contribpubsub.DataField])
+		if err != nil {
+			return err
+		}
+		if ct == "" {
+			ct = "application/json"
+		}
+	}
+
+	return v.Validate(data, ct)
+}
+
+type jsonSchemaValidator struct {
+	schema *spec.Schema
+}
+
+func (v *jsonSchemaValidator) Validate(data []byte, _ string) error {
+	var val any
+	if err := json.Unmarshal(data, &val); err != nil {
+		return fmt.Errorf("data is not valid JSON: %w", err)
+	}
+	return validate.AgainstSchema(v.schema, val, strfmt.Default)
+}
+
+type protoValidator struct {
+	desc protoreflect.MessageDescriptor
+}
+
+func (v *protoValidator) Validate(data []byte, contentType string) error {
+	msg := dynamicpb.NewMessage(v.desc)
+	if contenttype.IsJSONContentType(contentType) {
+		return protojson.Unmarshal(data, msg)
+	}
+	return proto.Unmarshal(data, msg)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testJSONSchema = `{"type":"object","required":["orderId"],"properties":{"orderId":{"type":"integer"}}}`
+
+func TestNewSchemaValidator(t *testing.T) {
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := NewSchemaValidator(Schema{Type: "avro"})
+		require.Error(t, err)
+	})
+
+	t.Run("missing JSON Schema", func(t *testing.T) {
+		_, err := NewSchemaValidator(Schema{Type: SchemaTypeJSONSchema})
+		require.Error(t, err)
+	})
+
+	t.Run("protobuf message not found", func(t *testing.T) {
+		_, err := NewSchemaValidator(Schema{
+			Type:               SchemaTypeProtobuf,
+			ProtoDescriptorSet: testDescriptorSet(t),
+			ProtoMessage:       "google.protobuf.Missing",
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestJSONSchemaValidator(t *testing.T) {
+	v, err := NewSchemaValidator(Schema{Type: SchemaTypeJSONSchema, JSONSchema: testJSONSchema})
+	require.NoError(t, err)
+
+	require.NoError(t, v.Validate([]byte(`{"orderId":1}`), "application/json"))
+	require.Error(t, v.Validate([]byte(`{"orderId":"1"}`), "application/json"))
+	require.Error(t, v.Validate([]byte(`{}`), "application/json"))
+	require.Error(t, v.Validate([]byte(`not json`), "text/plain"))
+}
+
+func TestProtoValidator(t *testing.T) {
+	v, err := NewSchemaValidator(Schema{
+		Type:               SchemaTypeProtobuf,
+		ProtoDescriptorSet: testDescriptorSet(t),
+		ProtoMessage:       "google.protobuf.StringValue",
+	})
+	require.NoError(t, err)
+
+	b, err := proto.Marshal(wrapperspb.String("hello"))
+	require.NoError(t, err)
+	require.NoError(t, v.Validate(b, "application/octet-stream"))
+	require.NoError(t, v.Validate([]byte(`"hello"`), "application/json"))
+	require.Error(t, v.Validate([]byte{0xff, 0xff}, "application/octet-stream"))
+	require.Error(t, v.Validate([]byte(`{"value":1}`), "application/json"))
+}
+
+func TestParseTopicSchemas(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		validators, err := ParseTopicSchemas(map[string]string{})
+		require.NoError(t, err)
+		assert.Empty(t, validators)
+	})
+
+	t.Run("JSON Schema as object or string", func(t *testing.T) {
+		validators, err := ParseTopicSchemas(map[string]string{
+			"TopicSchemas": `{
+				"orders": {"type": "jsonschema", "jsonSchema": ` + testJSONSchema + `},
+				"payments": {"type": "jsonschema", "jsonSchema": "{\"type\":\"string\"}"}
+			}`,
+		})
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+		require.NoError(t, validators["orders"].Validate([]byte(`{"orderId":1}`), ""))
+		require.Error(t, validators["orders"].Validate([]byte(`{}`), ""))
+		require.NoError(t, validators["payments"].Validate([]byte(`"ok"`), ""))
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := ParseTopicSchemas(map[string]string{
+			TopicSchemasMetadataKey: `{"orders": {"type": "avro"}}`,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestValidateCloudEventData(t *testing.T) {
+	v, err := NewSchemaValidator(Schema{Type: SchemaTypeJSONSchema, JSONSchema: testJSONSchema})
+	require.NoError(t, err)
+
+	t.Run("JSON data", func(t *testing.T) {
+		require.NoError(t, ValidateCloudEventData(v, map[string]any{
+			"datacontenttype": "application/json",
+			"data":            map[string]any{"orderId": 1},
+		}))
+		require.Error(t, ValidateCloudEventData(v, map[string]any{
+			"datacontenttype": "application/json",
+			"data":            map[string]any{"orderId": "a"},
+		}))
+	})
+
+	t.Run("string data", func(t *testing.T) {
+		require.NoError(t, ValidateCloudEventData(v, map[string]any{
+			"datacontenttype": "text/plain",
+			"data":            `{"orderId":1}`,
+		}))
+	})
+
+	t.Run("base64 data", func(t *testing.T) {
+		require.NoError(t, ValidateCloudEventData(v, map[string]any{
+			"datacontenttype": "application/octet-stream",
+			"data_base64":     base64.StdEncoding.EncodeToString([]byte(`{"orderId":1}`)),
+		}))
+	})
+}
+
+func testDescriptorSet(t *testing.T) string {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto),
+		},
+	}
+	b, err := proto.Marshal(fds)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
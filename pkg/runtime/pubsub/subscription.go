@@ -1,13 +1,23 @@
 package pubsub
 
 type Subscription struct {
-	PubsubName      string            `json:"pubsubname"`
-	Topic           string            `json:"topic"`
-	DeadLetterTopic string            `json:"deadLetterTopic"`
-	Metadata        map[string]string `json:"metadata"`
-	Rules           []*Rule           `json:"rules,omitempty"`
-	Scopes          []string          `json:"scopes"`
-	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	PubsubName           string            `json:"pubsubname"`
+	Topic                string            `json:"topic"`
+	DeadLetterTopic      string            `json:"deadLetterTopic"`
+	Metadata             map[string]string `json:"metadata"`
+	Rules                []*Rule           `json:"rules,omitempty"`
+	Scopes               []string          `json:"scopes"`
+	BulkSubscribe        *BulkSubscribe    `json:"bulkSubscribe"`
+	ConcurrencyKey       string            `json:"concurrencyKey,omitempty"`
+	Deduplication        *Deduplication    `json:"deduplication,omitempty"`
+	Schema               *Schema           `json:"schema,omitempty"`
+	MaxConcurrency       int32             `json:"maxConcurrency,omitempty"`
+	MaxMessagesPerSecond int32             `json:"maxMessagesPerSecond,omitempty"`
+}
+
+type Deduplication struct {
+	StateStore   string `json:"stateStore"`
+	TTLInSeconds int32  `json:"ttlInSeconds,omitempty"`
 }
 
 type BulkSubscribe struct {
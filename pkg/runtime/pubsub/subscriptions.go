@@ -3,6 +3,7 @@ package pubsub
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -40,13 +41,23 @@ const (
 
 type (
 	SubscriptionJSON struct {
-		PubsubName      string            `json:"pubsubname"`
-		Topic           string            `json:"topic"`
-		DeadLetterTopic string            `json:"deadLetterTopic"`
-		Metadata        map[string]string `json:"metadata,omitempty"`
-		Route           string            `json:"route"`  // Single route from v1alpha1
-		Routes          RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
-		BulkSubscribe   BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+		PubsubName           string            `json:"pubsubname"`
+		Topic                string            `json:"topic"`
+		DeadLetterTopic      string            `json:"deadLetterTopic"`
+		Metadata             map[string]string `json:"metadata,omitempty"`
+		Route                string            `json:"route"`  // Single route from v1alpha1
+		Routes               RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
+		BulkSubscribe        BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+		ConcurrencyKey       string            `json:"concurrencyKey,omitempty"`
+		Deduplication        DeduplicationJSON `json:"deduplication,omitempty"`
+		Schema               Schema            `json:"schema,omitempty"`
+		MaxConcurrency       int32             `json:"maxConcurrency,omitempty"`
+		MaxMessagesPerSecond int32             `json:"maxMessagesPerSecond,omitempty"`
+	}
+
+	DeduplicationJSON struct {
+		StateStore   string `json:"stateStore"`
+		TTLInSeconds int32  `json:"ttlInSeconds,omitempty"`
 	}
 
 	RoutesJSON struct {
@@ -139,12 +150,17 @@ func GetSubscriptionsHTTP(ctx context.Context, channel channel.AppChannel, log l
 				MaxAwaitDurationMs: si.BulkSubscribe.MaxAwaitDurationMs,
 			}
 			subscriptions[i] = Subscription{
-				PubsubName:      si.PubsubName,
-				Topic:           si.Topic,
-				Metadata:        si.Metadata,
-				DeadLetterTopic: si.DeadLetterTopic,
-				Rules:           rules[:n],
-				BulkSubscribe:   bulkSubscribe,
+				PubsubName:           si.PubsubName,
+				Topic:                si.Topic,
+				Metadata:             si.Metadata,
+				DeadLetterTopic:      si.DeadLetterTopic,
+				Rules:                rules[:n],
+				BulkSubscribe:        bulkSubscribe,
+				ConcurrencyKey:       si.ConcurrencyKey,
+				Deduplication:        newDeduplication(si.Deduplication.StateStore, si.Deduplication.TTLInSeconds),
+				Schema:               newSchema(si.Schema),
+				MaxConcurrency:       si.MaxConcurrency,
+				MaxMessagesPerSecond: si.MaxMessagesPerSecond,
 			}
 		}
 
@@ -222,6 +238,16 @@ func GetSubscriptionsGRPC(ctx context.Context, channel runtimev1pb.AppCallbackCl
 				DeadLetterTopic: s.DeadLetterTopic,
 				Rules:           rules,
 				BulkSubscribe:   bulkSubscribe,
+				ConcurrencyKey:  s.GetConcurrencyKey(),
+				Deduplication:   newDeduplication(s.GetDeduplication().GetStateStore(), s.GetDeduplication().GetTtlInSeconds()),
+				Schema: newSchema(Schema{
+					Type:               s.GetSchema().GetType(),
+					JSONSchema:         s.GetSchema().GetJsonSchema(),
+					ProtoDescriptorSet: base64.StdEncoding.EncodeToString(s.GetSchema().GetProtoDescriptorSet()),
+					ProtoMessage:       s.GetSchema().GetProtoMessageType(),
+				}),
+				MaxConcurrency:       s.GetMaxConcurrency(),
+				MaxMessagesPerSecond: s.GetMaxMessagesPerSecond(),
 			}
 		}
 	}
@@ -332,6 +358,16 @@ func unmarshalSubscription(b []byte, namespace string) (*Subscription, error) {
 				MaxMessagesCount:   sub.Spec.BulkSubscribe.MaxMessagesCount,
 				MaxAwaitDurationMs: sub.Spec.BulkSubscribe.MaxAwaitDurationMs,
 			},
+			ConcurrencyKey: sub.Spec.ConcurrencyKey,
+			Deduplication:  newDeduplication(sub.Spec.Deduplication.StateStore, sub.Spec.Deduplication.TTLInSeconds),
+			Schema: newSchema(Schema{
+				Type:               sub.Spec.Schema.Type,
+				JSONSchema:         sub.Spec.Schema.JSONSchema,
+				ProtoDescriptorSet: sub.Spec.Schema.ProtoDescriptorSet,
+				ProtoMessage:       sub.Spec.Schema.ProtoMessage,
+			}),
+			MaxConcurrency:       sub.Spec.MaxConcurrency,
+			MaxMessagesPerSecond: sub.Spec.MaxMessagesPerSecond,
 		}, nil
 
 	default:
@@ -365,6 +401,25 @@ func unmarshalSubscription(b []byte, namespace string) (*Subscription, error) {
 	}
 }
 
+// newDeduplication returns the duplicate detection settings, or nil if duplicate detection is not enabled.
+func newDeduplication(stateStore string, ttlInSeconds int32) *Deduplication {
+	if stateStore == "" {
+		return nil
+	}
+	return &Deduplication{
+		StateStore:   stateStore,
+		TTLInSeconds: ttlInSeconds,
+	}
+}
+
+// newSchema returns the schema, or nil if no schema is configured.
+func newSchema(s Schema) *Schema {
+	if s.Type == "" {
+		return nil
+	}
+	return &s
+}
+
 func parseRoutingRulesYAML(routes subscriptionsapiV2alpha1.Routes) ([]*Rule, error) {
 	r := make([]*Rule, len(routes.Rules)+1)
 
@@ -446,15 +501,15 @@ func createRoutingRule(match, path string) (*Rule, error) {
 }
 
 // DeclarativeKubernetes loads subscriptions from the operator when running in Kubernetes.
-func DeclarativeKubernetes(ctx context.Context, client operatorv1pb.OperatorClient, podName string, namespace string, log logger.Logger) []Subscription {
+// It returns an error if the subscriptions couldn't be listed.
+func DeclarativeKubernetes(ctx context.Context, client operatorv1pb.OperatorClient, podName string, namespace string, log logger.Logger) ([]Subscription, error) {
 	var subs []Subscription
 	resp, err := client.ListSubscriptionsV2(ctx, &operatorv1pb.ListSubscriptionsRequest{
 		PodName:   podName,
 		Namespace: namespace,
 	})
 	if err != nil {
-		log.Errorf("Failed to list subscriptions from operator: %s", err)
-		return subs
+		return nil, fmt.Errorf("failed to list subscriptions from operator: %w", err)
 	}
 
 	for _, s := range resp.Subscriptions {
@@ -466,7 +521,7 @@ func DeclarativeKubernetes(ctx context.Context, client operatorv1pb.OperatorClie
 		}
 	}
 
-	return subs
+	return subs, nil
 }
 
 func appendSubscription(list []Subscription, subBytes []byte, namespace string) ([]Subscription, error) {
@@ -363,6 +363,71 @@ func TestDeclarativeSubscriptionsV2(t *testing.T) {
 		}
 	})
 
+	t.Run("load subscription with concurrency key", func(t *testing.T) {
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.ConcurrencyKey = "partitionkey"
+
+		filePath := filepath.Join(dir, "sub.yaml")
+		writeSubscriptionToDisk(s, filePath)
+		defer os.RemoveAll(filePath)
+
+		subs := DeclarativeLocal([]string{dir}, "", log)
+		if assert.Len(t, subs, 1) {
+			assert.Equal(t, "partitionkey", subs[0].ConcurrencyKey)
+		}
+	})
+
+	t.Run("load subscription with deduplication", func(t *testing.T) {
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.Deduplication = subscriptionsapiV2alpha1.Deduplication{
+			StateStore:   "statestore",
+			TTLInSeconds: 60,
+		}
+
+		filePath := filepath.Join(dir, "sub.yaml")
+		writeSubscriptionToDisk(s, filePath)
+		defer os.RemoveAll(filePath)
+
+		subs := DeclarativeLocal([]string{dir}, "", log)
+		if assert.Len(t, subs, 1) {
+			assert.Equal(t, &Deduplication{StateStore: "statestore", TTLInSeconds: 60}, subs[0].Deduplication)
+		}
+	})
+
+	t.Run("load subscription with concurrency and rate limits", func(t *testing.T) {
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.MaxConcurrency = 2
+		s.Spec.MaxMessagesPerSecond = 100
+
+		filePath := filepath.Join(dir, "sub.yaml")
+		writeSubscriptionToDisk(s, filePath)
+		defer os.RemoveAll(filePath)
+
+		subs := DeclarativeLocal([]string{dir}, "", log)
+		if assert.Len(t, subs, 1) {
+			assert.Equal(t, int32(2), subs[0].MaxConcurrency)
+			assert.Equal(t, int32(100), subs[0].MaxMessagesPerSecond)
+		}
+	})
+
+	t.Run("load subscription with schema", func(t *testing.T) {
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.Schema = subscriptionsapiV2alpha1.Schema{
+			Type:       "jsonschema",
+			JSONSchema: `{"type":"object"}`,
+		}
+
+		filePath := filepath.Join(dir, "sub.yaml")
+		writeSubscriptionToDisk(s, filePath)
+		defer os.RemoveAll(filePath)
+
+		subs := DeclarativeLocal([]string{dir}, "", log)
+		if assert.Len(t, subs, 1) && assert.NotNil(t, subs[0].Schema) {
+			assert.Equal(t, "jsonschema", subs[0].Schema.Type)
+			assert.Equal(t, `{"type":"object"}`, subs[0].Schema.JSONSchema)
+		}
+	})
+
 	t.Run("load multiple subscriptions in different files", func(t *testing.T) {
 		for i := 0; i < subscriptionCount; i++ {
 			iStr := fmt.Sprintf("%v", i)
@@ -786,7 +851,8 @@ func (m *mockK8sSubscriptions) ListSubscriptionsV2(ctx context.Context, in *oper
 
 func TestK8sSubscriptions(t *testing.T) {
 	m := mockK8sSubscriptions{}
-	subs := DeclarativeKubernetes(context.TODO(), &m, "testPodName", "testNamespace", log)
+	subs, err := DeclarativeKubernetes(context.TODO(), &m, "testPodName", "testNamespace", log)
+	require.NoError(t, err)
 	if assert.Len(t, subs, 1) {
 		assert.Equal(t, "topic1", subs[0].Topic)
 		if assert.Len(t, subs[0].Rules, 3) {
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/internal/watch"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// WatchDeclarativeLocal watches the resources paths and calls onChange with all the declarative
+// subscriptions they contain every time a file in them is created, modified or removed.
+// It blocks until the context is canceled.
+func WatchDeclarativeLocal(ctx context.Context, resourcesPaths []string, namespace string, log logger.Logger, onChange func([]Subscription)) error {
+	return watch.Local(ctx, log, "declarative subscriptions", resourcesPaths, func() {
+		onChange(DeclarativeLocal(resourcesPaths, namespace, log))
+	})
+}
+
+// WatchDeclarativeKubernetes watches the subscriptions of the namespace through the operator and
+// calls onChange with all the declarative subscriptions every time one is created, modified or deleted.
+// onChange isn't called when the subscriptions can't be listed, so the current ones are kept.
+// It blocks until the context is canceled.
+func WatchDeclarativeKubernetes(ctx context.Context, client operatorv1pb.OperatorClient, podName string, namespace string, log logger.Logger, onChange func([]Subscription)) error {
+	if client == nil {
+		return nil
+	}
+
+	connect := func(ctx context.Context) (watch.UpdateStream[*operatorv1pb.SubscriptionUpdateEvent], error) {
+		return client.SubscriptionUpdate(ctx, &operatorv1pb.SubscriptionUpdateRequest{
+			Namespace: namespace,
+			PodName:   podName,
+		})
+	}
+	return watch.Operator(ctx, log, "declarative subscriptions", connect, func() {
+		subs, err := DeclarativeKubernetes(ctx, client, podName, namespace, log)
+		if err != nil {
+			log.Errorf("Failed to reload declarative subscriptions, keeping the current ones: %s", err)
+			return
+		}
+		onChange(subs)
+	})
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchDeclarativeLocal(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	changes := make(chan []Subscription, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- WatchDeclarativeLocal(ctx, []string{dir, filepath.Join(dir, "missing")}, "", log, func(subs []Subscription) {
+			changes <- subs
+		})
+	}()
+	// Wait for the watcher to start.
+	time.Sleep(100 * time.Millisecond)
+
+	waitChange := func(t *testing.T) []Subscription {
+		t.Helper()
+		select {
+		case subs := <-changes:
+			return subs
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "subscriptions were not reloaded")
+			return nil
+		}
+	}
+
+	filePath := filepath.Join(dir, "sub.yaml")
+
+	t.Run("subscription added", func(t *testing.T) {
+		writeSubscriptionToDisk(testDeclarativeSubscriptionV2(), filePath)
+		subs := waitChange(t)
+		require.Len(t, subs, 1)
+		assert.Equal(t, "topic1", subs[0].Topic)
+		assert.Len(t, subs[0].Rules, 3)
+	})
+
+	t.Run("subscription modified", func(t *testing.T) {
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.Routes.Rules = nil
+		writeSubscriptionToDisk(s, filePath)
+		subs := waitChange(t)
+		require.Len(t, subs, 1)
+		if assert.Len(t, subs[0].Rules, 1) {
+			assert.Equal(t, "myroute", subs[0].Rules[0].Path)
+		}
+	})
+
+	t.Run("subscription removed", func(t *testing.T) {
+		require.NoError(t, os.Remove(filePath))
+		assert.Empty(t, waitChange(t))
+	})
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
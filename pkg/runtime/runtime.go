@@ -48,10 +48,12 @@ import (
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpEndpointV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/apphealth"
 	"github.com/dapr/dapr/pkg/components"
 	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
+	env "github.com/dapr/dapr/pkg/config/env"
 	"github.com/dapr/dapr/pkg/config/protocol"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
@@ -63,6 +65,7 @@ import (
 	httpMiddleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/meta"
@@ -257,6 +260,14 @@ func getPodName() string {
 	return os.Getenv("POD_NAME")
 }
 
+func getTopology() hashing.Topology {
+	return hashing.Topology{
+		Region: os.Getenv(env.TopologyRegion),
+		Zone:   os.Getenv(env.TopologyZone),
+		Node:   os.Getenv(env.TopologyNode),
+	}
+}
+
 func getOperatorClient(ctx context.Context, cfg *internalConfig) (operatorv1pb.OperatorClient, error) {
 	// Get the operator client only if we're running in Kubernetes and if we need it
 	if cfg.mode != modes.KubernetesMode {
@@ -348,6 +359,9 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if a.authenticator != nil {
+		go a.authenticator.WatchRevocationList(ctx)
+	}
 	a.podName = getPodName()
 
 	if a.hostAddress, err = utils.GetHostAddress(); err != nil {
@@ -366,6 +380,8 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 
 	go a.processComponents(ctx)
 	go a.processHTTPEndpoints(ctx)
+	go a.processor.Outbox().Run(ctx)
+	go a.processor.Delayed().Run(ctx)
 
 	if _, ok := os.LookupEnv(hotReloadingEnvVar); ok {
 		log.Debug("starting to watch component updates")
@@ -379,6 +395,20 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		if err != nil {
 			log.Warnf("failed to watch http endpoint updates: %s", err)
 		}
+
+		log.Debug("starting to watch declarative subscription updates")
+		go func() {
+			if wErr := a.processor.PubSub().WatchDeclarativeSubscriptions(ctx); wErr != nil {
+				log.Warnf("failed to watch declarative subscription updates: %s", wErr)
+			}
+		}()
+
+		log.Debug("starting to watch resiliency updates")
+		go func() {
+			if wErr := a.watchResiliency(ctx); wErr != nil {
+				log.Warnf("failed to watch resiliency updates: %s", wErr)
+			}
+		}()
 	}
 
 	a.appendBuiltinSecretStore()
@@ -812,6 +842,28 @@ func (a *DaprRuntime) beginHTTPEndpointsUpdates(ctx context.Context) error {
 	return nil
 }
 
+// watchResiliency watches the resiliency configurations, from the resources paths in standalone mode
+// or from the operator in Kubernetes mode, and reloads them when they change.
+// It blocks until the context is canceled.
+func (a *DaprRuntime) watchResiliency(ctx context.Context) error {
+	reloadable, ok := a.resiliency.(*resiliency.Reloadable)
+	if !ok {
+		return nil
+	}
+	onChange := func(configs []*resiliencyV1alpha.Resiliency) {
+		reloadable.Reload(configs...)
+	}
+
+	switch a.runtimeConfig.mode {
+	case modes.KubernetesMode:
+		return resiliency.WatchKubernetesResiliency(ctx, log, a.runtimeConfig.id, a.namespace, a.podName, a.operatorClient, onChange)
+	case modes.StandaloneMode:
+		return resiliency.WatchLocalResiliency(ctx, log, a.runtimeConfig.id, a.runtimeConfig.standalone.ResourcesPath, onChange)
+	default:
+		return nil
+	}
+}
+
 func (a *DaprRuntime) onHTTPEndpointUpdated(ctx context.Context, endpoint httpEndpointV1alpha1.HTTPEndpoint) bool {
 	oldEndpoint, exists := a.compStore.GetHTTPEndpoint(endpoint.Name)
 	_, _ = a.processResourceSecrets(ctx, &endpoint)
@@ -914,6 +966,9 @@ func (a *DaprRuntime) startHTTPServer(port int, publicPort *int, profilePort int
 		CompStore:                   a.compStore,
 		AppConnectionConfig:         a.runtimeConfig.appConnectionConfig,
 		GlobalConfig:                a.globalConfig,
+		Outbox:                      a.processor.Outbox(),
+		SubscriptionPauser:          a.processor.PubSub(),
+		InputBindingPauser:          a.processor.Binding(),
 	})
 
 	serverConf := http.ServerConfig{
@@ -1009,6 +1064,9 @@ func (a *DaprRuntime) getGRPCAPI() grpc.API {
 		CompStore:                   a.compStore,
 		AppConnectionConfig:         a.runtimeConfig.appConnectionConfig,
 		GlobalConfig:                a.globalConfig,
+		Outbox:                      a.processor.Outbox(),
+		SubscriptionPauser:          a.processor.PubSub(),
+		InputBindingPauser:          a.processor.Binding(),
 	})
 }
 
@@ -1092,6 +1150,7 @@ func (a *DaprRuntime) initActors() error {
 		HealthEndpoint:     a.channels.AppHTTPEndpoint(),
 		AppChannelAddress:  a.runtimeConfig.appConnectionConfig.ChannelAddress,
 		PodName:            getPodName(),
+		Topology:           getTopology(),
 	})
 
 	act := actors.NewActors(actors.ActorsOpts{
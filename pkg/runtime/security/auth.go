@@ -6,6 +6,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	daprCredentials "github.com/dapr/dapr/pkg/credentials"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+	"github.com/dapr/dapr/pkg/runtime/security/consts"
 )
 
 const (
@@ -26,12 +28,16 @@ const (
 	kubeTknPath       = "/var/run/secrets/dapr.io/sentrytoken/token"
 	legacyKubeTknPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	sentryMaxRetries  = 100
+
+	revocationListPollInterval = time.Second * 30
 )
 
 type Authenticator interface {
 	GetTrustAnchors() *x509.CertPool
 	GetCurrentSignedCert() *SignedCertificate
 	CreateSignedWorkloadCert(id, namespace, trustDomain string) (*SignedCertificate, error)
+	GetRevocationList() *daprCredentials.RevocationList
+	WatchRevocationList(ctx context.Context)
 }
 
 type authenticator struct {
@@ -42,6 +48,7 @@ type authenticator struct {
 	sentryAddress     string
 	currentSignedCert *SignedCertificate
 	certMutex         *sync.RWMutex
+	revocations       *daprCredentials.RevocationList
 }
 
 type SignedCertificate struct {
@@ -59,11 +66,15 @@ func newAuthenticator(sentryAddress string, trustAnchors *x509.CertPool, certCha
 		genCSRFunc:    genCSRFunc,
 		sentryAddress: sentryAddress,
 		certMutex:     &sync.RWMutex{},
+		revocations:   daprCredentials.NewRevocationList(),
 	}
 }
 
-// GetTrustAnchors returns the extracted root cert that serves as the trust anchor.
+// GetTrustAnchors returns the root certs that serve as trust anchors.
+// They are updated with the trust anchors returned by Sentry, to follow root certificate rotations.
 func (a *authenticator) GetTrustAnchors() *x509.CertPool {
+	a.certMutex.RLock()
+	defer a.certMutex.RUnlock()
 	return a.trustAnchors
 }
 
@@ -83,27 +94,10 @@ func (a *authenticator) CreateSignedWorkloadCert(id, namespace, trustDomain stri
 	}
 	certPem := pem.EncodeToMemory(&pem.Block{Type: certType, Bytes: csrb})
 
-	config, err := daprCredentials.TLSConfigFromCertAndKey(a.certChainPem, a.keyPem, TLSServerName, a.trustAnchors)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create tls config from cert and key: %w", err)
-	}
-
-	unaryClientInterceptor := grpcRetry.UnaryClientInterceptor()
-
-	if diag.DefaultGRPCMonitoring.IsEnabled() {
-		unaryClientInterceptor = grpcMiddleware.ChainUnaryClient(
-			unaryClientInterceptor,
-			diag.DefaultGRPCMonitoring.UnaryClientInterceptor(),
-		)
-	}
-
-	conn, err := grpc.Dial(
-		a.sentryAddress,
-		grpc.WithTransportCredentials(credentials.NewTLS(config)),
-		grpc.WithUnaryInterceptor(unaryClientInterceptor))
+	conn, err := a.dialSentry()
 	if err != nil {
 		diag.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sentry_conn")
-		return nil, fmt.Errorf("error establishing connection to sentry: %w", err)
+		return nil, err
 	}
 	defer conn.Close()
 
@@ -146,15 +140,122 @@ func (a *authenticator) CreateSignedWorkloadCert(id, namespace, trustDomain stri
 		TrustChain:    trustChain,
 	}
 
+	var trustAnchors *x509.CertPool
+	if anchors := resp.GetTrustAnchors(); len(anchors) > 0 {
+		trustAnchors, err = CertPool(anchors)
+		if err != nil {
+			log.Warnf("Failed to parse trust anchors from sentry, keeping the current ones: %v", err)
+		}
+	}
+
 	a.certMutex.Lock()
 	defer a.certMutex.Unlock()
 
 	a.currentSignedCert = signedCert
+	if trustAnchors != nil {
+		a.trustAnchors = trustAnchors
+	}
 	return signedCert, nil
 }
 
-// currently we support Kubernetes identities.
+// GetRevocationList returns the list of identities and certificates revoked by Sentry.
+func (a *authenticator) GetRevocationList() *daprCredentials.RevocationList {
+	return a.revocations
+}
+
+// WatchRevocationList polls Sentry for the revocation list until the context is canceled.
+// The connection to Sentry is reused across polls, as gRPC reconnects when needed.
+func (a *authenticator) WatchRevocationList(ctx context.Context) {
+	t := time.NewTicker(revocationListPollInterval)
+	defer t.Stop()
+
+	var conn *grpc.ClientConn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+
+	for {
+		var err error
+		if conn == nil {
+			conn, err = a.dialSentry()
+		}
+		if err == nil {
+			err = a.updateRevocationList(ctx, sentryv1pb.NewCAClient(conn))
+		}
+		if err != nil {
+			log.Warnf("Failed to update revocation list from sentry: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (a *authenticator) updateRevocationList(ctx context.Context, client sentryv1pb.CAClient) error {
+	ctx, cancel := context.WithTimeout(ctx, sentrySignTimeout)
+	defer cancel()
+	resp, err := client.GetRevocationList(ctx, &sentryv1pb.GetRevocationListRequest{})
+	if err != nil {
+		return err
+	}
+
+	a.revocations.Update(resp.GetSpiffeIds(), resp.GetSerialNumbers())
+	return nil
+}
+
+func (a *authenticator) dialSentry() (*grpc.ClientConn, error) {
+	// Once a workload cert has been signed, use it rather than the initial cert
+	// chain, which may not be trusted anymore after a root certificate rotation.
+	a.certMutex.RLock()
+	certPem, keyPem, trustAnchors := a.certChainPem, a.keyPem, a.trustAnchors
+	if a.currentSignedCert != nil {
+		certPem, keyPem = a.currentSignedCert.WorkloadCert, a.currentSignedCert.PrivateKeyPem
+	}
+	a.certMutex.RUnlock()
+
+	config, err := daprCredentials.TLSConfigFromCertAndKey(certPem, keyPem, TLSServerName, trustAnchors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tls config from cert and key: %w", err)
+	}
+
+	unaryClientInterceptor := grpcRetry.UnaryClientInterceptor()
+
+	if diag.DefaultGRPCMonitoring.IsEnabled() {
+		unaryClientInterceptor = grpcMiddleware.ChainUnaryClient(
+			unaryClientInterceptor,
+			diag.DefaultGRPCMonitoring.UnaryClientInterceptor(),
+		)
+	}
+
+	conn, err := grpc.Dial(
+		a.sentryAddress,
+		grpc.WithTransportCredentials(credentials.NewTLS(config)),
+		grpc.WithUnaryInterceptor(unaryClientInterceptor))
+	if err != nil {
+		return nil, fmt.Errorf("error establishing connection to sentry: %w", err)
+	}
+	return conn, nil
+}
+
+// getToken returns the token used by Sentry to validate the identity of daprd.
+// In self-hosted mode this is a join token, otherwise the Kubernetes service account token.
 func getToken() string {
+	if tkn := os.Getenv(consts.SentryTokenEnvVar); tkn != "" {
+		return tkn
+	}
+	if path := os.Getenv(consts.SentryTokenFileEnvVar); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			log.Warnf("Failed to read sentry token file %s: %v", path, err)
+		}
+		return strings.TrimSpace(string(b))
+	}
+
 	b, err := os.ReadFile(kubeTknPath)
 	if err != nil && os.IsNotExist(err) {
 		// Attempt to use the legacy token if that exists
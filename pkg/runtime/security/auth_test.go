@@ -1,10 +1,18 @@
 package security
 
 import (
+	"context"
 	"crypto/x509"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+	"github.com/dapr/dapr/pkg/runtime/security/consts"
 )
 
 func mockGenCSR(id string) ([]byte, []byte, error) {
@@ -28,6 +36,32 @@ func TestGetCurrentSignedCert(t *testing.T) {
 	assert.NotNil(t, c)
 }
 
+type fakeCAClient struct {
+	sentryv1pb.CAClient
+	calls int
+}
+
+func (c *fakeCAClient) GetRevocationList(context.Context, *sentryv1pb.GetRevocationListRequest, ...grpc.CallOption) (*sentryv1pb.GetRevocationListResponse, error) {
+	c.calls++
+	return &sentryv1pb.GetRevocationListResponse{
+		SpiffeIds:     []string{"spiffe://public/ns/default/app1"},
+		SerialNumbers: []string{"0A:0B"},
+	}, nil
+}
+
+func TestUpdateRevocationList(t *testing.T) {
+	a := getTestAuthenticator().(*authenticator)
+	client := &fakeCAClient{}
+
+	require.NoError(t, a.updateRevocationList(context.Background(), client))
+	require.NoError(t, a.updateRevocationList(context.Background(), client))
+	assert.Equal(t, 2, client.calls)
+	assert.True(t, a.GetRevocationList().IsIDRevoked("spiffe://public/ns/default/app1"))
+	ids, serials := a.GetRevocationList().Entries()
+	assert.Len(t, ids, 1)
+	assert.Equal(t, []string{"a0b"}, serials)
+}
+
 func TestGetSentryIdentifier(t *testing.T) {
 	t.Run("with identity in env", func(t *testing.T) {
 		envID := "cluster.local"
@@ -42,3 +76,17 @@ func TestGetSentryIdentifier(t *testing.T) {
 		assert.Equal(t, "app1", id)
 	})
 }
+
+func TestGetToken(t *testing.T) {
+	t.Run("join token from env", func(t *testing.T) {
+		t.Setenv(consts.SentryTokenEnvVar, "token1")
+		assert.Equal(t, "token1", getToken())
+	})
+
+	t.Run("join token from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(path, []byte("token2\n"), 0o600))
+		t.Setenv(consts.SentryTokenFileEnvVar, path)
+		assert.Equal(t, "token2", getToken())
+	})
+}
@@ -22,4 +22,8 @@ const (
 	APITokenHeader = "dapr-api-token"
 	// Name of the variable injected in the daprd container with the list of injected env vars.
 	EnvKeysEnvVar = "DAPR_ENV_KEYS"
+	// Env var for the join token sent to Sentry in self-hosted mode.
+	SentryTokenEnvVar = "DAPR_SENTRY_TOKEN"
+	// Env var for the path to a file holding the join token sent to Sentry in self-hosted mode.
+	SentryTokenFileEnvVar = "DAPR_SENTRY_TOKEN_FILE"
 )
@@ -36,16 +36,18 @@ var log = logger.NewLogger("dapr.sentry.ca")
 type CertificateAuthority interface {
 	LoadOrStoreTrustBundle(ctx context.Context) error
 	GetCACertBundle() TrustRootBundler
-	SignCSR(csrPem []byte, subject string, identity *identity.Bundle, ttl time.Duration, isCA bool) (*SignedCertificate, error)
+	SignCSR(ctx context.Context, csrPem []byte, subject string, identity *identity.Bundle, ttl time.Duration, isCA bool) (*SignedCertificate, error)
 	ValidateCSR(csr *x509.CertificateRequest) error
 }
 
-func NewCertificateAuthority(config config.SentryConfig) (CertificateAuthority, error) {
+func NewCertificateAuthority(conf config.SentryConfig) (CertificateAuthority, error) {
 	// Load future external CAs from components-contrib.
-	switch config.CAStore {
+	switch {
+	case conf.CAStore == config.ExternalCAStore, conf.ExternalSignerURL != "":
+		return newExternalCA(conf)
 	default:
 		return &defaultCA{
-			config: config,
+			config: conf,
 		}, nil
 	}
 }
@@ -59,6 +61,9 @@ type defaultCA struct {
 type SignedCertificate struct {
 	Certificate *x509.Certificate
 	CertPEM     []byte
+	// TrustChainPEM is the PEM-encoded chain of the issuers of Certificate, excluding the root.
+	// If empty, the issuer cert of the trust bundle is used.
+	TrustChainPEM []byte
 }
 
 // LoadOrStoreTrustBundle loads the root cert and issuer cert from the configured secret store.
@@ -82,7 +87,7 @@ func (c *defaultCA) GetCACertBundle() TrustRootBundler {
 // SignCSR signs a request with a PEM encoded CSR cert and duration.
 // If isCA is set to true, a CA cert will be issued. If isCA is set to false, a workload
 // Certificate will be issued instead.
-func (c *defaultCA) SignCSR(csrPem []byte, subject string, identity *identity.Bundle, ttl time.Duration, isCA bool) (*SignedCertificate, error) {
+func (c *defaultCA) SignCSR(_ context.Context, csrPem []byte, subject string, identity *identity.Bundle, ttl time.Duration, isCA bool) (*SignedCertificate, error) {
 	c.issuerLock.RLock()
 	defer c.issuerLock.RUnlock()
 
@@ -205,10 +210,16 @@ func (c *defaultCA) validateAndBuildTrustBundle(ctx context.Context) (*trustRoot
 	}
 
 	// load trust anchors
+	// During a root rotation, the trust anchors contain both the old and the new root:
+	// the issuer can be signed by either of them.
 	trustAnchors, err := certs.CertPoolFromPEM(rootCertBytes)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing cert pool for trust anchors: %w", err)
 	}
+	// Only warn, so existing deployments with an unusual chain keep working.
+	if err = verifyIssuerChain(issuerCertBytes, trustAnchors); err != nil {
+		log.Warnf("Workloads may fail to verify the certificates signed by sentry: %v", err)
+	}
 
 	return &trustRootBundle{
 		issuerCreds:   issuerCreds,
@@ -219,6 +230,34 @@ func (c *defaultCA) validateAndBuildTrustBundle(ctx context.Context) (*trustRoot
 	}, nil
 }
 
+// verifyIssuerChain verifies that the issuer cert chains up to one of the trust anchors.
+// Any certificate following the issuer cert is used as intermediate.
+// Expiry is not enforced here, it is only reported in logs and metrics.
+func verifyIssuerChain(issuerCertPem []byte, trustAnchors *x509.CertPool) error {
+	issuerCerts, err := certs.DecodePEMCertificates(issuerCertPem)
+	if err != nil {
+		return fmt.Errorf("error decoding issuer cert: %w", err)
+	}
+	if len(issuerCerts) == 0 {
+		return errors.New("no certificate found in issuer cert")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, crt := range issuerCerts[1:] {
+		intermediates.AddCert(crt)
+	}
+	_, err = issuerCerts[0].Verify(x509.VerifyOptions{
+		Roots:         trustAnchors,
+		Intermediates: intermediates,
+		CurrentTime:   issuerCerts[0].NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("issuer cert is not signed by any of the trust anchors: %w", err)
+	}
+	return nil
+}
+
 func (c *defaultCA) generateRootAndIssuerCerts(ctx context.Context) (*certs.Credentials, []byte, []byte, error) {
 	rootKey, err := certs.GenerateECPrivateKey()
 	if err != nil {
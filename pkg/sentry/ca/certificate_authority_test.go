@@ -125,7 +125,7 @@ func TestSignCSR(t *testing.T) {
 		err := certAuth.LoadOrStoreTrustBundle(context.Background())
 		require.NoError(t, err)
 
-		resp, err := certAuth.SignCSR(certPem, "test-subject", nil, time.Hour*24, false)
+		resp, err := certAuth.SignCSR(context.Background(), certPem, "test-subject", nil, time.Hour*24, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, time.Now().UTC().Add(time.Hour*24+allowedClockSkew).Day(), resp.Certificate.NotAfter.UTC().Day())
@@ -144,7 +144,7 @@ func TestSignCSR(t *testing.T) {
 		err := certAuth.LoadOrStoreTrustBundle(context.Background())
 		require.NoError(t, err)
 
-		resp, err := certAuth.SignCSR(certPem, "test-subject", nil, time.Hour*-1, false)
+		resp, err := certAuth.SignCSR(context.Background(), certPem, "test-subject", nil, time.Hour*-1, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, time.Now().UTC().Add(workloadCertTTL+allowedClockSkew).Day(), resp.Certificate.NotAfter.UTC().Day())
@@ -160,7 +160,7 @@ func TestSignCSR(t *testing.T) {
 		err := certAuth.LoadOrStoreTrustBundle(context.Background())
 		require.NoError(t, err)
 
-		_, err = certAuth.SignCSR(certPem, "", nil, time.Hour*24, false)
+		_, err = certAuth.SignCSR(context.Background(), certPem, "", nil, time.Hour*24, false)
 		assert.Error(t, err)
 	})
 
@@ -178,7 +178,7 @@ func TestSignCSR(t *testing.T) {
 		require.NoError(t, err)
 
 		bundle := identity.NewBundle("app", "default", "public")
-		resp, err := certAuth.SignCSR(certPem, "test-subject", bundle, time.Hour*24, false)
+		resp, err := certAuth.SignCSR(context.Background(), certPem, "test-subject", bundle, time.Hour*24, false)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 
@@ -281,3 +281,27 @@ func TestDetectCertificates(t *testing.T) {
 		assert.True(t, time.Since(start).Seconds() >= 2)
 	})
 }
+
+func TestVerifyIssuerChain(t *testing.T) {
+	oldRootKey, err := certs.GenerateECPrivateKey()
+	require.NoError(t, err)
+	_, oldRootPem, _, _, err := GetNewSelfSignedCertificates(oldRootKey, time.Hour, 0)
+	require.NoError(t, err)
+
+	newRootKey, err := certs.GenerateECPrivateKey()
+	require.NoError(t, err)
+	_, newRootPem, newIssuerPem, _, err := GetNewSelfSignedCertificates(newRootKey, time.Hour, 0)
+	require.NoError(t, err)
+
+	t.Run("issuer signed by one of multiple trust anchors", func(t *testing.T) {
+		trustAnchors, err := certs.CertPoolFromPEM(append(append([]byte{}, oldRootPem...), newRootPem...))
+		require.NoError(t, err)
+		assert.NoError(t, verifyIssuerChain(newIssuerPem, trustAnchors))
+	})
+
+	t.Run("issuer not signed by trust anchors", func(t *testing.T) {
+		trustAnchors, err := certs.CertPoolFromPEM(oldRootPem)
+		require.NoError(t, err)
+		assert.Error(t, verifyIssuerChain(newIssuerPem, trustAnchors))
+	})
+}
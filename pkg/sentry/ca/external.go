@@ -0,0 +1,328 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/sentry/certs"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/identity"
+)
+
+const (
+	externalSignerTimeout = time.Second * 10
+	// maxExternalSignerResponseSize is the maximum size of a response from the external signer.
+	maxExternalSignerResponseSize = 1 << 20
+)
+
+// ExternalSignRequest is the request sent to the external signer to sign a CSR.
+type ExternalSignRequest struct {
+	// CSR is the PEM-encoded certificate signing request.
+	CSR string `json:"csr"`
+	// CommonName is the subject common name requested by Sentry.
+	CommonName string `json:"commonName,omitempty"`
+	// DNSNames are the DNS SANs to add to the certificate.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// SPIFFEID is the SPIFFE ID to add to the certificate as URI SAN.
+	SPIFFEID string `json:"spiffeId,omitempty"`
+	// TTLSeconds is the requested lifetime of the certificate.
+	TTLSeconds int64 `json:"ttlSeconds"`
+	// IsCA is set when a CA certificate is requested.
+	IsCA bool `json:"isCA,omitempty"`
+}
+
+// ExternalSignResponse is the response of the external signer.
+type ExternalSignResponse struct {
+	// Certificate is the PEM-encoded signed certificate.
+	Certificate string `json:"certificate"`
+	// Chain is the PEM-encoded chain of the upstream issuers, from the issuer
+	// of Certificate up to, but not including, the root.
+	Chain []string `json:"chain,omitempty"`
+}
+
+// externalCA is a CertificateAuthority which delegates signing to an external signer,
+// so that no CA private key is held by Sentry.
+// The external signer is called over HTTP with a JSON ExternalSignRequest and responds
+// with an ExternalSignResponse.
+type externalCA struct {
+	bundle *trustRootBundle
+	config config.SentryConfig
+	client *http.Client
+	token  string
+}
+
+func newExternalCA(conf config.SentryConfig) (*externalCA, error) {
+	if conf.ExternalSignerURL == "" {
+		return nil, errors.New("external signer URL is required for the external CA store")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if conf.ExternalSignerCAPath != "" {
+		caPem, err := os.ReadFile(conf.ExternalSignerCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading external signer CA: %w", err)
+		}
+		pool, err := certs.CertPoolFromPEM(caPem)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing external signer CA: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	var token string
+	if conf.ExternalSignerTokenPath != "" {
+		b, err := os.ReadFile(conf.ExternalSignerTokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading external signer token: %w", err)
+		}
+		token = strings.TrimSpace(string(b))
+	}
+
+	return &externalCA{
+		config: conf,
+		client: &http.Client{
+			Timeout:   externalSignerTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		token: token,
+	}, nil
+}
+
+// LoadOrStoreTrustBundle loads the trust anchors and, if present, the upstream issuer chain.
+// Only public certificates are read: the issuer private key is held by the external signer.
+func (c *externalCA) LoadOrStoreTrustBundle(ctx context.Context) error {
+	if err := detectCertificates(c.config.RootCertPath); err != nil {
+		return err
+	}
+
+	rootCertPem, err := os.ReadFile(c.config.RootCertPath)
+	if err != nil {
+		return fmt.Errorf("error reading root cert: %w", err)
+	}
+	trustAnchors, err := certs.CertPoolFromPEM(rootCertPem)
+	if err != nil {
+		return fmt.Errorf("error parsing cert pool for trust anchors: %w", err)
+	}
+
+	// The issuer cert is used to know the expiry of the upstream issuer;
+	// fall back to the root cert if it is not provided.
+	issuerCertPem, err := os.ReadFile(c.config.IssuerCertPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error reading issuer cert: %w", err)
+		}
+		log.Info("Issuer cert not found: using the root cert expiry for the external signer")
+		issuerCertPem = nil
+	}
+	expiryPem := issuerCertPem
+	if len(expiryPem) == 0 {
+		expiryPem = rootCertPem
+	}
+	issuerCerts, err := certs.DecodePEMCertificates(expiryPem)
+	if err != nil {
+		return fmt.Errorf("error decoding issuer cert: %w", err)
+	}
+	if len(issuerCerts) == 0 {
+		return errors.New("no certificate found in issuer cert")
+	}
+
+	c.bundle = &trustRootBundle{
+		issuerCreds:   &certs.Credentials{Certificate: issuerCerts[0]},
+		trustAnchors:  trustAnchors,
+		trustDomain:   c.config.TrustDomain,
+		rootCertPem:   rootCertPem,
+		issuerCertPem: issuerCertPem,
+	}
+	return nil
+}
+
+// GetCACertBundle returns the Trust Root Bundle.
+func (c *externalCA) GetCACertBundle() TrustRootBundler {
+	return c.bundle
+}
+
+// SignCSR sends the CSR to the external signer and returns the signed certificate
+// together with the upstream issuer chain. The certificate returned by the signer
+// is verified against the request before it is handed out.
+func (c *externalCA) SignCSR(ctx context.Context, csrPem []byte, subject string, identityBundle *identity.Bundle, ttl time.Duration, isCA bool) (*SignedCertificate, error) {
+	if c.bundle == nil {
+		return nil, errors.New("trust bundle is not loaded")
+	}
+
+	csrReq, err := certs.ParsePemCSR(csrPem)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing csr pem: %w", err)
+	}
+
+	certLifetime := ttl
+	if certLifetime.Seconds() <= 0 {
+		certLifetime = c.config.WorkloadCertTTL
+	}
+	certLifetime += c.config.AllowedClockSkew
+
+	req := ExternalSignRequest{
+		CSR:        string(csrPem),
+		TTLSeconds: int64(certLifetime.Seconds()),
+		IsCA:       isCA,
+	}
+	if subject == caCommonName {
+		req.CommonName = subject
+		req.DNSNames = []string{subject}
+	}
+	if identityBundle != nil {
+		spiffeID, err := identity.CreateSPIFFEID(identityBundle.TrustDomain, identityBundle.Namespace, identityBundle.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error generating spiffe id: %w", err)
+		}
+		req.SPIFFEID = spiffeID
+	}
+
+	res, err := c.sign(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error signing csr with external signer: %w", err)
+	}
+
+	signedCerts, err := certs.DecodePEMCertificates([]byte(strings.TrimSpace(res.Certificate)))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cert: %w", err)
+	}
+	if len(signedCerts) == 0 {
+		return nil, errors.New("no certificate found in external signer response")
+	}
+
+	var (
+		chainPem   []byte
+		chainCerts []*x509.Certificate
+	)
+	if len(res.Chain) > 0 {
+		chain := make([]string, len(res.Chain))
+		for i, crt := range res.Chain {
+			chain[i] = strings.TrimSpace(crt)
+		}
+		chainCerts, err = certs.DecodePEMCertificates([]byte(strings.Join(chain, "\n")))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing upstream chain: %w", err)
+		}
+		for _, crt := range chainCerts {
+			chainPem = append(chainPem, pem.EncodeToMemory(&pem.Block{Type: certs.BlockTypeCertificate, Bytes: crt.Raw})...)
+		}
+	}
+
+	if err = c.verifySigned(signedCerts[0], chainCerts, csrReq, req.SPIFFEID, isCA); err != nil {
+		return nil, fmt.Errorf("invalid certificate from external signer: %w", err)
+	}
+
+	return &SignedCertificate{
+		Certificate: signedCerts[0],
+		CertPEM: pem.EncodeToMemory(&pem.Block{
+			Type:  certs.BlockTypeCertificate,
+			Bytes: signedCerts[0].Raw,
+		}),
+		TrustChainPEM: chainPem,
+	}, nil
+}
+
+// verifySigned verifies that the certificate returned by the external signer chains
+// up to the trust anchors and matches the request it was signed for.
+func (c *externalCA) verifySigned(crt *x509.Certificate, chain []*x509.Certificate, csrReq *x509.CertificateRequest, spiffeID string, isCA bool) error {
+	intermediates := x509.NewCertPool()
+	for _, ic := range chain {
+		intermediates.AddCert(ic)
+	}
+	_, err := crt.Verify(x509.VerifyOptions{
+		Roots:         c.bundle.trustAnchors,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("certificate does not chain to the trust anchors: %w", err)
+	}
+
+	pub, ok := crt.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(csrReq.PublicKey) {
+		return errors.New("certificate public key does not match the csr")
+	}
+
+	if spiffeID != "" && (len(crt.URIs) != 1 || crt.URIs[0].String() != spiffeID) {
+		return fmt.Errorf("certificate URI SAN does not match %s", spiffeID)
+	}
+
+	if crt.IsCA && !isCA {
+		return errors.New("certificate is a CA but a workload certificate was requested")
+	}
+
+	return nil
+}
+
+func (c *externalCA) sign(ctx context.Context, signReq ExternalSignRequest) (*ExternalSignResponse, error) {
+	body, err := json.Marshal(signReq)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, externalSignerTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.ExternalSignerURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxExternalSignerResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("external signer responded with status %d: %s", resp.StatusCode, string(b))
+	}
+
+	var res ExternalSignResponse
+	if err = json.Unmarshal(b, &res); err != nil {
+		return nil, fmt.Errorf("error decoding external signer response: %w", err)
+	}
+	if res.Certificate == "" {
+		return nil, errors.New("external signer response has no certificate")
+	}
+	return &res, nil
+}
+
+func (c *externalCA) ValidateCSR(csr *x509.CertificateRequest) error {
+	if csr.Subject.CommonName == "" {
+		return errors.New("cannot validate request: missing common name")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/sentry/certs"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/csr"
+	"github.com/dapr/dapr/pkg/sentry/identity"
+)
+
+func TestExternalCA(t *testing.T) {
+	rootKey, err := certs.GenerateECPrivateKey()
+	require.NoError(t, err)
+	issuerCreds, rootCertPem, issuerCertPem, _, err := GetNewSelfSignedCertificates(rootKey, time.Hour, 0)
+	require.NoError(t, err)
+
+	otherKey, err := certs.GenerateECPrivateKey()
+	require.NoError(t, err)
+	otherCreds, _, _, _, err := GetNewSelfSignedCertificates(otherKey, time.Hour, 0)
+	require.NoError(t, err)
+
+	// tamper changes the certificate returned by the signer.
+	var tamper string
+	var signReq ExternalSignRequest
+	signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&signReq)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		req, err := certs.ParsePemCSR([]byte(signReq.CSR))
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var bundle *identity.Bundle
+		if signReq.SPIFFEID != "" && tamper != "spiffe" {
+			parts := strings.Split(strings.TrimPrefix(signReq.SPIFFEID, "spiffe://"), "/")
+			bundle = identity.NewBundle(parts[3], parts[2], parts[0])
+		}
+		pub, signingCreds, isCA := req.PublicKey, issuerCreds, signReq.IsCA
+		switch tamper {
+		case "key":
+			pub = otherKey.Public()
+		case "chain":
+			signingCreds = otherCreds
+		case "ca":
+			isCA = true
+		}
+		crtb, err := csr.GenerateCSRCertificate(req, signReq.CommonName, bundle, signingCreds.Certificate, pub,
+			signingCreds.PrivateKey, time.Duration(signReq.TTLSeconds)*time.Second, 0, isCA)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		json.NewEncoder(w).Encode(ExternalSignResponse{
+			Certificate: string(pem.EncodeToMemory(&pem.Block{Type: certs.BlockTypeCertificate, Bytes: crtb})),
+			Chain:       []string{string(issuerCertPem)},
+		})
+	}))
+	defer signer.Close()
+
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("secret\n"), 0o600))
+	rootCertPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(rootCertPath, rootCertPem, 0o600))
+
+	conf := config.SentryConfig{
+		TrustDomain:             "cluster.local",
+		WorkloadCertTTL:         time.Hour,
+		RootCertPath:            rootCertPath,
+		IssuerCertPath:          filepath.Join(dir, "issuer.crt"),
+		ExternalSignerURL:       signer.URL,
+		ExternalSignerTokenPath: tokenPath,
+	}
+
+	certAuth, err := NewCertificateAuthority(conf)
+	require.NoError(t, err)
+	require.IsType(t, &externalCA{}, certAuth)
+
+	t.Run("load trust bundle without issuer key", func(t *testing.T) {
+		require.NoError(t, certAuth.LoadOrStoreTrustBundle(context.Background()))
+
+		bundle := certAuth.GetCACertBundle()
+		assert.Equal(t, rootCertPem, bundle.GetRootCertPem())
+		assert.Empty(t, bundle.GetIssuerCertPem())
+		require.NotNil(t, bundle.GetIssuerCertExpiry())
+	})
+
+	t.Run("sign csr with external signer", func(t *testing.T) {
+		csrPem, _, err := csr.GenerateCSR("", false)
+		require.NoError(t, err)
+
+		signed, err := certAuth.SignCSR(context.Background(), csrPem, "app1", identity.NewBundle("app1", "default", "public"), -1, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, "spiffe://public/ns/default/app1", signReq.SPIFFEID)
+		assert.Equal(t, int64(time.Hour.Seconds()), signReq.TTLSeconds)
+		assert.Equal(t, issuerCertPem, signed.TrustChainPEM)
+		assert.Equal(t, issuerCreds.Certificate.Subject, signed.Certificate.Issuer)
+		assert.NoError(t, signed.Certificate.CheckSignatureFrom(issuerCreds.Certificate))
+		require.Len(t, signed.Certificate.URIs, 1)
+		assert.Equal(t, "spiffe://public/ns/default/app1", signed.Certificate.URIs[0].String())
+	})
+
+	t.Run("reject invalid certificates from signer", func(t *testing.T) {
+		defer func() {
+			tamper = ""
+		}()
+
+		for _, tc := range []string{"key", "spiffe", "chain", "ca"} {
+			t.Run(tc, func(t *testing.T) {
+				tamper = tc
+				csrPem, _, err := csr.GenerateCSR("", false)
+				require.NoError(t, err)
+
+				_, err = certAuth.SignCSR(context.Background(), csrPem, "app1", identity.NewBundle("app1", "default", "public"), -1, false)
+				assert.ErrorContains(t, err, "invalid certificate from external signer")
+			})
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		csrPem, _, err := csr.GenerateCSR("", false)
+		require.NoError(t, err)
+		_, err = certAuth.SignCSR(ctx, csrPem, "app1", identity.NewBundle("app1", "default", "public"), -1, false)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("signer error", func(t *testing.T) {
+		c, err := newExternalCA(config.SentryConfig{ExternalSignerURL: signer.URL})
+		require.NoError(t, err)
+		c.bundle = certAuth.(*externalCA).bundle
+
+		csrPem, _, err := csr.GenerateCSR("", false)
+		require.NoError(t, err)
+		_, err = c.SignCSR(context.Background(), csrPem, "app1", nil, -1, false)
+		assert.ErrorContains(t, err, "status 401")
+	})
+}
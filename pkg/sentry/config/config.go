@@ -27,6 +27,18 @@ const (
 	defaultDaprSystemConfigName = "daprsystem"
 
 	DefaultPort = 50001
+
+	// ExternalCAStore is the CA store which delegates signing to an external signer.
+	ExternalCAStore = "external"
+
+	// JoinTokensEnvVar is the env var holding the pre-shared join tokens for self-hosted mode.
+	// It is used when JoinTokensFile is not set.
+	//nolint:gosec
+	JoinTokensEnvVar = "DAPR_SENTRY_JOIN_TOKENS"
+	// JoinTokenKeyEnvVar is the env var holding the HMAC key to validate JWT join tokens in self-hosted mode.
+	// It is used when JoinTokenKeyFile is not set.
+	//nolint:gosec
+	JoinTokenKeyEnvVar = "DAPR_SENTRY_JOIN_TOKEN_KEY"
 )
 
 var log = logger.NewLogger("dapr.sentry.config")
@@ -43,6 +55,22 @@ type SentryConfig struct {
 	IssuerKeyPath    string
 	Features         []daprGlobalConfig.FeatureSpec
 	TokenAudience    *string
+	// JoinTokensFile is the path to the file with the pre-shared join tokens for self-hosted mode.
+	JoinTokensFile string
+	// JoinTokenKeyFile is the path to the HMAC key to validate JWT join tokens in self-hosted mode.
+	JoinTokenKeyFile string
+	// JoinTokenAudience is the expected audience of JWT join tokens in self-hosted mode, if set.
+	JoinTokenAudience string
+	// JoinTokenMaxLifetime is the maximum lifetime of JWT join tokens in self-hosted mode, if set.
+	JoinTokenMaxLifetime time.Duration
+	// ExternalSignerURL is the URL of the external signer used by the external CA store.
+	ExternalSignerURL string
+	// ExternalSignerCAPath is the path to the CA bundle used to verify the TLS certificate of the external signer.
+	ExternalSignerCAPath string
+	// ExternalSignerTokenPath is the path to the bearer token sent to the external signer.
+	ExternalSignerTokenPath string
+	// DenyListPath is the path to the file with the denied SPIFFE IDs and revoked certificate serial numbers.
+	DenyListPath string
 }
 
 func (c SentryConfig) GetTokenAudiences() (audiences []string) {
@@ -52,11 +80,40 @@ func (c SentryConfig) GetTokenAudiences() (audiences []string) {
 	return
 }
 
+// GetJoinTokens returns the pre-shared join tokens for self-hosted mode, read
+// from JoinTokensFile or the JoinTokensEnvVar env var. It returns nil if none are configured.
+func (c SentryConfig) GetJoinTokens() ([]byte, error) {
+	return readFileOrEnv(c.JoinTokensFile, JoinTokensEnvVar)
+}
+
+// GetJoinTokenKey returns the HMAC key to validate JWT join tokens in self-hosted mode, read
+// from JoinTokenKeyFile or the JoinTokenKeyEnvVar env var. It returns nil if none is configured.
+func (c SentryConfig) GetJoinTokenKey() ([]byte, error) {
+	return readFileOrEnv(c.JoinTokenKeyFile, JoinTokenKeyEnvVar)
+}
+
+func readFileOrEnv(path, envVar string) ([]byte, error) {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		return b, nil
+	}
+
+	if v := os.Getenv(envVar); v != "" {
+		return []byte(v), nil
+	}
+	return nil, nil
+}
+
 // String implements fmt.Stringer.
 func (c SentryConfig) String() string {
 	caStore := "default"
 	if c.CAStore != "" {
 		caStore = c.CAStore
+	} else if c.ExternalSignerURL != "" {
+		caStore = ExternalCAStore
 	}
 
 	return fmt.Sprintf("Configuration: port:'%v' ca store:'%s', allowed clock skew:'%s', workload cert ttl:'%s'",
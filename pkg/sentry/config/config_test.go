@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,3 +43,45 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, "1h0m0s", conf.AllowedClockSkew.String())
 	})
 }
+
+func TestJoinTokenSources(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		c := getDefaultConfig()
+		b, err := c.GetJoinTokens()
+		assert.NoError(t, err)
+		assert.Nil(t, b)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv(JoinTokensEnvVar, "tokens")
+		t.Setenv(JoinTokenKeyEnvVar, "key")
+		c := getDefaultConfig()
+
+		b, err := c.GetJoinTokens()
+		assert.NoError(t, err)
+		assert.Equal(t, "tokens", string(b))
+		b, err = c.GetJoinTokenKey()
+		assert.NoError(t, err)
+		assert.Equal(t, "key", string(b))
+	})
+
+	t.Run("file takes precedence over env", func(t *testing.T) {
+		t.Setenv(JoinTokensEnvVar, "tokens")
+		path := filepath.Join(t.TempDir(), "tokens")
+		assert.NoError(t, os.WriteFile(path, []byte("from-file"), 0o600))
+		c := getDefaultConfig()
+		c.JoinTokensFile = path
+
+		b, err := c.GetJoinTokens()
+		assert.NoError(t, err)
+		assert.Equal(t, "from-file", string(b))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		c := getDefaultConfig()
+		c.JoinTokenKeyFile = filepath.Join(t.TempDir(), "missing")
+
+		_, err := c.GetJoinTokenKey()
+		assert.Error(t, err)
+	})
+}
@@ -1,14 +1,178 @@
 package selfhosted
 
-import "github.com/dapr/dapr/pkg/sentry/identity"
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
 
-func NewValidator() identity.Validator {
-	return &validator{}
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/dapr/pkg/sentry/identity"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	errPrefix = "csr validation failed"
+
+	// NamespaceClaim is the name of the JWT claim holding the namespace the token is bound to.
+	// The app ID is held in the subject claim.
+	NamespaceClaim = "namespace"
+
+	// defaultNamespace is the namespace used by daprd in self-hosted mode when none is set.
+	defaultNamespace = "default"
+)
+
+var log = logger.NewLogger("dapr.sentry.identity.selfhosted")
+
+// JoinToken is a pre-shared token bound to an app ID and namespace.
+type JoinToken struct {
+	Token     string `json:"token"`
+	AppID     string `json:"appId"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Options contains the options for the self-hosted validator.
+type Options struct {
+	// JoinTokens is the list of pre-shared join tokens.
+	JoinTokens []JoinToken
+	// SigningKey is the HMAC key used to validate JWT join tokens.
+	SigningKey []byte
+	// Audience is the expected audience of JWT join tokens, if set.
+	Audience string
+	// MaxTokenLifetime is the maximum lifetime of JWT join tokens, if set.
+	MaxTokenLifetime time.Duration
+	// AllowedClockSkew is the allowed clock skew when validating JWT join tokens.
+	AllowedClockSkew time.Duration
+}
+
+// ParseJoinTokens parses a YAML or JSON list of pre-shared join tokens.
+func ParseJoinTokens(data []byte) ([]JoinToken, error) {
+	var tokens []JoinToken
+	if err := yaml.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse join tokens: %w", err)
+	}
+
+	for i, t := range tokens {
+		if t.Token == "" || t.AppID == "" {
+			return nil, fmt.Errorf("join token at index %d must have a token and an app ID", i)
+		}
+	}
+	return tokens, nil
+}
+
+// NewValidator returns a validator for self-hosted mode.
+// If no join tokens or signing key are configured, every request is accepted.
+func NewValidator(opts Options) identity.Validator {
+	if len(opts.JoinTokens) == 0 && len(opts.SigningKey) == 0 {
+		log.Warn("No join tokens configured for self-hosted mode: sentry will sign certificates for any app ID")
+		return &validator{}
+	}
+
+	return &validator{
+		tokens:      opts.JoinTokens,
+		signingKey:  opts.SigningKey,
+		audience:    opts.Audience,
+		maxLifetime: opts.MaxTokenLifetime,
+		clockSkew:   opts.AllowedClockSkew,
+		enabled:     true,
+	}
+}
+
+type validator struct {
+	tokens      []JoinToken
+	signingKey  []byte
+	audience    string
+	maxLifetime time.Duration
+	clockSkew   time.Duration
+	enabled     bool
 }
 
-type validator struct{}
+// BindsSubject implements identity.SubjectBindingValidator.
+func (v *validator) BindsSubject() bool {
+	return v.enabled
+}
 
 func (v *validator) Validate(id, token, namespace string) error {
-	// no validation for self hosted.
+	if !v.enabled {
+		// no validation for self hosted without join tokens.
+		return nil
+	}
+
+	if id == "" {
+		return fmt.Errorf("%s: id field in request must not be empty", errPrefix)
+	}
+	if token == "" {
+		return fmt.Errorf("%s: token field in request must not be empty", errPrefix)
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	for _, t := range v.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+			return checkBinding(id, namespace, t.AppID, t.Namespace)
+		}
+	}
+
+	if len(v.signingKey) > 0 {
+		return v.validateJWT(id, token, namespace)
+	}
+
+	return fmt.Errorf("%s: unknown join token", errPrefix)
+}
+
+func (v *validator) validateJWT(id, token, namespace string) error {
+	opts := []jwt.ParseOption{
+		jwt.WithKey(jwa.HS256, v.signingKey),
+		jwt.WithValidate(true),
+		jwt.WithAcceptableSkew(v.clockSkew),
+		jwt.WithRequiredClaim(jwt.ExpirationKey),
+	}
+	if v.audience != "" {
+		opts = append(opts, jwt.WithAudience(v.audience))
+	}
+
+	tkn, err := jwt.ParseString(token, opts...)
+	if err != nil {
+		return fmt.Errorf("%s: invalid join token: %w", errPrefix, err)
+	}
+
+	if v.maxLifetime > 0 {
+		// Tokens without an issued at claim are valid from now on.
+		issuedAt := tkn.IssuedAt()
+		if issuedAt.IsZero() {
+			issuedAt = time.Now()
+		}
+		if tkn.Expiration().Sub(issuedAt) > v.maxLifetime+v.clockSkew {
+			return fmt.Errorf("%s: join token lifetime exceeds the maximum of %v", errPrefix, v.maxLifetime)
+		}
+	}
+
+	var tokenNamespace string
+	if ns, ok := tkn.Get(NamespaceClaim); ok {
+		tokenNamespace, ok = ns.(string)
+		if !ok {
+			return fmt.Errorf("%s: invalid %s claim in join token", errPrefix, NamespaceClaim)
+		}
+	}
+
+	return checkBinding(id, namespace, tkn.Subject(), tokenNamespace)
+}
+
+// checkBinding verifies that the requested identity matches the identity the token is bound to.
+func checkBinding(id, namespace, tokenAppID, tokenNamespace string) error {
+	if tokenAppID == "" {
+		return errors.New(errPrefix + ": join token is not bound to an app ID")
+	}
+	if tokenNamespace == "" {
+		tokenNamespace = defaultNamespace
+	}
+
+	if id != tokenAppID || namespace != tokenNamespace {
+		return fmt.Errorf("%s: join token is not valid for app ID %s in namespace %s", errPrefix, id, namespace)
+	}
 	return nil
 }
@@ -0,0 +1,134 @@
+package selfhosted
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("no join tokens accepts any request", func(t *testing.T) {
+		v := NewValidator(Options{})
+		assert.NoError(t, v.Validate("a1", "", ""))
+	})
+
+	t.Run("pre-shared join tokens", func(t *testing.T) {
+		v := NewValidator(Options{
+			JoinTokens: []JoinToken{
+				{Token: "token1", AppID: "a1"},
+				{Token: "token2", AppID: "a2", Namespace: "ns2"},
+			},
+		})
+
+		assert.NoError(t, v.Validate("a1", "token1", ""))
+		assert.NoError(t, v.Validate("a1", "token1", "default"))
+		assert.NoError(t, v.Validate("a2", "token2", "ns2"))
+		assert.Error(t, v.Validate("a2", "token1", ""), "token bound to another app ID")
+		assert.Error(t, v.Validate("a2", "token2", "default"), "token bound to another namespace")
+		assert.Error(t, v.Validate("a1", "unknown", ""))
+		assert.Error(t, v.Validate("a1", "", ""))
+		assert.Error(t, v.Validate("", "token1", ""))
+	})
+
+	t.Run("jwt join tokens", func(t *testing.T) {
+		key := []byte("test-signing-key")
+		v := NewValidator(Options{
+			SigningKey: key,
+			Audience:   "dapr.io/sentry",
+		})
+
+		sign := func(t *testing.T, sub, ns, aud string, exp time.Time, key []byte) string {
+			b := jwt.NewBuilder().Subject(sub).Expiration(exp)
+			if ns != "" {
+				b = b.Claim(NamespaceClaim, ns)
+			}
+			if aud != "" {
+				b = b.Audience([]string{aud})
+			}
+			tkn, err := b.Build()
+			require.NoError(t, err)
+			signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.HS256, key))
+			require.NoError(t, err)
+			return string(signed)
+		}
+
+		exp := time.Now().Add(time.Hour)
+		assert.NoError(t, v.Validate("a1", sign(t, "a1", "ns1", "dapr.io/sentry", exp, key), "ns1"))
+		assert.NoError(t, v.Validate("a1", sign(t, "a1", "", "dapr.io/sentry", exp, key), ""))
+		assert.Error(t, v.Validate("a2", sign(t, "a1", "ns1", "dapr.io/sentry", exp, key), "ns1"), "wrong app ID")
+		assert.Error(t, v.Validate("a1", sign(t, "a1", "ns1", "dapr.io/sentry", exp, key), "ns2"), "wrong namespace")
+		assert.Error(t, v.Validate("a1", sign(t, "a1", "ns1", "other", exp, key), "ns1"), "wrong audience")
+		assert.Error(t, v.Validate("a1", sign(t, "a1", "ns1", "dapr.io/sentry", time.Now().Add(-time.Hour), key), "ns1"), "expired")
+		assert.Error(t, v.Validate("a1", sign(t, "a1", "ns1", "dapr.io/sentry", exp, []byte("other-key")), "ns1"), "bad signature")
+		assert.Error(t, v.Validate("a1", sign(t, "", "ns1", "dapr.io/sentry", exp, key), "ns1"), "no subject")
+	})
+
+	t.Run("jwt join tokens must expire", func(t *testing.T) {
+		key := []byte("test-signing-key")
+		v := NewValidator(Options{SigningKey: key})
+
+		tkn, err := jwt.NewBuilder().Subject("a1").Build()
+		require.NoError(t, err)
+		signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.HS256, key))
+		require.NoError(t, err)
+		assert.Error(t, v.Validate("a1", string(signed), ""))
+	})
+
+	t.Run("jwt join tokens lifetime", func(t *testing.T) {
+		key := []byte("test-signing-key")
+		v := NewValidator(Options{
+			SigningKey:       key,
+			MaxTokenLifetime: time.Hour,
+		})
+
+		sign := func(t *testing.T, iat, exp time.Time) string {
+			b := jwt.NewBuilder().Subject("a1").Expiration(exp)
+			if !iat.IsZero() {
+				b = b.IssuedAt(iat)
+			}
+			tkn, err := b.Build()
+			require.NoError(t, err)
+			signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.HS256, key))
+			require.NoError(t, err)
+			return string(signed)
+		}
+
+		now := time.Now()
+		assert.NoError(t, v.Validate("a1", sign(t, now, now.Add(30*time.Minute)), ""))
+		assert.NoError(t, v.Validate("a1", sign(t, time.Time{}, now.Add(30*time.Minute)), ""))
+		assert.Error(t, v.Validate("a1", sign(t, now, now.Add(2*time.Hour)), ""), "issued for too long")
+		assert.Error(t, v.Validate("a1", sign(t, time.Time{}, now.Add(2*time.Hour)), ""), "expires too late")
+	})
+}
+
+func TestParseJoinTokens(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		tokens, err := ParseJoinTokens([]byte(`
+- token: token1
+  appId: a1
+- token: token2
+  appId: a2
+  namespace: ns2
+`))
+		require.NoError(t, err)
+		assert.Equal(t, []JoinToken{
+			{Token: "token1", AppID: "a1"},
+			{Token: "token2", AppID: "a2", Namespace: "ns2"},
+		}, tokens)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		tokens, err := ParseJoinTokens([]byte(`[{"token":"token1","appId":"a1"}]`))
+		require.NoError(t, err)
+		assert.Equal(t, []JoinToken{{Token: "token1", AppID: "a1"}}, tokens)
+	})
+
+	t.Run("missing app ID", func(t *testing.T) {
+		_, err := ParseJoinTokens([]byte(`[{"token":"token1"}]`))
+		assert.Error(t, err)
+	})
+}
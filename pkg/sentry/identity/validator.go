@@ -4,3 +4,11 @@ package identity
 type Validator interface {
 	Validate(id, token, namespace string) error
 }
+
+// SubjectBindingValidator is implemented by validators whose tokens are bound to an app ID.
+// When BindsSubject returns true, the subject of the certificate signing request must match
+// the validated ID.
+type SubjectBindingValidator interface {
+	Validator
+	BindsSubject() bool
+}
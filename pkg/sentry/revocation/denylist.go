@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/dapr/pkg/credentials"
+	"github.com/dapr/kit/logger"
+)
+
+// reloadInterval is the interval at which the deny-list file is checked for changes.
+const reloadInterval = time.Second * 10
+
+var log = logger.NewLogger("dapr.sentry.revocation")
+
+// denyListFile is the format of the deny-list file.
+type denyListFile struct {
+	SPIFFEIDs     []string `json:"spiffeIds"`
+	SerialNumbers []string `json:"serialNumbers"`
+}
+
+// DenyList is the list of SPIFFE IDs and certificate serial numbers Sentry refuses
+// to sign for, and which is published to sidecars as revocation list.
+// The list is loaded from a YAML or JSON file and reloaded when the file changes.
+type DenyList struct {
+	*credentials.RevocationList
+
+	path    string
+	modTime time.Time
+}
+
+// NewDenyList returns a DenyList loaded from the file at path.
+// If path is empty, the deny list is empty.
+func NewDenyList(path string) (*DenyList, error) {
+	d := &DenyList{
+		RevocationList: credentials.NewRevocationList(),
+		path:           path,
+	}
+	if path == "" {
+		return d, nil
+	}
+
+	if _, err := d.reload(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Run reloads the deny list when the file changes, until the context is canceled.
+func (d *DenyList) Run(ctx context.Context) error {
+	if d.path == "" {
+		<-ctx.Done()
+		return nil
+	}
+
+	t := time.NewTicker(reloadInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			reloaded, err := d.reload()
+			if err != nil {
+				log.Errorf("Failed to reload deny list: %v", err)
+				continue
+			}
+			if reloaded {
+				log.Info("Deny list reloaded")
+			}
+		}
+	}
+}
+
+// reload loads the deny list from the file if it changed since the last load.
+func (d *DenyList) reload() (bool, error) {
+	fi, err := os.Stat(d.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read deny list: %w", err)
+	}
+	if fi.ModTime().Equal(d.modTime) {
+		return false, nil
+	}
+
+	b, err := os.ReadFile(d.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read deny list: %w", err)
+	}
+	var f denyListFile
+	if err = yaml.Unmarshal(b, &f); err != nil {
+		return false, fmt.Errorf("failed to parse deny list: %w", err)
+	}
+
+	d.Update(f.SPIFFEIDs, f.SerialNumbers)
+	d.modTime = fi.ModTime()
+	return true, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenyList(t *testing.T) {
+	t.Run("no file", func(t *testing.T) {
+		d, err := NewDenyList("")
+		require.NoError(t, err)
+		assert.False(t, d.IsIDRevoked("spiffe://public/ns/default/app1"))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := NewDenyList(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.Error(t, err)
+	})
+
+	t.Run("load and reload", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "denylist.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+spiffeIds:
+- spiffe://public/ns/default/app1
+serialNumbers:
+- "0A:0B"
+`), 0o600))
+
+		d, err := NewDenyList(path)
+		require.NoError(t, err)
+		assert.True(t, d.IsIDRevoked("spiffe://public/ns/default/app1"))
+		ids, serials := d.Entries()
+		assert.Equal(t, []string{"spiffe://public/ns/default/app1"}, ids)
+		assert.Equal(t, []string{"a0b"}, serials)
+
+		reloaded, err := d.reload()
+		require.NoError(t, err)
+		assert.False(t, reloaded, "file did not change")
+
+		require.NoError(t, os.WriteFile(path, []byte(`spiffeIds: ["spiffe://public/ns/default/app2"]`), 0o600))
+		require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+		reloaded, err = d.reload()
+		require.NoError(t, err)
+		assert.True(t, reloaded)
+		assert.False(t, d.IsIDRevoked("spiffe://public/ns/default/app1"))
+		assert.True(t, d.IsIDRevoked("spiffe://public/ns/default/app2"))
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/dapr/dapr/pkg/sentry/identity/selfhosted"
 	k8s "github.com/dapr/dapr/pkg/sentry/kubernetes"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
+	"github.com/dapr/dapr/pkg/sentry/revocation"
 	"github.com/dapr/dapr/pkg/sentry/server"
 	"github.com/dapr/kit/logger"
 )
@@ -91,16 +92,26 @@ func (s *sentry) createCAServer(ctx context.Context) (ca.CertificateAuthority, i
 // Runs the CA server.
 // This method blocks until the server is shut down.
 func (s *sentry) run(ctx context.Context, certAuth ca.CertificateAuthority, v identity.Validator) error {
-	s.server = server.NewCAServer(certAuth, v)
+	denyList, err := revocation.NewDenyList(s.conf.DenyListPath)
+	if err != nil {
+		return fmt.Errorf("error loading deny list: %w", err)
+	}
+
+	s.server = server.NewCAServer(certAuth, v, denyList)
 
 	// In background, watch for the root certificate's expiration
+	// and for changes to the deny list
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(2)
 	defer wg.Wait()
 	go func() {
 		defer wg.Done()
 		watchCertExpiry(ctx, certAuth)
 	}()
+	go func() {
+		defer wg.Done()
+		denyList.Run(ctx)
+	}()
 
 	// Start the server; this is a blocking call
 	log.Infof("sentry certificate authority is running, protecting y'all")
@@ -154,5 +165,25 @@ func (s *sentry) createValidator() (identity.Validator, error) {
 
 		return kubernetes.NewValidator(kubeClient, s.conf.GetTokenAudiences()), nil
 	}
-	return selfhosted.NewValidator(), nil
+
+	tokens, err := s.conf.GetJoinTokens()
+	if err != nil {
+		return nil, err
+	}
+	opts := selfhosted.Options{
+		Audience:         s.conf.JoinTokenAudience,
+		MaxTokenLifetime: s.conf.JoinTokenMaxLifetime,
+		AllowedClockSkew: s.conf.AllowedClockSkew,
+	}
+	if len(tokens) > 0 {
+		opts.JoinTokens, err = selfhosted.ParseJoinTokens(tokens)
+		if err != nil {
+			return nil, err
+		}
+	}
+	opts.SigningKey, err = s.conf.GetJoinTokenKey()
+	if err != nil {
+		return nil, err
+	}
+	return selfhosted.NewValidator(opts), nil
 }
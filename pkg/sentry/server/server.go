@@ -18,6 +18,7 @@ import (
 	"github.com/dapr/dapr/pkg/sentry/csr"
 	"github.com/dapr/dapr/pkg/sentry/identity"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
+	"github.com/dapr/dapr/pkg/sentry/revocation"
 	"github.com/dapr/kit/logger"
 )
 
@@ -37,13 +38,16 @@ type server struct {
 	certAuth    ca.CertificateAuthority
 	srv         *grpc.Server
 	validator   identity.Validator
+	denyList    *revocation.DenyList
 }
 
 // NewCAServer returns a new CA Server running a gRPC server.
-func NewCAServer(ca ca.CertificateAuthority, validator identity.Validator) CAServer {
+// denyList may be nil if no identity is denied.
+func NewCAServer(ca ca.CertificateAuthority, validator identity.Validator, denyList *revocation.DenyList) CAServer {
 	return &server{
 		certAuth:  ca,
 		validator: validator,
+		denyList:  denyList,
 	}
 }
 
@@ -83,9 +87,9 @@ func (s *server) tlsServerOption(trustBundler ca.TrustRootBundler) grpc.ServerOp
 		ClientCAs: cp,
 		// Require cert verification
 		ClientAuth: tls.RequireAndVerifyClientCert,
-		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
 			if s.certificate == nil || needsRefresh(s.certificate, serverCertExpiryBuffer) {
-				cert, err := s.getServerCertificate()
+				cert, err := s.getServerCertificate(hello.Context())
 				if err != nil {
 					monitoring.ServerCertIssueFailed("server_cert")
 					log.Error(err)
@@ -97,10 +101,14 @@ func (s *server) tlsServerOption(trustBundler ca.TrustRootBundler) grpc.ServerOp
 		},
 		MinVersion: tls.VersionTLS12,
 	}
+	if s.denyList != nil {
+		// Refuse renewals from clients presenting a revoked certificate.
+		config.VerifyPeerCertificate = s.denyList.VerifyPeerCertificate
+	}
 	return grpc.Creds(credentials.NewTLS(config))
 }
 
-func (s *server) getServerCertificate() (*tls.Certificate, error) {
+func (s *server) getServerCertificate(ctx context.Context) (*tls.Certificate, error) {
 	csrPem, pkPem, err := csr.GenerateCSR("", false)
 	if err != nil {
 		return nil, err
@@ -113,13 +121,17 @@ func (s *server) getServerCertificate() (*tls.Certificate, error) {
 	}
 	serverCertTTL := issuerExp.Sub(now)
 
-	resp, err := s.certAuth.SignCSR(csrPem, s.certAuth.GetCACertBundle().GetTrustDomain(), nil, serverCertTTL, false)
+	resp, err := s.certAuth.SignCSR(ctx, csrPem, s.certAuth.GetCACertBundle().GetTrustDomain(), nil, serverCertTTL, false)
 	if err != nil {
 		return nil, err
 	}
 
 	certPem := resp.CertPEM
-	certPem = append(certPem, s.certAuth.GetCACertBundle().GetIssuerCertPem()...)
+	if len(resp.TrustChainPEM) > 0 {
+		certPem = append(certPem, resp.TrustChainPEM...)
+	} else {
+		certPem = append(certPem, s.certAuth.GetCACertBundle().GetIssuerCertPem()...)
+	}
 	if rootCertPem := s.certAuth.GetCACertBundle().GetRootCertPem(); len(rootCertPem) > 0 {
 		certPem = append(certPem, rootCertPem...)
 	}
@@ -164,8 +176,22 @@ func (s *server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		return nil, err
 	}
 
+	if v, ok := s.validator.(identity.SubjectBindingValidator); ok && v.BindsSubject() && csr.Subject.CommonName != req.GetId() {
+		err = fmt.Errorf("error validating requester identity: csr subject %q does not match id %q", csr.Subject.CommonName, req.GetId())
+		log.Error(err)
+		monitoring.CertSignFailed("req_id_validation")
+		return nil, err
+	}
+
 	identity := identity.NewBundle(csr.Subject.CommonName, req.GetNamespace(), req.GetTrustDomain())
-	signed, err := s.certAuth.SignCSR(csrPem, csr.Subject.CommonName, identity, -1, false)
+	if s.isDenied(identity) {
+		err = fmt.Errorf("error validating requester identity: %s is denied", csr.Subject.CommonName)
+		log.Error(err)
+		monitoring.CertSignFailed("denied")
+		return nil, err
+	}
+
+	signed, err := s.certAuth.SignCSR(ctx, csrPem, csr.Subject.CommonName, identity, -1, false)
 	if err != nil {
 		err = fmt.Errorf("error signing csr: %w", err)
 		log.Error(err)
@@ -174,7 +200,10 @@ func (s *server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 	}
 
 	certPem := signed.CertPEM
-	issuerCert := s.certAuth.GetCACertBundle().GetIssuerCertPem()
+	issuerCert := signed.TrustChainPEM
+	if len(issuerCert) == 0 {
+		issuerCert = s.certAuth.GetCACertBundle().GetIssuerCertPem()
+	}
 	rootCert := s.certAuth.GetCACertBundle().GetRootCertPem()
 
 	certPem = append(certPem, issuerCert...)
@@ -198,6 +227,7 @@ func (s *server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		WorkloadCertificate:    certPem,
 		TrustChainCertificates: [][]byte{issuerCert, rootCert},
 		ValidUntil:             expiry,
+		TrustAnchors:           rootCert,
 	}
 
 	monitoring.CertSignSucceed()
@@ -205,6 +235,29 @@ func (s *server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 	return resp, nil
 }
 
+// GetRevocationList returns the list of denied SPIFFE IDs and revoked certificate serial numbers.
+func (s *server) GetRevocationList(context.Context, *sentryv1pb.GetRevocationListRequest) (*sentryv1pb.GetRevocationListResponse, error) {
+	resp := &sentryv1pb.GetRevocationListResponse{}
+	if s.denyList != nil {
+		resp.SpiffeIds, resp.SerialNumbers = s.denyList.Entries()
+	}
+	return resp, nil
+}
+
+func (s *server) isDenied(bundle *identity.Bundle) bool {
+	if s.denyList == nil {
+		return false
+	}
+
+	spiffeID, err := identity.CreateSPIFFEID(bundle.TrustDomain, bundle.Namespace, bundle.ID)
+	if err != nil {
+		// Without a SPIFFE ID the deny list can't be checked, so refuse to sign.
+		log.Warnf("Failed to create SPIFFE ID to check the deny list: %v", err)
+		return true
+	}
+	return s.denyList.IsIDRevoked(spiffeID)
+}
+
 func needsRefresh(cert *tls.Certificate, expiryBuffer time.Duration) bool {
 	leaf := cert.Leaf
 	if leaf == nil {
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	state "github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+)
+
+// FakeQueryStateStore is a FakeStateStore which supports queries on JSON values.
+// Filters are limited to EQ and AND on top-level fields, and results are not paginated.
+type FakeQueryStateStore struct {
+	*FakeStateStore
+}
+
+func NewFakeQueryStateStore() *FakeQueryStateStore {
+	return &FakeQueryStateStore{FakeStateStore: NewFakeStateStore()}
+}
+
+func (f *FakeQueryStateStore) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	if !f.NoLock {
+		f.lock.RLock()
+		defer f.lock.RUnlock()
+	}
+
+	res := &state.QueryResponse{}
+	for key, item := range f.Items {
+		var fields map[string]any
+		if json.Unmarshal(item.data, &fields) != nil {
+			continue
+		}
+		ok, err := matchFilter(req.Query.Filter, fields)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res.Results = append(res.Results, state.QueryItem{Key: key, Data: item.data, ETag: item.etag})
+		}
+	}
+	return res, nil
+}
+
+func matchFilter(filter query.Filter, fields map[string]any) (bool, error) {
+	switch f := filter.(type) {
+	case nil:
+		return true, nil
+	case *query.EQ:
+		return fields[f.Key] == f.Val, nil
+	case *query.AND:
+		for _, sub := range f.Filters {
+			ok, err := matchFilter(sub, fields)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported filter %T", filter)
+	}
+}
@@ -142,6 +142,14 @@ func (f *FakeStateStore) Set(ctx context.Context, req *state.SetRequest) error {
 		defer f.lock.Unlock()
 	}
 
+	item := f.Items[req.Key]
+	switch {
+	case req.ETag != nil && (item == nil || *req.ETag != *item.etag):
+		return state.NewETagError(state.ETagMismatch, nil)
+	case req.ETag == nil && req.Options.Concurrency == state.FirstWrite && item != nil:
+		return state.NewETagError(state.ETagMismatch, nil)
+	}
+
 	b, _ := marshal(&req.Value)
 	f.Items[req.Key] = f.NewItem(b)
 